@@ -20,6 +20,7 @@ import (
 	upgradeclient "github.com/cosmos/cosmos-sdk/x/upgrade/client"
 
 	treasuryclient "github.com/terra-project/core/x/treasury/client"
+	wasmclient "github.com/terra-project/core/x/wasm/client"
 
 	core "github.com/terra-project/core/types"
 	"github.com/terra-project/core/x/auth"
@@ -29,6 +30,7 @@ import (
 	"github.com/terra-project/core/x/crisis"
 	distr "github.com/terra-project/core/x/distribution"
 	"github.com/terra-project/core/x/evidence"
+	"github.com/terra-project/core/x/feegrant"
 	"github.com/terra-project/core/x/genutil"
 	"github.com/terra-project/core/x/gov"
 	"github.com/terra-project/core/x/market"
@@ -45,8 +47,11 @@ import (
 	wasmconfig "github.com/terra-project/core/x/wasm/config"
 
 	bankwasm "github.com/terra-project/core/x/bank/wasm"
+	distrwasm "github.com/terra-project/core/x/distribution/wasm"
+	govwasm "github.com/terra-project/core/x/gov/wasm"
 	marketwasm "github.com/terra-project/core/x/market/wasm"
 	oraclewasm "github.com/terra-project/core/x/oracle/wasm"
+	slashingwasm "github.com/terra-project/core/x/slashing/wasm"
 	stakingwasm "github.com/terra-project/core/x/staking/wasm"
 	treasurywasm "github.com/terra-project/core/x/treasury/wasm"
 )
@@ -76,6 +81,10 @@ var (
 			upgradeclient.ProposalHandler,
 			treasuryclient.TaxRateUpdateProposalHandler,
 			treasuryclient.RewardWeightUpdateProposalHandler,
+			wasmclient.StoreCodeProposalHandler,
+			wasmclient.InstantiateContractProposalHandler,
+			wasmclient.MigrateContractProposalHandler,
+			wasmclient.UpdateContractAdminProposalHandler,
 		),
 		params.AppModuleBasic{},
 		crisis.AppModuleBasic{},
@@ -88,6 +97,8 @@ var (
 		treasury.AppModuleBasic{},
 		wasm.AppModuleBasic{},
 		msgauth.AppModuleBasic{},
+		feegrant.AppModuleBasic{},
+		vesting.AppModuleBasic{},
 	)
 
 	// module account permissions
@@ -115,7 +126,6 @@ var (
 func MakeCodec() *codec.Codec {
 	var cdc = codec.New()
 	ModuleBasics.RegisterCodec(cdc)
-	vesting.RegisterCodec(cdc)
 	sdk.RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
 	codec.RegisterEvidences(cdc)
@@ -157,6 +167,12 @@ type TerraApp struct {
 	treasuryKeeper treasury.Keeper
 	wasmKeeper     wasm.Keeper
 	msgauthKeeper  msgauth.Keeper
+	feeGrantKeeper feegrant.Keeper
+	versionKeeper  upgrade.VersionKeeper
+
+	// the in-place store migrations of the modules and the upgrades
+	migrations upgrade.MigrationRegistry
+	upgrades   map[string]Upgrade
 
 	// the module manager
 	mm *module.Manager
@@ -182,7 +198,7 @@ func NewTerraApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		gov.StoreKey, params.StoreKey, oracle.StoreKey,
 		market.StoreKey, mint.StoreKey, treasury.StoreKey,
 		upgrade.StoreKey, evidence.StoreKey, wasm.StoreKey,
-		msgauth.StoreKey,
+		msgauth.StoreKey, feegrant.StoreKey,
 	)
 	tkeys := sdk.NewTransientStoreKeys(params.TStoreKey)
 
@@ -210,6 +226,7 @@ func NewTerraApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	app.subspaces[mint.ModuleName] = app.paramsKeeper.Subspace(mint.DefaultParamspace)
 	app.subspaces[wasm.ModuleName] = app.paramsKeeper.Subspace(wasm.DefaultParamspace)
 	app.subspaces[treasury.ModuleName] = app.paramsKeeper.Subspace(treasury.DefaultParamspace)
+	app.subspaces[msgauth.ModuleName] = app.paramsKeeper.Subspace(msgauth.DefaultParamspace)
 
 	// add keepers
 	app.accountKeeper = auth.NewAccountKeeper(app.cdc, keys[auth.StoreKey], app.subspaces[auth.ModuleName], auth.ProtoBaseAccount)
@@ -222,6 +239,7 @@ func NewTerraApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		app.subspaces[slashing.ModuleName])
 	app.crisisKeeper = crisis.NewKeeper(app.subspaces[crisis.ModuleName], invCheckPeriod, app.supplyKeeper, auth.FeeCollectorName)
 	app.upgradeKeeper = upgrade.NewKeeper(skipUpgradeHeights, keys[upgrade.StoreKey], app.cdc)
+	app.versionKeeper = upgrade.NewVersionKeeper(keys[upgrade.StoreKey])
 	app.oracleKeeper = oracle.NewKeeper(app.cdc, keys[oracle.StoreKey], app.subspaces[oracle.ModuleName], app.distrKeeper,
 		&stakingKeeper, app.supplyKeeper, distr.ModuleName)
 	app.marketKeeper = market.NewKeeper(app.cdc, keys[market.StoreKey], app.subspaces[market.ModuleName],
@@ -229,12 +247,16 @@ func NewTerraApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	app.mintKeeper = mint.NewKeeper(app.cdc, keys[mint.StoreKey], app.subspaces[mint.ModuleName], &stakingKeeper, app.supplyKeeper, auth.FeeCollectorName)
 	app.treasuryKeeper = treasury.NewKeeper(app.cdc, keys[treasury.StoreKey], app.subspaces[treasury.ModuleName],
 		app.supplyKeeper, app.marketKeeper, &stakingKeeper, app.distrKeeper,
-		oracle.ModuleName, distr.ModuleName)
-	app.msgauthKeeper = msgauth.NewKeeper(app.cdc, keys[msgauth.StoreKey], bApp.Router(),
+		app.oracleKeeper, oracle.ModuleName, distr.ModuleName)
+	app.msgauthKeeper = msgauth.NewKeeper(app.cdc, keys[msgauth.StoreKey], app.subspaces[msgauth.ModuleName], bApp.Router(),
 		bank.MsgSend{}.Type(),
 		market.MsgSwap{}.Type(),
 		gov.MsgVote{}.Type(),
+		staking.MsgDelegate{}.Type(),
+		wasm.MsgExecuteContract{}.Type(),
+		msgauth.MsgExecAuthorized{}.Type(),
 	)
+	app.feeGrantKeeper = feegrant.NewKeeper(app.cdc, keys[feegrant.StoreKey], app.bankKeeper)
 
 	// register the evidence router
 	evidenceRouter := evidence.NewRouter()
@@ -253,7 +275,8 @@ func NewTerraApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		AddRoute(params.RouterKey, params.NewParamChangeProposalHandler(app.paramsKeeper)).
 		AddRoute(distr.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.distrKeeper)).
 		AddRoute(upgrade.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.upgradeKeeper)).
-		AddRoute(treasury.RouterKey, treasury.NewTreasuryPolicyUpdateHandler(app.treasuryKeeper))
+		AddRoute(treasury.RouterKey, treasury.NewTreasuryPolicyUpdateHandler(app.treasuryKeeper)).
+		AddRoute(wasm.RouterKey, wasm.NewWasmProposalHandler(app.wasmKeeper))
 	app.govKeeper = gov.NewKeeper(app.cdc, keys[gov.StoreKey], app.subspaces[gov.ModuleName],
 		app.supplyKeeper, &stakingKeeper, govRouter)
 
@@ -263,18 +286,23 @@ func NewTerraApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		staking.NewMultiStakingHooks(app.distrKeeper.Hooks(), app.slashingKeeper.Hooks()))
 
 	app.wasmKeeper.RegisterMsgParsers(map[string]wasm.WasmMsgParserInterface{
-		wasm.WasmMsgParserRouteBank:    bankwasm.NewWasmMsgParser(),
-		wasm.WasmMsgParserRouteStaking: stakingwasm.NewWasmMsgParser(),
-		wasm.WasmMsgParserRouteMarket:  marketwasm.NewWasmMsgParser(),
-		wasm.WasmMsgParserRouteWasm:    wasm.NewWasmMsgParser(),
+		wasm.WasmMsgParserRouteBank:         bankwasm.NewWasmMsgParser(),
+		wasm.WasmMsgParserRouteStaking:      stakingwasm.NewWasmMsgParser(),
+		wasm.WasmMsgParserRouteMarket:       marketwasm.NewWasmMsgParser(),
+		wasm.WasmMsgParserRouteWasm:         wasm.NewWasmMsgParser(),
+		wasm.WasmMsgParserRouteDistribution: distrwasm.NewWasmMsgParser(),
+		wasm.WasmMsgParserRouteGov:          govwasm.NewWasmMsgParser(),
 	})
 	app.wasmKeeper.RegisterQueriers(map[string]wasm.WasmQuerierInterface{
-		wasm.WasmQueryRouteBank:     bankwasm.NewWasmQuerier(app.bankKeeper),
-		wasm.WasmQueryRouteStaking:  stakingwasm.NewWasmQuerier(app.stakingKeeper),
-		wasm.WasmQueryRouteMarket:   marketwasm.NewWasmQuerier(app.marketKeeper),
-		wasm.WasmQueryRouteOracle:   oraclewasm.NewWasmQuerier(app.oracleKeeper),
-		wasm.WasmQueryRouteTreasury: treasurywasm.NewWasmQuerier(app.treasuryKeeper),
-		wasm.WasmQueryRouteWasm:     wasm.NewWasmQuerier(app.wasmKeeper),
+		wasm.WasmQueryRouteBank:         bankwasm.NewWasmQuerier(app.bankKeeper),
+		wasm.WasmQueryRouteStaking:      stakingwasm.NewWasmQuerier(app.stakingKeeper),
+		wasm.WasmQueryRouteMarket:       marketwasm.NewWasmQuerier(app.marketKeeper),
+		wasm.WasmQueryRouteOracle:       oraclewasm.NewWasmQuerier(app.oracleKeeper),
+		wasm.WasmQueryRouteTreasury:     treasurywasm.NewWasmQuerier(app.treasuryKeeper),
+		wasm.WasmQueryRouteWasm:         wasm.NewWasmQuerier(app.wasmKeeper),
+		wasm.WasmQueryRouteDistribution: distrwasm.NewWasmQuerier(app.distrKeeper),
+		wasm.WasmQueryRouteGov:          govwasm.NewWasmQuerier(app.govKeeper),
+		wasm.WasmQueryRouteSlashing:     slashingwasm.NewWasmQuerier(app.slashingKeeper, app.stakingKeeper),
 	})
 
 	app.mm = module.NewManager(
@@ -292,9 +320,11 @@ func NewTerraApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		evidence.NewAppModule(app.evidenceKeeper),
 		market.NewAppModule(app.marketKeeper, app.accountKeeper, app.oracleKeeper),
 		oracle.NewAppModule(app.oracleKeeper, app.accountKeeper),
-		treasury.NewAppModule(app.treasuryKeeper),
+		treasury.NewAppModule(app.treasuryKeeper, app.accountKeeper, app.bankKeeper),
 		wasm.NewAppModule(app.wasmKeeper, app.accountKeeper, app.bankKeeper),
 		msgauth.NewAppModule(app.msgauthKeeper, app.accountKeeper, app.bankKeeper),
+		feegrant.NewAppModule(app.feeGrantKeeper, app.accountKeeper),
+		vesting.NewAppModule(app.accountKeeper, app.bankKeeper),
 	)
 
 	// During begin block slashing happens after distr.BeginBlocker so that
@@ -303,7 +333,7 @@ func NewTerraApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 	app.mm.SetOrderBeginBlockers(upgrade.ModuleName, mint.ModuleName, distr.ModuleName, slashing.ModuleName,
 		evidence.ModuleName, wasm.ModuleName)
 	app.mm.SetOrderEndBlockers(crisis.ModuleName, oracle.ModuleName, gov.ModuleName, market.ModuleName,
-		treasury.ModuleName, msgauth.ModuleName, staking.ModuleName)
+		treasury.ModuleName, msgauth.ModuleName, feegrant.ModuleName, staking.ModuleName)
 
 	// genutils must occur after staking so that pools are properly
 	// treasury must occur after supply so that initial issuance is properly
@@ -312,11 +342,22 @@ func NewTerraApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		staking.ModuleName, bank.ModuleName, slashing.ModuleName,
 		gov.ModuleName, mint.ModuleName, supply.ModuleName,
 		oracle.ModuleName, treasury.ModuleName, market.ModuleName,
-		wasm.ModuleName, msgauth.ModuleName, crisis.ModuleName,
-		genutil.ModuleName, evidence.ModuleName)
+		wasm.ModuleName, msgauth.ModuleName, feegrant.ModuleName, vesting.ModuleName,
+		crisis.ModuleName, genutil.ModuleName, evidence.ModuleName)
 
 	app.mm.RegisterInvariants(&app.crisisKeeper)
 	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
+	app.QueryRouter().AddRoute(QuerierRouteFeatures, NewFeaturesQuerier(app.cdc))
+
+	// register the store migrations of the modules and the upgrade handlers
+	app.migrations = upgrade.NewMigrationRegistry()
+	if err := app.registerMigrations(); err != nil {
+		tmos.Exit(err.Error())
+	}
+
+	if err := app.registerUpgrades(Upgrades, skipUpgradeHeights); err != nil {
+		tmos.Exit(err.Error())
+	}
 
 	// fuzz test simulation
 	app.sm = module.NewSimulationManager(
@@ -331,9 +372,11 @@ func NewTerraApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		params.NewAppModule(), // NOTE: only used for simulation to generate randomized param change proposals
 		market.NewAppModule(app.marketKeeper, app.accountKeeper, app.oracleKeeper),
 		oracle.NewAppModule(app.oracleKeeper, app.accountKeeper),
-		treasury.NewAppModule(app.treasuryKeeper),
+		treasury.NewAppModule(app.treasuryKeeper, app.accountKeeper, app.bankKeeper),
 		wasm.NewAppModule(app.wasmKeeper, app.accountKeeper, app.bankKeeper),
 		msgauth.NewAppModule(app.msgauthKeeper, app.accountKeeper, app.bankKeeper),
+		feegrant.NewAppModule(app.feeGrantKeeper, app.accountKeeper),
+		vesting.NewAppModule(app.accountKeeper, app.bankKeeper),
 	)
 
 	app.sm.RegisterStoreDecoders()
@@ -350,6 +393,8 @@ func NewTerraApp(logger log.Logger, db dbm.DB, traceStore io.Writer, loadLatest
 		app.supplyKeeper,
 		app.oracleKeeper,
 		app.treasuryKeeper,
+		app.marketKeeper,
+		app.feeGrantKeeper,
 		auth.DefaultSigVerificationGasConsumer))
 	app.SetEndBlocker(app.EndBlocker)
 
@@ -394,7 +439,11 @@ func (app *TerraApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abci.
 func (app *TerraApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 	var genesisState simapp.GenesisState
 	app.cdc.MustUnmarshalJSON(req.AppStateBytes, &genesisState)
-	return app.mm.InitGenesis(ctx, genesisState)
+	res := app.mm.InitGenesis(ctx, genesisState)
+
+	// the genesis state is at the consensus versions of the modules of the app
+	app.versionKeeper.SetModuleVersions(ctx, upgrade.ConsensusVersions(app.mm))
+	return res
 }
 
 // LoadHeight loads a particular height
@@ -461,6 +510,26 @@ func (app *TerraApp) GetTreasuryKeeper() treasury.Keeper {
 	return app.treasuryKeeper
 }
 
+// GetAccountKeeper is test purpose function to return account keeper
+func (app *TerraApp) GetAccountKeeper() auth.AccountKeeper {
+	return app.accountKeeper
+}
+
+// GetMarketKeeper is test purpose function to return market keeper
+func (app *TerraApp) GetMarketKeeper() market.Keeper {
+	return app.marketKeeper
+}
+
+// GetFeeGrantKeeper is test purpose function to return fee grant keeper
+func (app *TerraApp) GetFeeGrantKeeper() feegrant.Keeper {
+	return app.feeGrantKeeper
+}
+
+// GetOracleKeeper is test purpose function to return oracle keeper
+func (app *TerraApp) GetOracleKeeper() oracle.Keeper {
+	return app.oracleKeeper
+}
+
 // GetMaccPerms returns a copy of the module account permissions
 func GetMaccPerms() map[string][]string {
 	dupMaccPerms := make(map[string][]string)
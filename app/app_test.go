@@ -14,7 +14,16 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
 
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/auth"
+	"github.com/terra-project/core/x/market"
+	"github.com/terra-project/core/x/oracle"
+	"github.com/terra-project/core/x/supply"
+	"github.com/terra-project/core/x/treasury"
+	"github.com/terra-project/core/x/wasm"
 	wasmconfig "github.com/terra-project/core/x/wasm/config"
 )
 
@@ -70,3 +79,59 @@ func TestBlackListedAddrs(t *testing.T) {
 		require.Equal(t, !allowedReceivingModAcc[acc], app.bankKeeper.BlacklistedAddr(app.supplyKeeper.GetModuleAddress(acc)))
 	}
 }
+
+// ensure that the invariants of the terra modules are routable by MsgVerifyInvariant
+func TestTerraInvariants(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	viper.Set(flags.FlagHome, tempDir)
+	defer os.RemoveAll(tempDir)
+
+	db := dbm.NewMemDB()
+	tapp := NewTerraApp(log.NewNopLogger(), db, nil, true, 0, map[int64]bool{}, wasmconfig.DefaultConfig())
+	require.NoError(t, setGenesis(tapp))
+
+	routes := make(map[string]bool)
+	ctx := tapp.NewContext(true, abci.Header{Height: tapp.LastBlockHeight()})
+	for _, route := range tapp.crisisKeeper.Routes() {
+		msg, broken := route.Invar(ctx)
+		require.False(t, broken, msg)
+		routes[route.FullRoute()] = true
+	}
+
+	for _, route := range []string{
+		oracle.ModuleName + "/reward-pool",
+		market.ModuleName + "/terra-pool-delta",
+		treasury.ModuleName + "/tax-proceeds",
+		treasury.ModuleName + "/tax-caps",
+		wasm.ModuleName + "/contract-indexes",
+		wasm.ModuleName + "/last-ids",
+	} {
+		require.True(t, routes[route], route)
+	}
+}
+
+// ensure that a genesis holding terra without tax caps passes the invariants of InitChain
+func TestGenesisWithoutTaxCaps(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	viper.Set(flags.FlagHome, tempDir)
+	defer os.RemoveAll(tempDir)
+
+	db := dbm.NewMemDB()
+	tapp := NewTerraApp(log.NewNopLogger(), db, nil, true, 0, map[int64]bool{}, wasmconfig.DefaultConfig())
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin(core.MicroUSDDenom, 1000000))
+	acc := auth.NewBaseAccountWithAddress(sdk.AccAddress("holder______________"))
+	require.NoError(t, acc.SetCoins(coins))
+
+	genesisState := ModuleBasics.DefaultGenesis()
+	genesisState[auth.ModuleName] = tapp.Codec().MustMarshalJSON(auth.NewGenesisState(auth.DefaultParams(), authexported.GenesisAccounts{&acc}))
+	genesisState[supply.ModuleName] = tapp.Codec().MustMarshalJSON(supply.NewGenesisState(coins))
+	stateBytes, err := codec.MarshalJSONIndent(tapp.Codec(), genesisState)
+	require.NoError(t, err)
+
+	require.NotPanics(t, func() {
+		tapp.InitChain(abci.RequestInitChain{Validators: []abci.ValidatorUpdate{}, AppStateBytes: stateBytes})
+	})
+}
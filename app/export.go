@@ -2,7 +2,10 @@ package app
 
 import (
 	"encoding/json"
+	"fmt"
+	"io"
 	"log"
+	"sort"
 
 	abci "github.com/tendermint/tendermint/abci/types"
 	tmtypes "github.com/tendermint/tendermint/types"
@@ -10,9 +13,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	distr "github.com/terra-project/core/x/distribution"
 	"github.com/terra-project/core/x/oracle"
 	"github.com/terra-project/core/x/slashing"
 	"github.com/terra-project/core/x/staking"
+	"github.com/terra-project/core/x/wasm"
 )
 
 // ExportAppStateAndValidators exports the state of terra for a genesis file
@@ -35,6 +40,83 @@ func (app *TerraApp) ExportAppStateAndValidators(forZeroHeight bool, jailWhiteLi
 	return appState, validators, nil
 }
 
+// ExportOptions are the options of StreamAppState
+type ExportOptions struct {
+	ForZeroHeight bool
+	JailWhiteList []string
+
+	// Modules to export; all the modules if empty
+	Modules []string
+
+	// CodeDir is the dir the wasm codes are written to, which the exported
+	// wasm genesis references instead of holding the code bytes
+	CodeDir string
+}
+
+// StreamAppState writes the exported state of the modules to the writer module by
+// module, streaming the wasm state without holding it in memory, and returns the validators
+func (app *TerraApp) StreamAppState(w io.Writer, opts ExportOptions) ([]tmtypes.GenesisValidator, error) {
+	modules := opts.Modules
+	if len(modules) == 0 {
+		for moduleName := range app.mm.Modules {
+			modules = append(modules, moduleName)
+		}
+	}
+
+	for _, moduleName := range modules {
+		if _, ok := app.mm.Modules[moduleName]; !ok {
+			return nil, fmt.Errorf("unknown module %s", moduleName)
+		}
+	}
+
+	sort.Strings(modules)
+
+	// as if they could withdraw from the start of the next block
+	ctx := app.NewContext(true, abci.Header{Height: app.LastBlockHeight()})
+
+	if opts.ForZeroHeight {
+		app.prepForZeroHeightGenesis(ctx, opts.JailWhiteList)
+	}
+
+	if _, err := io.WriteString(w, "{"); err != nil {
+		return nil, err
+	}
+
+	for i, moduleName := range modules {
+		sep := ","
+		if i == 0 {
+			sep = ""
+		}
+
+		if _, err := fmt.Fprintf(w, "%s%q:", sep, moduleName); err != nil {
+			return nil, err
+		}
+
+		if moduleName == wasm.ModuleName {
+			if err := wasm.StreamGenesis(ctx, app.wasmKeeper, w, opts.CodeDir); err != nil {
+				return nil, err
+			}
+
+			continue
+		}
+
+		moduleGenState := app.mm.Modules[moduleName].ExportGenesis(ctx)
+		if moduleGenState == nil {
+			moduleGenState = json.RawMessage("null")
+		}
+
+		if _, err := w.Write(moduleGenState); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := io.WriteString(w, "}"); err != nil {
+		return nil, err
+	}
+
+	return staking.WriteValidators(ctx, app.stakingKeeper), nil
+}
+
 // prepForZeroHeightGenesis prepares for fresh start at zero height
 // NOTE zero height genesis is a temporary feature which will be deprecated
 //      in favour of export at a block height
@@ -64,7 +146,7 @@ func (app *TerraApp) prepForZeroHeightGenesis(ctx sdk.Context, jailWhiteList []s
 	// withdraw all validator commission
 	app.stakingKeeper.IterateValidators(ctx, func(_ int64, val staking.ValidatorI) (stop bool) {
 		_, err := app.distrKeeper.WithdrawValidatorCommission(ctx, val.GetOperator())
-		if err != nil {
+		if err != nil && !distr.ErrNoValidatorCommission.Is(err) {
 			log.Fatal(err)
 		}
 		return false
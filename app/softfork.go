@@ -0,0 +1,153 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+
+	"github.com/pelletier/go-toml"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	core "github.com/terra-project/core/types"
+)
+
+const (
+	// SoftforksGenesisKey is the key of the app state of the genesis holding
+	// the activation heights of the features of the chain, eg.
+	//	"softforks": {"softfork-1": "100"}
+	SoftforksGenesisKey = "softforks"
+
+	// SoftforksConfigKey is the section of the app config holding the
+	// activation heights of the features per chain ID, quoted when it has dots, eg.
+	//	[softforks."my-chain.1"]
+	//	softfork-1 = 100
+	SoftforksConfigKey = "softforks"
+
+	// QuerierRouteFeatures is the query route of the feature statuses
+	QuerierRouteFeatures = "features"
+)
+
+// ReadFeatureHeightsConfig reads the activation heights per chain ID of the app config file;
+// the file is read as it is, as viper lowercases the keys and splits them on the dots,
+// which changes the chain IDs
+func ReadFeatureHeightsConfig(appConfigFile string) (map[string]interface{}, error) {
+	if _, err := os.Stat(appConfigFile); os.IsNotExist(err) {
+		return nil, nil
+	}
+
+	tree, err := toml.LoadFile(appConfigFile)
+	if err != nil {
+		return nil, err
+	}
+
+	switch softforks := tree.Get(SoftforksConfigKey).(type) {
+	case nil:
+		return nil, nil
+	case *toml.Tree:
+		return softforks.ToMap(), nil
+	default:
+		return nil, fmt.Errorf("invalid %s of the app config", SoftforksConfigKey)
+	}
+}
+
+// LoadFeatureHeights sets the activation heights of the features of the chain in the
+// genesis file, and then the ones of the app config, which override the genesis ones
+func LoadFeatureHeights(registry *core.FeatureRegistry, genFile string, config map[string]interface{}) error {
+	if genFile != "" {
+		if err := loadGenesisFeatureHeights(registry, genFile); err != nil {
+			return fmt.Errorf("failed to load the activation heights of %s: %w", genFile, err)
+		}
+	}
+
+	for chainID, heights := range config {
+		chainHeights, ok := heights.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("invalid %s.%s of the app config", SoftforksConfigKey, chainID)
+		}
+
+		if err := setFeatureHeights(registry, chainID, chainHeights); err != nil {
+			return fmt.Errorf("invalid %s.%s of the app config: %w", SoftforksConfigKey, chainID, err)
+		}
+	}
+
+	return nil
+}
+
+func loadGenesisFeatureHeights(registry *core.FeatureRegistry, genFile string) error {
+	file, err := os.Open(genFile)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var genDoc struct {
+		ChainID  string `json:"chain_id"`
+		AppState struct {
+			Softforks map[string]interface{} `json:"softforks"`
+		} `json:"app_state"`
+	}
+
+	if err := json.NewDecoder(file).Decode(&genDoc); err != nil {
+		return err
+	}
+
+	return setFeatureHeights(registry, genDoc.ChainID, genDoc.AppState.Softforks)
+}
+
+func setFeatureHeights(registry *core.FeatureRegistry, chainID string, heights map[string]interface{}) error {
+	for feature, value := range heights {
+		height, err := parseFeatureHeight(value)
+		if err != nil {
+			return fmt.Errorf("invalid activation height of %s: %w", feature, err)
+		}
+
+		if err := registry.SetActivationHeight(chainID, feature, height); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseFeatureHeight parses the height of the JSON, as a string like amino or a number, and of the config
+func parseFeatureHeight(value interface{}) (int64, error) {
+	switch value := value.(type) {
+	case string:
+		return strconv.ParseInt(value, 10, 64)
+	case float64:
+		if value != math.Trunc(value) {
+			return 0, fmt.Errorf("non integer height %v", value)
+		}
+
+		return int64(value), nil
+	case int64:
+		return value, nil
+	case int:
+		return int64(value), nil
+	}
+
+	return 0, fmt.Errorf("invalid height %v", value)
+}
+
+// NewFeaturesQuerier returns the querier of the statuses of the features at the query height
+func NewFeaturesQuerier(cdc *codec.Codec) sdk.Querier {
+	return func(ctx sdk.Context, _ []string, _ abci.RequestQuery) ([]byte, error) {
+		statuses := core.DefaultFeatureRegistry.Statuses(ctx.ChainID(), ctx.BlockHeight())
+
+		bz, err := codec.MarshalJSONIndent(cdc, statuses)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		}
+
+		return bz, nil
+	}
+}
@@ -0,0 +1,142 @@
+package app
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+)
+
+func TestLoadFeatureHeights(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "softfork")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	genFile := filepath.Join(tempDir, "genesis.json")
+	require.NoError(t, ioutil.WriteFile(genFile, []byte(`{
+		"chain_id": "private-1",
+		"app_state": {"softforks": {"softfork-1": "100", "softfork-2": 200}}
+	}`), 0644))
+
+	registry := core.NewFeatureRegistry()
+	require.NoError(t, LoadFeatureHeights(registry, genFile, map[string]interface{}{
+		"private-1": map[string]interface{}{core.FeatureSoftfork2: int64(300)},
+		"private-2": map[string]interface{}{core.FeatureSoftfork3: int64(10)},
+	}))
+
+	// the app config overrides the genesis
+	require.Equal(t, int64(100), registry.ActivationHeight("private-1", core.FeatureSoftfork1))
+	require.Equal(t, int64(300), registry.ActivationHeight("private-1", core.FeatureSoftfork2))
+	require.Equal(t, int64(0), registry.ActivationHeight("private-1", core.FeatureSoftfork3))
+	require.Equal(t, int64(10), registry.ActivationHeight("private-2", core.FeatureSoftfork3))
+
+	require.False(t, registry.IsActive("private-1", core.FeatureSoftfork1, 99))
+	require.True(t, registry.IsActive("private-1", core.FeatureSoftfork1, 100))
+	require.True(t, registry.IsActive("private-1", core.FeatureSoftfork3, 1))
+	require.Equal(t, []core.FeatureStatus{
+		{Name: core.FeatureSoftfork1, ActivationHeight: 100, Active: true},
+		{Name: core.FeatureSoftfork2, ActivationHeight: 300, Active: false},
+		{Name: core.FeatureSoftfork3, ActivationHeight: 0, Active: true},
+		{Name: core.FeatureWasmQueryLimits, ActivationHeight: 0, Active: true},
+		{Name: core.FeatureWasmTypedEvents, ActivationHeight: 0, Active: true},
+		{Name: core.FeatureWasmCustomMsgs, ActivationHeight: 0, Active: true},
+		{Name: core.FeatureOracleRewardTracking, ActivationHeight: 0, Active: true},
+		{Name: core.FeatureTreasuryTaxCaps, ActivationHeight: 0, Active: true},
+	}, registry.Statuses("private-1", 200))
+
+	// missing genesis file is skipped
+	require.NoError(t, LoadFeatureHeights(registry, filepath.Join(tempDir, "none.json"), nil))
+
+	// unknown feature and invalid height
+	require.Error(t, LoadFeatureHeights(registry, "", map[string]interface{}{
+		"private-1": map[string]interface{}{"unknown": int64(1)},
+	}))
+	require.Error(t, LoadFeatureHeights(registry, "", map[string]interface{}{
+		"private-1": map[string]interface{}{core.FeatureSoftfork1: "-1"},
+	}))
+	require.Error(t, LoadFeatureHeights(registry, "", map[string]interface{}{
+		"private-1": map[string]interface{}{core.FeatureSoftfork1: "abc"},
+	}))
+}
+
+func TestReadFeatureHeightsConfig(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "softfork")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	// the chain IDs keep the case and the dots
+	appConfigFile := filepath.Join(tempDir, "app.toml")
+	require.NoError(t, ioutil.WriteFile(appConfigFile, []byte(`
+minimum-gas-prices = ""
+
+[softforks."Private.Net-1"]
+softfork-1 = 100
+wasm-custom-msgs = 200
+`), 0644))
+
+	config, err := ReadFeatureHeightsConfig(appConfigFile)
+	require.NoError(t, err)
+
+	registry := core.NewFeatureRegistry()
+	require.NoError(t, LoadFeatureHeights(registry, "", config))
+	require.Equal(t, int64(100), registry.ActivationHeight("Private.Net-1", core.FeatureSoftfork1))
+	require.Equal(t, int64(200), registry.ActivationHeight("Private.Net-1", core.FeatureWasmCustomMsgs))
+
+	// the config without softforks and a missing config
+	require.NoError(t, ioutil.WriteFile(appConfigFile, []byte(`minimum-gas-prices = ""`), 0644))
+	config, err = ReadFeatureHeightsConfig(appConfigFile)
+	require.NoError(t, err)
+	require.Nil(t, config)
+
+	config, err = ReadFeatureHeightsConfig(filepath.Join(tempDir, "none.toml"))
+	require.NoError(t, err)
+	require.Nil(t, config)
+
+	require.NoError(t, ioutil.WriteFile(appConfigFile, []byte(`softforks = 1`), 0644))
+	_, err = ReadFeatureHeightsConfig(appConfigFile)
+	require.Error(t, err)
+}
+
+func TestSoftforkCompatibility(t *testing.T) {
+	header := abci.Header{ChainID: "columbus-4", Height: 1200000}
+	ctx := sdk.NewContext(nil, header, false, log.NewNopLogger())
+	require.False(t, core.IsWaitingForSoftfork(ctx, 1))
+	require.True(t, core.IsSoftforkHeight(ctx, 1))
+	require.True(t, core.IsWaitingForSoftfork(ctx, 2))
+	require.False(t, core.IsWaitingForSoftfork(ctx.WithBlockHeight(2380000), 3))
+	require.True(t, core.IsSoftforkHeight(ctx.WithBlockHeight(2380000), 3))
+
+	// the features added later are not softfork versions
+	require.False(t, core.IsFeatureActive(ctx, core.FeatureWasmQueryLimits))
+	require.False(t, core.IsWaitingForSoftfork(ctx, 4))
+	require.False(t, core.IsSoftforkHeight(ctx, 4))
+
+	// the features are active from the genesis on the other chains
+	ctx = ctx.WithChainID("softfork-test").WithBlockHeight(1)
+	for version := uint8(1); version <= 3; version++ {
+		require.False(t, core.IsWaitingForSoftfork(ctx, version))
+		require.False(t, core.IsSoftforkHeight(ctx, version))
+	}
+
+	require.NoError(t, core.DefaultFeatureRegistry.SetActivationHeight("softfork-test", core.FeatureSoftfork1, 5))
+	require.True(t, core.IsWaitingForSoftfork(ctx, 1))
+	require.True(t, core.IsSoftforkHeight(ctx.WithBlockHeight(5), 1))
+
+	cdc := codec.New()
+	res, err := NewFeaturesQuerier(cdc)(ctx, nil, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var statuses []core.FeatureStatus
+	require.NoError(t, cdc.UnmarshalJSON(res, &statuses))
+	require.Equal(t, core.FeatureStatus{Name: core.FeatureSoftfork1, ActivationHeight: 5, Active: false}, statuses[0])
+}
@@ -0,0 +1,150 @@
+package app
+
+import (
+	"fmt"
+
+	bam "github.com/cosmos/cosmos-sdk/baseapp"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/feegrant"
+	"github.com/terra-project/core/x/upgrade"
+)
+
+// Upgrade is an upgrade of the app, applied at the height of the upgrade plan of its name
+type Upgrade struct {
+	// Name is the name of the upgrade plan
+	Name string
+
+	// StoreUpgrades are the stores added, renamed and deleted when the upgraded
+	// binary loads the store at the height of a plan with a height
+	StoreUpgrades storetypes.StoreUpgrades
+
+	// Handler applies the upgrade, DefaultUpgradeHandler when nil
+	Handler UpgradeHandler
+}
+
+// UpgradeHandler applies the upgrade plan from the consensus versions of the modules
+// before the upgrade, and returns the consensus versions after the upgrade
+type UpgradeHandler func(ctx sdk.Context, app *TerraApp, plan upgrade.Plan, fromVM upgrade.VersionMap) (upgrade.VersionMap, error)
+
+// DefaultUpgradeHandler runs the in-place store migrations of the modules
+func DefaultUpgradeHandler(ctx sdk.Context, app *TerraApp, _ upgrade.Plan, fromVM upgrade.VersionMap) (upgrade.VersionMap, error) {
+	return app.RunMigrations(ctx, fromVM)
+}
+
+// Upgrades are the upgrades handled by the app; the chain halts at
+// the height of an upgrade plan the app has no upgrade for
+var Upgrades = []Upgrade{
+	// v0.5.0 adds the feegrant store, starts tracking the consensus versions,
+	// and migrates the stores of the modules from their initial versions
+	{
+		Name:          "v0.5.0",
+		StoreUpgrades: storetypes.StoreUpgrades{Added: []string{feegrant.StoreKey}},
+	},
+}
+
+// RunMigrations migrates the stores of the modules from the consensus versions
+// of fromVM, and returns the consensus versions of the modules of the app
+func (app *TerraApp) RunMigrations(ctx sdk.Context, fromVM upgrade.VersionMap) (upgrade.VersionMap, error) {
+	return app.migrations.RunMigrations(ctx, app.mm, fromVM)
+}
+
+// GetModuleVersions returns the consensus versions of the modules stored on chain
+func (app *TerraApp) GetModuleVersions(ctx sdk.Context) upgrade.VersionMap {
+	return app.versionKeeper.GetModuleVersions(ctx)
+}
+
+// registerMigrations registers the in-place store migrations of the modules
+func (app *TerraApp) registerMigrations() error {
+	for _, am := range app.mm.Modules {
+		if m, ok := am.(upgrade.HasMigrations); ok {
+			if err := m.RegisterMigrations(app.migrations); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// registerUpgrades registers the handlers of the upgrades, and the store
+// loader applying their store upgrades
+func (app *TerraApp) registerUpgrades(upgrades []Upgrade, skipUpgradeHeights map[int64]bool) error {
+	app.upgrades = make(map[string]Upgrade, len(upgrades))
+	for _, u := range upgrades {
+		if _, ok := app.upgrades[u.Name]; ok {
+			return fmt.Errorf("duplicate upgrade %s", u.Name)
+		}
+
+		app.upgrades[u.Name] = u
+		app.upgradeKeeper.SetUpgradeHandler(u.Name, app.upgradeHandler(u))
+	}
+
+	app.SetStoreLoader(app.upgradeStoreLoader(skipUpgradeHeights))
+	return nil
+}
+
+// upgradeHandler returns the handler of the upgrade keeper for the upgrade, which
+// runs the upgrade from the stored consensus versions and stores the new ones
+func (app *TerraApp) upgradeHandler(u Upgrade) upgrade.UpgradeHandler {
+	handler := u.Handler
+	if handler == nil {
+		handler = DefaultUpgradeHandler
+	}
+
+	return func(ctx sdk.Context, plan upgrade.Plan) {
+		fromVM := app.versionKeeper.GetModuleVersions(ctx)
+
+		// the versions were not tracked before the first upgrade, so all the
+		// modules but the ones of the added stores are at the initial version
+		if len(fromVM) == 0 {
+			for moduleName := range app.mm.Modules {
+				if !u.StoreUpgrades.IsAdded(moduleName) {
+					fromVM[moduleName] = upgrade.InitialConsensusVersion
+				}
+			}
+		}
+
+		toVM, err := handler(ctx, app, plan, fromVM)
+		if err != nil {
+			panic(fmt.Sprintf("failed to apply upgrade %s: %s", plan.Name, err))
+		}
+
+		app.versionKeeper.SetModuleVersions(ctx, toVM)
+	}
+}
+
+// upgradeStoreLoader returns the store loader loading the latest version of the store,
+// and applying the store upgrades of the upgrade planned at the next height, which is
+// the height the upgraded binary starts at
+func (app *TerraApp) upgradeStoreLoader(skipUpgradeHeights map[int64]bool) bam.StoreLoader {
+	return func(ms sdk.CommitMultiStore) error {
+		if err := ms.LoadLatestVersion(); err != nil {
+			return err
+		}
+
+		bz := ms.GetKVStore(app.keys[upgrade.StoreKey]).Get(upgrade.PlanKey())
+		if bz == nil {
+			return nil
+		}
+
+		var plan upgrade.Plan
+		if err := app.cdc.UnmarshalBinaryBare(bz, &plan); err != nil {
+			return err
+		}
+
+		u, ok := app.upgrades[plan.Name]
+		if !ok || plan.Height != ms.LastCommitID().Version+1 || skipUpgradeHeights[plan.Height] {
+			return nil
+		}
+
+		storeUpgrades := u.StoreUpgrades
+		if len(storeUpgrades.Added) == 0 && len(storeUpgrades.Renamed) == 0 && len(storeUpgrades.Deleted) == 0 {
+			return nil
+		}
+
+		// the stores are loaded again, as the plan is read from the loaded store
+		return ms.LoadLatestVersionAndUpgrade(&storeUpgrades)
+	}
+}
@@ -0,0 +1,313 @@
+package app
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/feegrant"
+	"github.com/terra-project/core/x/market"
+	"github.com/terra-project/core/x/msgauth"
+	"github.com/terra-project/core/x/oracle"
+	"github.com/terra-project/core/x/params"
+	"github.com/terra-project/core/x/treasury"
+	"github.com/terra-project/core/x/upgrade"
+	"github.com/terra-project/core/x/wasm"
+	wasmconfig "github.com/terra-project/core/x/wasm/config"
+)
+
+// storeFixture is the exported state of stores of a previous version of the app, as the
+// hex encoded key-value pairs of the stores, and the hex encoded values of the params
+// of the subspaces by their keys
+type storeFixture struct {
+	Stores map[string]map[string]string `json:"stores"`
+	Params map[string]map[string]string `json:"params"`
+}
+
+// upgradeTest is an app of which a block replaced stores by the state of a fixture
+// and scheduled an upgrade plan at the next height
+type upgradeTest struct {
+	app    *TerraApp
+	height int64
+}
+
+func loadStoreFixture(t *testing.T, fixture string) storeFixture {
+	bz, err := ioutil.ReadFile(filepath.Join("testdata", fixture))
+	require.NoError(t, err)
+
+	var state storeFixture
+	require.NoError(t, json.Unmarshal(bz, &state))
+	return state
+}
+
+// setupUpgradeTest initializes an app from the default genesis and replaces the stores and
+// the param subspaces of the fixture by its state, and the stored consensus versions by fromVM
+func setupUpgradeTest(t *testing.T, db dbm.DB, state storeFixture, fromVM upgrade.VersionMap, plan upgrade.Plan) upgradeTest {
+	tapp := NewTerraApp(log.NewNopLogger(), db, nil, true, 0, map[int64]bool{}, wasmconfig.DefaultConfig())
+	require.NoError(t, setGenesis(tapp))
+
+	height := tapp.LastBlockHeight() + 1
+	header := abci.Header{Height: height}
+	tapp.BeginBlock(abci.RequestBeginBlock{Header: header})
+	tapp.EndBlock(abci.RequestEndBlock{Height: height})
+
+	// the stores are replaced after the end blockers, so that they are committed as in the fixture
+	ctx := tapp.NewContext(false, header)
+
+	for name, kvs := range state.Stores {
+		store := ctx.KVStore(tapp.keys[name])
+		replaceStore(t, store, kvs, true)
+	}
+
+	for name, kvs := range state.Params {
+		store := prefix.NewStore(ctx.KVStore(tapp.keys[params.StoreKey]), []byte(name+"/"))
+		replaceStore(t, store, kvs, false)
+	}
+
+	tapp.versionKeeper.SetModuleVersions(ctx, fromVM)
+
+	plan.Height = height + 1
+	require.NoError(t, tapp.upgradeKeeper.ScheduleUpgrade(ctx, plan))
+	tapp.Commit()
+
+	return upgradeTest{app: tapp, height: plan.Height}
+}
+
+func replaceStore(t *testing.T, store sdk.KVStore, kvs map[string]string, hexKeys bool) {
+	iter := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for key, value := range kvs {
+		keyBz := []byte(key)
+		if hexKeys {
+			var err error
+			keyBz, err = hex.DecodeString(key)
+			require.NoError(t, err)
+		}
+
+		valueBz, err := hex.DecodeString(value)
+		require.NoError(t, err)
+		store.Set(keyBz, valueBz)
+	}
+}
+
+// runUpgrade runs the block at the height of the upgrade plan and returns the context after it
+func (ut upgradeTest) runUpgrade() sdk.Context {
+	header := abci.Header{Height: ut.height}
+	ut.app.BeginBlock(abci.RequestBeginBlock{Header: header})
+	ctx := ut.app.NewContext(false, header)
+	ut.app.EndBlock(abci.RequestEndBlock{Height: ut.height})
+	ut.app.Commit()
+
+	return ctx
+}
+
+func setUpgradeTestHome(t *testing.T) func() {
+	tempDir, err := ioutil.TempDir("", "upgradetest")
+	require.NoError(t, err)
+	viper.Set(flags.FlagHome, tempDir)
+
+	return func() { os.RemoveAll(tempDir) }
+}
+
+func TestWasmMigration1to2(t *testing.T) {
+	defer setUpgradeTestHome(t)()
+
+	// the fixture is the wasm store of a chain without tracked versions, with a code
+	// stored by alice and contracts instantiated by alice and bob
+	plan := upgrade.Plan{Name: "v0.5.0"}
+	ut := setupUpgradeTest(t, dbm.NewMemDB(), loadStoreFixture(t, "wasm_v1_store.json"), upgrade.VersionMap{}, plan)
+	tapp := ut.app
+
+	ctx := ut.runUpgrade()
+	require.Equal(t, ut.height, tapp.upgradeKeeper.GetDoneHeight(ctx, plan.Name))
+	require.Equal(t, upgrade.ConsensusVersions(tapp.mm), tapp.GetModuleVersions(ctx))
+	require.Equal(t, uint64(2), tapp.GetModuleVersions(ctx)[wasm.ModuleName])
+
+	// the params added in version 2 are set to their defaults
+	wasmParams := tapp.wasmKeeper.GetParams(ctx)
+	require.Equal(t, wasm.DefaultUploadAccess, wasmParams.UploadAccess)
+	require.Equal(t, wasm.DefaultMaxQueryDepth, wasmParams.MaxQueryDepth)
+	require.Equal(t, uint64(512000), wasmParams.MaxContractSize)
+
+	codeInfo, err := tapp.wasmKeeper.GetCodeInfo(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, wasm.AllowEverybody, codeInfo.InstantiatePermission)
+
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+	contracts := tapp.wasmKeeper.GetContractsByCode(ctx, 1, 1, 10)
+	require.Len(t, contracts, 2)
+	require.Len(t, tapp.wasmKeeper.GetContractsByOwner(ctx, alice, 1, 10), 1)
+	require.Len(t, tapp.wasmKeeper.GetContractsByOwner(ctx, bob, 1, 10), 1)
+
+	// the contract stores are kept, and the histories start with the current codes
+	for _, contract := range contracts {
+		models, _ := tapp.wasmKeeper.ListContractStore(ctx, contract, nil, nil, nil, 10)
+		require.Len(t, models, 1)
+		require.Equal(t, []byte("config"), models[0].Key.Bytes())
+
+		require.Equal(t, []wasm.ContractHistoryEntry{
+			wasm.NewContractHistoryEntry(wasm.ContractHistoryOperationGenesis, 1, ut.height, nil),
+		}, tapp.wasmKeeper.GetContractHistory(ctx, contract))
+	}
+}
+
+func TestV1BaselineMigrations(t *testing.T) {
+	defer setUpgradeTestHome(t)()
+
+	// the fixtures are the stores and the params of the oracle, market, treasury
+	// and msgauth modules of a chain at version 1, populated through their keepers;
+	// msgauth had no params at version 1
+	state := storeFixture{Stores: map[string]map[string]string{}, Params: map[string]map[string]string{}}
+	modules := []string{oracle.ModuleName, market.ModuleName, treasury.ModuleName, msgauth.ModuleName}
+	for _, name := range modules {
+		fixture := loadStoreFixture(t, name+"_v1_store.json")
+		state.Stores[name] = fixture.Stores[name]
+		if params, ok := fixture.Params[name]; ok {
+			state.Params[name] = params
+		}
+	}
+
+	ut := setupUpgradeTest(t, dbm.NewMemDB(), state, upgrade.VersionMap{}, upgrade.Plan{Name: "test"})
+	tapp := ut.app
+	ctx := tapp.NewContext(true, abci.Header{Height: ut.height})
+
+	fromVM := upgrade.ConsensusVersions(tapp.mm)
+	for _, name := range modules {
+		fromVM[name] = upgrade.InitialConsensusVersion
+	}
+
+	toVM, err := tapp.RunMigrations(ctx, fromVM)
+	require.NoError(t, err)
+	require.Equal(t, upgrade.ConsensusVersions(tapp.mm), toVM)
+
+	// the stores are kept as they are
+	for _, name := range modules {
+		kvs := make(map[string]string)
+		iter := ctx.KVStore(tapp.keys[name]).Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			kvs[hex.EncodeToString(iter.Key())] = hex.EncodeToString(iter.Value())
+		}
+		iter.Close()
+
+		require.Equal(t, state.Stores[name], kvs, name)
+	}
+
+	// and read by the keepers
+	val := sdk.ValAddress("validator___________")
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+
+	exchangeRate, err := tapp.oracleKeeper.GetLunaExchangeRate(ctx, core.MicroKRWDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDec(1000), exchangeRate)
+	require.Equal(t, sdk.AccAddress("feeder______________"), tapp.oracleKeeper.GetOracleDelegate(ctx, val))
+	require.Equal(t, int64(3), tapp.oracleKeeper.GetMissCounter(ctx, val))
+	_, err = tapp.oracleKeeper.GetAggregateExchangeRateVote(ctx, val)
+	require.NoError(t, err)
+	require.Equal(t, oracle.DefaultParams(), tapp.oracleKeeper.GetParams(ctx))
+
+	require.Equal(t, sdk.NewDec(-1000000), tapp.marketKeeper.GetTerraPoolDelta(ctx))
+	require.Equal(t, market.DefaultParams(), tapp.marketKeeper.GetParams(ctx))
+
+	require.Equal(t, sdk.NewDecWithPrec(5, 3), tapp.treasuryKeeper.GetTaxRate(ctx))
+	require.Equal(t, sdk.NewInt(1000), tapp.treasuryKeeper.GetTaxCap(ctx, core.MicroUSDDenom))
+	require.Equal(t, sdk.NewInt(1000000), tapp.treasuryKeeper.GetTSL(ctx, 0))
+	require.Equal(t, treasury.DefaultParams(), tapp.treasuryKeeper.GetParams(ctx))
+
+	grant, found := tapp.msgauthKeeper.GetGrant(ctx, alice, bob, "send")
+	require.True(t, found)
+	require.Equal(t, msgauth.NewSendAuthorization(sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1000000))), grant.Authorization)
+	require.Len(t, tapp.msgauthKeeper.GetGrantQueueTimeSlice(ctx, grant.Expiration), 1)
+
+	// the params added in version 2 are set to their defaults
+	require.Equal(t, uint64(2), toVM[msgauth.ModuleName])
+	require.Equal(t, msgauth.DefaultParams(), tapp.msgauthKeeper.GetParams(ctx))
+}
+
+func TestRunMigrations(t *testing.T) {
+	defer setUpgradeTestHome(t)()
+
+	ut := setupUpgradeTest(t, dbm.NewMemDB(), loadStoreFixture(t, "wasm_v1_store.json"), upgrade.VersionMap{}, upgrade.Plan{Name: "test"})
+	tapp := ut.app
+	ctx := tapp.NewContext(true, abci.Header{Height: ut.height})
+
+	fromVM := upgrade.ConsensusVersions(tapp.mm)
+	fromVM[wasm.ModuleName] = 1
+
+	// no migration registered
+	_, err := upgrade.NewMigrationRegistry().RunMigrations(ctx, tapp.mm, fromVM)
+	require.True(t, upgrade.ErrMissingMigration.Is(err))
+
+	// duplicate migration
+	err = tapp.migrations.RegisterMigration(wasm.ModuleName, 1, func(sdk.Context) error { return nil })
+	require.True(t, upgrade.ErrDuplicateMigration.Is(err))
+
+	// downgrade
+	fromVM[wasm.ModuleName] = 3
+	_, err = tapp.RunMigrations(ctx, fromVM)
+	require.True(t, upgrade.ErrInvalidVersion.Is(err))
+
+	// the modules missing in the versions are initialized from their default genesis
+	fromVM = upgrade.ConsensusVersions(tapp.mm)
+	delete(fromVM, feegrant.ModuleName)
+	toVM, err := tapp.RunMigrations(ctx, fromVM)
+	require.NoError(t, err)
+	require.Equal(t, upgrade.ConsensusVersions(tapp.mm), toVM)
+}
+
+func TestUpgradeStoreLoader(t *testing.T) {
+	defer setUpgradeTestHome(t)()
+
+	db := dbm.NewMemDB()
+	plan := upgrade.Plan{Name: "delete-feegrant"}
+	state := storeFixture{Stores: map[string]map[string]string{feegrant.StoreKey: {"01": "01"}}}
+	ut := setupUpgradeTest(t, db, state, upgrade.VersionMap{}, plan)
+
+	defer func(upgrades []Upgrade) { Upgrades = upgrades }(Upgrades)
+	Upgrades = []Upgrade{{
+		Name:          plan.Name,
+		StoreUpgrades: storetypes.StoreUpgrades{Deleted: []string{feegrant.StoreKey}},
+	}}
+
+	// the upgraded binary deletes the store when it starts at the upgrade height
+	ut.app = NewTerraApp(log.NewNopLogger(), db, nil, true, 0, map[int64]bool{}, wasmconfig.DefaultConfig())
+	require.Equal(t, ut.height-1, ut.app.LastBlockHeight())
+
+	ctx := ut.runUpgrade()
+	require.Equal(t, ut.height, ut.app.upgradeKeeper.GetDoneHeight(ctx, plan.Name))
+
+	// the deletion is committed, and the store is loaded without upgrades after the upgrade
+	tapp := NewTerraApp(log.NewNopLogger(), db, nil, true, 0, map[int64]bool{}, wasmconfig.DefaultConfig())
+	require.Equal(t, ut.height, tapp.LastBlockHeight())
+
+	ctx = tapp.NewContext(true, abci.Header{Height: ut.height})
+	iter := ctx.KVStore(tapp.keys[feegrant.StoreKey]).Iterator(nil, nil)
+	defer iter.Close()
+	require.False(t, iter.Valid())
+}
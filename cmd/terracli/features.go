@@ -0,0 +1,61 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+
+	"github.com/tendermint/go-amino"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+
+	"github.com/terra-project/core/app"
+	core "github.com/terra-project/core/types"
+)
+
+// getCmdQueryFeatures returns the query command of the statuses of the softfork features
+func getCmdQueryFeatures(cdc *amino.Codec) *cobra.Command {
+	return flags.GetCommands(&cobra.Command{
+		Use:   "features",
+		Args:  cobra.NoArgs,
+		Short: "Query the activation heights of the softfork features and whether they are active",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s", app.QuerierRouteFeatures), nil)
+			if err != nil {
+				return err
+			}
+
+			var statuses []core.FeatureStatus
+			cdc.MustUnmarshalJSON(res, &statuses)
+			return cliCtx.PrintOutput(statuses)
+		},
+	})[0]
+}
+
+func registerFeaturesRoute(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/features", queryFeaturesHandlerFn(cliCtx)).Methods("GET")
+}
+
+func queryFeaturesHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s", app.QuerierRouteFeatures), nil)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
@@ -108,13 +108,17 @@ func queryCmd(cdc *amino.Codec) *cobra.Command {
 		Short:   "Querying subcommands",
 	}
 
+	accountCmd := authcmd.GetAccountCmd(cdc)
+	accountCmd.AddCommand(flags.GetCommands(tauthcmd.GetCmdQueryVestingSchedule(cdc))...)
+
 	queryCmd.AddCommand(
-		authcmd.GetAccountCmd(cdc),
+		accountCmd,
 		flags.LineBreak,
 		rpc.ValidatorCommand(cdc),
 		rpc.BlockCommand(),
 		authcmd.QueryTxsByEventsCmd(cdc),
 		authcmd.QueryTxCmd(cdc),
+		getCmdQueryFeatures(cdc),
 		flags.LineBreak,
 	)
 
@@ -167,6 +171,7 @@ func registerRoutes(rs *lcd.RestServer) {
 	client.RegisterRoutes(rs.CliCtx, rs.Mux)
 	tauthrest.RegisterRoutes(rs.CliCtx, rs.Mux)
 	authrest.RegisterTxRoutes(rs.CliCtx, rs.Mux)
+	registerFeaturesRoute(rs.CliCtx, rs.Mux)
 	app.ModuleBasics.RegisterRESTRoutes(rs.CliCtx, rs.Mux)
 }
 
@@ -0,0 +1,147 @@
+package main
+
+// DONTCOVER
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/app"
+	wasmconfig "github.com/terra-project/core/x/wasm/config"
+)
+
+const (
+	flagHeight        = "height"
+	flagForZeroHeight = "for-zero-height"
+	flagJailWhitelist = "jail-whitelist"
+	flagModules       = "modules"
+	flagCodeDir       = "code-dir"
+	flagOutput        = "output"
+)
+
+// exportCmd dumps the app state to JSON, replacing the export command of the server
+// to export the state of a past height and a part of the modules without holding
+// the whole state in memory
+func exportCmd(ctx *server.Context, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export state to JSON",
+		Long: `Export the state of the modules to a genesis JSON, streamed to the output.
+
+The state of a past height is loaded from the application store, unless it is pruned.
+With --for-zero-height, the state is prepared to start at height zero and the wasm
+codes are written to the code dir, which the exported wasm genesis references by
+their paths instead of holding the code bytes; the code files must be available at
+the same paths for the nodes starting from the genesis.
+
+Example:
+	terrad export --height 100000 --modules oracle,wasm,market --output state.json
+	terrad export --for-zero-height --code-dir ./wasm_codes --output genesis.json
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(flags.FlagHome))
+
+			db, err := sdk.NewLevelDB("application", filepath.Join(config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			out := io.Writer(os.Stdout)
+			if output := viper.GetString(flagOutput); output != "" {
+				file, err := os.Create(output)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+
+				out = file
+			}
+
+			if db.Stats()["leveldb.sstables"] == "" {
+				if _, err := fmt.Fprintln(os.Stderr, "WARNING: State is not initialized. Returning genesis file."); err != nil {
+					return err
+				}
+
+				genesis, err := ioutil.ReadFile(config.GenesisFile())
+				if err != nil {
+					return err
+				}
+
+				_, err = out.Write(genesis)
+				return err
+			}
+
+			height := viper.GetInt64(flagHeight)
+			opts := app.ExportOptions{
+				ForZeroHeight: viper.GetBool(flagForZeroHeight),
+				JailWhiteList: viper.GetStringSlice(flagJailWhitelist),
+				Modules:       viper.GetStringSlice(flagModules),
+			}
+
+			if opts.ForZeroHeight {
+				opts.CodeDir = viper.GetString(flagCodeDir)
+			}
+
+			tApp := app.NewTerraApp(ctx.Logger, db, nil, height == -1, uint(1), map[int64]bool{}, wasmconfig.DefaultConfig())
+			if height != -1 {
+				if err := tApp.LoadHeight(height); err != nil {
+					return fmt.Errorf("failed to load height %d, which may be pruned: %w", height, err)
+				}
+			}
+
+			doc, err := tmtypes.GenesisDocFromFile(config.GenesisFile())
+			if err != nil {
+				return err
+			}
+
+			// the app state is streamed first, as the validators are known after it
+			w := bufio.NewWriter(out)
+			if _, err := w.WriteString(`{"app_state":`); err != nil {
+				return err
+			}
+
+			validators, err := tApp.StreamAppState(w, opts)
+			if err != nil {
+				return fmt.Errorf("error exporting state: %v", err)
+			}
+
+			doc.AppState = nil
+			doc.Validators = validators
+
+			encoded, err := cdc.MarshalJSON(doc)
+			if err != nil {
+				return err
+			}
+
+			if _, err := fmt.Fprintf(w, ",%s\n", sdk.MustSortJSON(encoded)[1:]); err != nil {
+				return err
+			}
+
+			return w.Flush()
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, -1, "Export state from a particular height (-1 means latest height)")
+	cmd.Flags().Bool(flagForZeroHeight, false, "Export state to start at height zero (perform preproccessing)")
+	cmd.Flags().StringSlice(flagJailWhitelist, []string{}, "List of validators to not jail state export")
+	cmd.Flags().StringSlice(flagModules, []string{}, "Modules to export, all the modules by default (e.g. oracle,wasm,market)")
+	cmd.Flags().String(flagCodeDir, "wasm_codes", "Directory the wasm codes are written to with --for-zero-height, referenced by their absolute paths")
+	cmd.Flags().String(flagOutput, "", "File to write the exported state to, stdout by default")
+	return cmd
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -90,72 +91,17 @@ $ %s add-genesis-account acc1 '10000000000uluna,1000000ukrw'
 				return err
 			}
 
-			var genAcc authexported.GenesisAccount
-			if len(args) == 2 {
-				// Build normal account
-				acc := types.NewBaseAccountWithAddress(addr)
-				acc.Coins = coins
-
-				genAcc = &acc
-				if err := genAcc.Validate(); err != nil {
-					return err
-				}
-			} else {
-				// Build vesting account
-				genesisTime := genDoc.GenesisTime
-				vestingSchedulesDenomMap := make(map[string]*vesting.VestingSchedule)
-				unparsedSchedules := strings.Split(args[2], ",")
-				for _, unparsedSchedule := range unparsedSchedules {
-					items := strings.Split(unparsedSchedule, "|")
-					if len(items) != 4 {
-						return errors.New("vesting schedule parse error")
-					}
-
-					denom := items[0]
-					startDay, err := strconv.Atoi(items[1])
-					if err != nil {
-						return err
-					}
-					endDay, err := strconv.Atoi(items[2])
-					if err != nil {
-						return err
-					}
-					ratio, err := sdk.NewDecFromStr(items[3])
-					if err != nil {
-						return err
-					}
-
-					lazySchedule := vesting.LazySchedule{
-						StartTime: genesisTime.AddDate(0, 0, startDay).UTC().Unix(),
-						EndTime:   genesisTime.AddDate(0, 0, endDay).UTC().Unix(),
-						Ratio:     ratio,
-					}
-
-					if vs, ok := vestingSchedulesDenomMap[denom]; ok {
-						vs.LazySchedules = append(vs.LazySchedules, lazySchedule)
-					} else {
-						vestingSchedulesDenomMap[denom] = &vesting.VestingSchedule{Denom: denom, LazySchedules: vesting.LazySchedules{lazySchedule}}
-					}
-				}
-
-				vestingSchedules := vesting.VestingSchedules{}
-				for denom, schedule := range vestingSchedulesDenomMap {
-					schedule.Denom = denom
-
-					vestingSchedules = append(vestingSchedules, *schedule)
-				}
-
-				acc := types.NewBaseAccountWithAddress(addr)
-				acc.Coins = coins
-				baseVestingAcc, err := vesting.NewBaseVestingAccount(&acc, acc.Coins, 0)
+			var vestingSchedules vesting.VestingSchedules
+			if len(args) == 3 {
+				vestingSchedules, err = parseVestingSchedules(args[2], genDoc.GenesisTime)
 				if err != nil {
 					return err
 				}
+			}
 
-				genAcc = vesting.NewLazyGradedVestingAccountRaw(baseVestingAcc, vestingSchedules)
-				if err := genAcc.Validate(); err != nil {
-					return err
-				}
+			genAcc, err := newGenesisAccount(addr, coins, vestingSchedules)
+			if err != nil {
+				return err
 			}
 
 			// Add genesis account to the app state
@@ -188,3 +134,82 @@ $ %s add-genesis-account acc1 '10000000000uluna,1000000ukrw'
 	cmd.Flags().String(flagClientHome, defaultClientHome, "client's home directory")
 	return cmd
 }
+
+// parseVestingSchedules parses comma separated vesting schedules in the format of
+// denom|start|end|ratio, where start and end are numbers of days from the genesis.
+// The lazy schedules of a denom keep the given order.
+func parseVestingSchedules(unparsedSchedules string, genesisTime time.Time) (vesting.VestingSchedules, error) {
+	var vestingSchedules vesting.VestingSchedules
+	for _, unparsedSchedule := range strings.Split(unparsedSchedules, ",") {
+		items := strings.Split(strings.TrimSpace(unparsedSchedule), "|")
+		if len(items) != 4 {
+			return nil, errors.New("vesting schedule parse error")
+		}
+
+		startDay, err := strconv.Atoi(items[1])
+		if err != nil {
+			return nil, err
+		}
+
+		endDay, err := strconv.Atoi(items[2])
+		if err != nil {
+			return nil, err
+		}
+
+		ratio, err := sdk.NewDecFromStr(items[3])
+		if err != nil {
+			return nil, err
+		}
+
+		vestingSchedules = appendLazySchedule(vestingSchedules, items[0], vesting.LazySchedule{
+			StartTime: genesisTime.AddDate(0, 0, startDay).UTC().Unix(),
+			EndTime:   genesisTime.AddDate(0, 0, endDay).UTC().Unix(),
+			Ratio:     ratio,
+		})
+	}
+
+	return vestingSchedules, nil
+}
+
+// appendLazySchedule appends the lazy schedule to the vesting schedule of the denom
+func appendLazySchedule(vestingSchedules vesting.VestingSchedules, denom string, lazySchedule vesting.LazySchedule) vesting.VestingSchedules {
+	for i, vs := range vestingSchedules {
+		if vs.Denom == denom {
+			vestingSchedules[i].LazySchedules = append(vs.LazySchedules, lazySchedule)
+			return vestingSchedules
+		}
+	}
+
+	return append(vestingSchedules, vesting.VestingSchedule{Denom: denom, LazySchedules: vesting.LazySchedules{lazySchedule}})
+}
+
+// newGenesisAccount returns a validated base account, or a lazy graded vesting
+// account vesting all the coins when the vesting schedules are given
+func newGenesisAccount(addr sdk.AccAddress, coins sdk.Coins, vestingSchedules vesting.VestingSchedules) (authexported.GenesisAccount, error) {
+	acc := types.NewBaseAccountWithAddress(addr)
+	acc.Coins = coins
+
+	var genAcc authexported.GenesisAccount = &acc
+	if len(vestingSchedules) != 0 {
+		for _, vestingSchedule := range vestingSchedules {
+			for _, lazySchedule := range vestingSchedule.LazySchedules {
+				if err := lazySchedule.Validate(); err != nil {
+					return nil, fmt.Errorf("invalid vesting schedule of %s: %w", vestingSchedule.Denom, err)
+				}
+			}
+		}
+
+		baseVestingAcc, err := vesting.NewBaseVestingAccount(&acc, acc.Coins, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		genAcc = vesting.NewLazyGradedVestingAccountRaw(baseVestingAcc, vestingSchedules)
+	}
+
+	if err := genAcc.Validate(); err != nil {
+		return nil, err
+	}
+
+	return genAcc, nil
+}
@@ -0,0 +1,310 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/tendermint/tendermint/libs/cli"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/server"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/genutil"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/terra-project/core/x/auth/vesting"
+)
+
+const (
+	flagFile   = "file"
+	flagFormat = "format"
+
+	formatCSV  = "csv"
+	formatJSON = "json"
+)
+
+// genesisAccountRecord is a genesis account of the JSON import file;
+// start and end of the schedules are numbers of days from the genesis
+type genesisAccountRecord struct {
+	Address   string                  `json:"address"`
+	Coins     string                  `json:"coins"`
+	Schedules []genesisScheduleRecord `json:"schedules,omitempty"`
+}
+
+type genesisScheduleRecord struct {
+	Denom string `json:"denom"`
+	Start int    `json:"start"`
+	End   int    `json:"end"`
+	Ratio string `json:"ratio"`
+}
+
+// AddGenesisAccountsCmd returns add-genesis-accounts cobra Command.
+func AddGenesisAccountsCmd(ctx *server.Context, cdc *codec.Codec, defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-genesis-accounts --file [file]",
+		Short: "Add genesis accounts in bulk from a CSV or JSON file to genesis.json",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Add genesis accounts and vesting accounts from a CSV or JSON file to genesis.json.
+The file is read row by row, every row is validated, duplicate addresses in the file
+or the genesis are rejected, and the genesis is written once after all rows are added.
+When the genesis has a total supply, the coins of the accounts are added to it.
+'start' and 'end' of the vesting schedules are numbers of days from the genesis.
+
+A CSV file has the columns address, coins and optional schedules, with an optional header
+and '#' comments,
+
+address,coins,schedules
+terra1...,"10000000000uluna,1000000ukrw","uluna|30|60|0.1,uluna|60|90|0.9,ukrw|0|30|1"
+terra1...,1000000uluna,
+
+A JSON file is an array of accounts,
+
+[
+  {"address": "terra1...", "coins": "10000000000uluna,1000000ukrw",
+   "schedules": [{"denom": "uluna", "start": 30, "end": 60, "ratio": "0.1"}, ...]},
+  {"address": "terra1...", "coins": "1000000uluna"}
+]
+
+Example:
+$ %s add-genesis-accounts --file accounts.csv
+`, version.ServerName),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			config := ctx.Config
+			config.SetRoot(viper.GetString(cli.HomeFlag))
+
+			fileName := viper.GetString(flagFile)
+			if fileName == "" {
+				return errors.New("--file is required")
+			}
+
+			format := viper.GetString(flagFormat)
+			if format == "" {
+				format = strings.TrimPrefix(strings.ToLower(filepath.Ext(fileName)), ".")
+			}
+
+			// retrieve the app state
+			genFile := config.GenesisFile()
+			appState, genDoc, err := genutil.GenesisStateFromGenFile(cdc, genFile)
+			if err != nil {
+				return err
+			}
+
+			file, err := os.Open(fileName)
+			if err != nil {
+				return err
+			}
+			defer file.Close()
+
+			count, totalCoins, err := addGenesisAccounts(cdc, appState, bufio.NewReader(file), format, genDoc.GenesisTime)
+			if err != nil {
+				return err
+			}
+
+			appStateJSON, err := cdc.MarshalJSON(appState)
+			if err != nil {
+				return err
+			}
+
+			// export app state
+			genDoc.AppState = appStateJSON
+			if err := genutil.ExportGenesisFile(genDoc, genFile); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.ErrOrStderr(), "added %d genesis accounts with %s\n", count, totalCoins)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(cli.HomeFlag, defaultNodeHome, "node's home directory")
+	cmd.Flags().String(flagFile, "", "CSV or JSON file of the genesis accounts")
+	cmd.Flags().String(flagFormat, "", "format of the file (csv|json), detected from the file extension by default")
+	return cmd
+}
+
+// addGenesisAccounts adds the genesis accounts read from the file of the format to the app state,
+// rejecting the addresses duplicated in the file or the genesis, and returns the number of
+// the accounts and their coins; the coins are added to the total supply when it is given, as
+// an empty supply is computed from the accounts at the genesis
+func addGenesisAccounts(cdc *codec.Codec, appState map[string]json.RawMessage, r io.Reader,
+	format string, genesisTime time.Time) (int, sdk.Coins, error) {
+	var genesisState auth.GenesisState
+	cdc.MustUnmarshalJSON(appState[auth.ModuleName], &genesisState)
+
+	addresses := make(map[string]bool, len(genesisState.Accounts))
+	for _, acc := range genesisState.Accounts {
+		addresses[acc.GetAddress().String()] = true
+	}
+
+	var totalCoins sdk.Coins
+	addAccount := func(row int, genAcc authexported.GenesisAccount) error {
+		addr := genAcc.GetAddress().String()
+		if addresses[addr] {
+			return fmt.Errorf("row %d: duplicate address %s", row, addr)
+		}
+
+		addresses[addr] = true
+		genesisState.Accounts = append(genesisState.Accounts, genAcc)
+		totalCoins = totalCoins.Add(genAcc.GetCoins()...)
+		return nil
+	}
+
+	var count int
+	var err error
+	switch format {
+	case formatCSV:
+		count, err = readGenesisAccountsCSV(r, genesisTime, addAccount)
+	case formatJSON:
+		count, err = readGenesisAccountsJSON(r, genesisTime, addAccount)
+	default:
+		return 0, nil, fmt.Errorf("unknown file format %q, use --%s %s|%s", format, flagFormat, formatCSV, formatJSON)
+	}
+
+	if err != nil {
+		return count, nil, err
+	}
+
+	appState[auth.ModuleName] = cdc.MustMarshalJSON(genesisState)
+
+	var supplyGenesisState supply.GenesisState
+	cdc.MustUnmarshalJSON(appState[supply.ModuleName], &supplyGenesisState)
+	if !supplyGenesisState.Supply.Empty() {
+		supplyGenesisState.Supply = supplyGenesisState.Supply.Add(totalCoins...)
+		appState[supply.ModuleName] = cdc.MustMarshalJSON(supplyGenesisState)
+	}
+
+	return count, totalCoins, nil
+}
+
+// readGenesisAccountsCSV reads the genesis accounts row by row from the CSV and
+// passes them to addAccount, returning the number of the accounts
+func readGenesisAccountsCSV(r io.Reader, genesisTime time.Time,
+	addAccount func(int, authexported.GenesisAccount) error) (int, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	reader.ReuseRecord = true
+
+	count := 0
+	for row := 1; ; row++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			return count, nil
+		}
+
+		if err != nil {
+			return count, err
+		}
+
+		// skip the header
+		if row == 1 && strings.EqualFold(strings.TrimSpace(record[0]), "address") {
+			continue
+		}
+
+		if len(record) < 2 || len(record) > 3 {
+			return count, fmt.Errorf("row %d: expected address, coins and optional schedules columns", row)
+		}
+
+		var vestingSchedules vesting.VestingSchedules
+		if len(record) == 3 && strings.TrimSpace(record[2]) != "" {
+			vestingSchedules, err = parseVestingSchedules(record[2], genesisTime)
+			if err != nil {
+				return count, fmt.Errorf("row %d: %w", row, err)
+			}
+		}
+
+		genAcc, err := parseGenesisAccount(record[0], record[1], vestingSchedules)
+		if err != nil {
+			return count, fmt.Errorf("row %d: %w", row, err)
+		}
+
+		if err := addAccount(row, genAcc); err != nil {
+			return count, err
+		}
+
+		count++
+	}
+}
+
+// readGenesisAccountsJSON streams the genesis accounts from the JSON array and
+// passes them to addAccount, returning the number of the accounts
+func readGenesisAccountsJSON(r io.Reader, genesisTime time.Time,
+	addAccount func(int, authexported.GenesisAccount) error) (int, error) {
+	decoder := json.NewDecoder(r)
+	if token, err := decoder.Token(); err != nil {
+		return 0, err
+	} else if delim, ok := token.(json.Delim); !ok || delim != '[' {
+		return 0, errors.New("genesis accounts file must be a JSON array")
+	}
+
+	count := 0
+	for row := 1; decoder.More(); row++ {
+		var record genesisAccountRecord
+		if err := decoder.Decode(&record); err != nil {
+			return count, fmt.Errorf("row %d: %w", row, err)
+		}
+
+		var vestingSchedules vesting.VestingSchedules
+		for _, schedule := range record.Schedules {
+			ratio, err := sdk.NewDecFromStr(schedule.Ratio)
+			if err != nil {
+				return count, fmt.Errorf("row %d: %w", row, err)
+			}
+
+			vestingSchedules = appendLazySchedule(vestingSchedules, schedule.Denom, vesting.LazySchedule{
+				StartTime: genesisTime.AddDate(0, 0, schedule.Start).UTC().Unix(),
+				EndTime:   genesisTime.AddDate(0, 0, schedule.End).UTC().Unix(),
+				Ratio:     ratio,
+			})
+		}
+
+		genAcc, err := parseGenesisAccount(record.Address, record.Coins, vestingSchedules)
+		if err != nil {
+			return count, fmt.Errorf("row %d: %w", row, err)
+		}
+
+		if err := addAccount(row, genAcc); err != nil {
+			return count, err
+		}
+
+		count++
+	}
+
+	if _, err := decoder.Token(); err != nil {
+		return count, err
+	}
+
+	return count, nil
+}
+
+func parseGenesisAccount(address, coins string, vestingSchedules vesting.VestingSchedules) (authexported.GenesisAccount, error) {
+	addr, err := sdk.AccAddressFromBech32(strings.TrimSpace(address))
+	if err != nil {
+		return nil, err
+	}
+
+	parsedCoins, err := sdk.ParseCoins(strings.TrimSpace(coins))
+	if err != nil {
+		return nil, err
+	}
+
+	return newGenesisAccount(addr, parsedCoins, vestingSchedules)
+}
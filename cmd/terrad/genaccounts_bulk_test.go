@@ -0,0 +1,189 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	"github.com/terra-project/core/app"
+	"github.com/terra-project/core/x/auth/vesting"
+)
+
+var (
+	genesisTime = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	addr1 = sdk.AccAddress([]byte("genesis_account_adr1"))
+	addr2 = sdk.AccAddress([]byte("genesis_account_adr2"))
+)
+
+// collectAccounts returns addAccount rejecting the duplicate addresses, like addGenesisAccounts
+func collectAccounts(accs *[]authexported.GenesisAccount) func(int, authexported.GenesisAccount) error {
+	addresses := make(map[string]bool)
+	return func(row int, genAcc authexported.GenesisAccount) error {
+		addr := genAcc.GetAddress().String()
+		if addresses[addr] {
+			return fmt.Errorf("row %d: duplicate address %s", row, addr)
+		}
+
+		addresses[addr] = true
+		*accs = append(*accs, genAcc)
+		return nil
+	}
+}
+
+func TestReadGenesisAccountsCSV(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		accounts int
+		vesting  []bool
+		err      string
+	}{
+		{"header", "address,coins,schedules\n" + addr1.String() + ",100uluna,\n", 1, []bool{false}, ""},
+		{"no header", addr1.String() + ",100uluna\n" + addr2.String() + ",\"100uluna,10ukrw\"\n", 2, []bool{false, false}, ""},
+		{"comment lines", "# accounts\n" + addr1.String() + ",100uluna\n# vesting accounts\n" +
+			addr2.String() + ",100uluna,\"uluna|0|30|0.5,uluna|30|60|0.5\"\n", 2, []bool{false, true}, ""},
+		{"duplicate address", addr1.String() + ",100uluna\n" + addr1.String() + ",10uluna\n", 1, nil, "row 2: duplicate address"},
+		{"missing coins", addr1.String() + "\n", 0, nil, "row 1: expected address"},
+		{"bad address", "terra1invalid,100uluna\n", 0, nil, "row 1:"},
+		{"bad coins", addr1.String() + ",100\n", 0, nil, "row 1:"},
+		{"bad schedule format", addr1.String() + ",100uluna,uluna|0|30\n", 0, nil, "row 1: vesting schedule parse error"},
+		{"schedule end before start", addr1.String() + ",100uluna,uluna|30|0|1\n", 0, nil, "row 1: invalid vesting schedule"},
+		{"schedule ratio not one", addr1.String() + ",100uluna,uluna|0|30|0.5\n", 0, nil, "row 1: vesting total ratio must be one"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var accs []authexported.GenesisAccount
+			count, err := readGenesisAccountsCSV(strings.NewReader(tc.input), genesisTime, collectAccounts(&accs))
+			if tc.err != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.err)
+			} else {
+				require.NoError(t, err)
+				for i, acc := range accs {
+					_, ok := acc.(*vesting.LazyGradedVestingAccount)
+					require.Equal(t, tc.vesting[i], ok)
+				}
+			}
+
+			require.Equal(t, tc.accounts, count)
+			require.Len(t, accs, tc.accounts)
+		})
+	}
+}
+
+func TestReadGenesisAccountsJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		accounts int
+		vesting  []bool
+		err      string
+	}{
+		{"accounts", `[{"address": "` + addr1.String() + `", "coins": "100uluna"},
+			{"address": "` + addr2.String() + `", "coins": "100uluna,10ukrw", "schedules": [
+				{"denom": "uluna", "start": 0, "end": 30, "ratio": "0.5"},
+				{"denom": "uluna", "start": 30, "end": 60, "ratio": "0.5"}]}]`, 2, []bool{false, true}, ""},
+		{"empty array", `[]`, 0, nil, ""},
+		{"not an array", `{"address": "` + addr1.String() + `", "coins": "100uluna"}`, 0, nil, "must be a JSON array"},
+		{"duplicate address", `[{"address": "` + addr1.String() + `", "coins": "100uluna"},
+			{"address": "` + addr1.String() + `", "coins": "10uluna"}]`, 1, nil, "row 2: duplicate address"},
+		{"bad address", `[{"address": "terra1invalid", "coins": "100uluna"}]`, 0, nil, "row 1:"},
+		{"bad ratio", `[{"address": "` + addr1.String() + `", "coins": "100uluna", "schedules": [
+			{"denom": "uluna", "start": 0, "end": 30, "ratio": "one"}]}]`, 0, nil, "row 1:"},
+		{"schedule end before start", `[{"address": "` + addr1.String() + `", "coins": "100uluna", "schedules": [
+			{"denom": "uluna", "start": 30, "end": 0, "ratio": "1"}]}]`, 0, nil, "row 1: invalid vesting schedule"},
+		{"schedule ratio not one", `[{"address": "` + addr1.String() + `", "coins": "100uluna", "schedules": [
+			{"denom": "uluna", "start": 0, "end": 30, "ratio": "0.5"}]}]`, 0, nil, "row 1: vesting total ratio must be one"},
+		{"unterminated array", `[{"address": "` + addr1.String() + `", "coins": "100uluna"}`, 1, nil, "row 2: unexpected end of JSON input"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var accs []authexported.GenesisAccount
+			count, err := readGenesisAccountsJSON(strings.NewReader(tc.input), genesisTime, collectAccounts(&accs))
+			if tc.err != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.err)
+			} else {
+				require.NoError(t, err)
+				for i, acc := range accs {
+					_, ok := acc.(*vesting.LazyGradedVestingAccount)
+					require.Equal(t, tc.vesting[i], ok)
+				}
+			}
+
+			require.Equal(t, tc.accounts, count)
+			require.Len(t, accs, tc.accounts)
+		})
+	}
+}
+
+func TestReadGenesisAccountsSchedules(t *testing.T) {
+	var accs []authexported.GenesisAccount
+	_, err := readGenesisAccountsCSV(strings.NewReader(addr1.String()+",100uluna,\"uluna|30|60|1\"\n"),
+		genesisTime, collectAccounts(&accs))
+	require.NoError(t, err)
+	require.Len(t, accs, 1)
+
+	acc, ok := accs[0].(*vesting.LazyGradedVestingAccount)
+	require.True(t, ok)
+	schedule, found := acc.GetVestingSchedule("uluna")
+	require.True(t, found)
+	require.Equal(t, genesisTime.AddDate(0, 0, 30).Unix(), schedule.LazySchedules[0].StartTime)
+	require.Equal(t, genesisTime.AddDate(0, 0, 60).Unix(), schedule.LazySchedules[0].EndTime)
+}
+
+func TestAddGenesisAccounts(t *testing.T) {
+	cdc := app.MakeCodec()
+	csv := addr1.String() + ",100uluna\n" + addr2.String() + ",\"100uluna,10ukrw\"\n"
+
+	tests := []struct {
+		name   string
+		supply sdk.Coins
+		expect sdk.Coins
+	}{
+		// an empty supply is computed from the accounts at the genesis
+		{"empty supply", sdk.Coins{}, sdk.Coins{}},
+		{"given supply", sdk.NewCoins(sdk.NewInt64Coin("uluna", 1000)),
+			sdk.NewCoins(sdk.NewInt64Coin("uluna", 1200), sdk.NewInt64Coin("ukrw", 10))},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			appState := app.ModuleBasics.DefaultGenesis()
+			appState[supply.ModuleName] = cdc.MustMarshalJSON(supply.NewGenesisState(tc.supply))
+
+			count, totalCoins, err := addGenesisAccounts(cdc, appState, strings.NewReader(csv), formatCSV, genesisTime)
+			require.NoError(t, err)
+			require.Equal(t, 2, count)
+			require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uluna", 200), sdk.NewInt64Coin("ukrw", 10)), totalCoins)
+
+			var authGenesisState auth.GenesisState
+			cdc.MustUnmarshalJSON(appState[auth.ModuleName], &authGenesisState)
+			require.Len(t, authGenesisState.Accounts, 2)
+
+			var supplyGenesisState supply.GenesisState
+			cdc.MustUnmarshalJSON(appState[supply.ModuleName], &supplyGenesisState)
+			require.True(t, tc.expect.IsEqual(supplyGenesisState.Supply) ||
+				(tc.expect.Empty() && supplyGenesisState.Supply.Empty()))
+
+			// the accounts are in the genesis now
+			_, _, err = addGenesisAccounts(cdc, appState, strings.NewReader(addr1.String()+",1uluna\n"), formatCSV, genesisTime)
+			require.Error(t, err)
+			require.Contains(t, err.Error(), "row 1: duplicate address")
+		})
+	}
+
+	_, _, err := addGenesisAccounts(cdc, app.ModuleBasics.DefaultGenesis(), strings.NewReader(csv), "xml", genesisTime)
+	require.Error(t, err)
+}
@@ -63,6 +63,7 @@ func main() {
 		auth.GenesisAccountIterator{}, app.DefaultNodeHome, app.DefaultCLIHome))
 	rootCmd.AddCommand(genutilcli.ValidateGenesisCmd(ctx, cdc, app.ModuleBasics))
 	rootCmd.AddCommand(AddGenesisAccountCmd(ctx, cdc, app.DefaultNodeHome, app.DefaultCLIHome))
+	rootCmd.AddCommand(AddGenesisAccountsCmd(ctx, cdc, app.DefaultNodeHome))
 	rootCmd.AddCommand(flags.NewCompletionCmd(rootCmd, true))
 	rootCmd.AddCommand(testnetCmd(ctx, cdc, app.ModuleBasics, auth.GenesisAccountIterator{}))
 	rootCmd.AddCommand(replayCmd())
@@ -70,6 +71,12 @@ func main() {
 
 	server.AddCommands(ctx, cdc, rootCmd, newApp, exportAppStateAndTMValidators)
 
+	// replace the export command of the server with the streaming one
+	if cmd, _, err := rootCmd.Find([]string{"export"}); err == nil && cmd != rootCmd {
+		rootCmd.RemoveCommand(cmd)
+	}
+	rootCmd.AddCommand(exportCmd(ctx, cdc))
+
 	// prepare and add flags
 	executor := cli.PrepareBaseCmd(rootCmd, "TE", app.DefaultNodeHome)
 	rootCmd.PersistentFlags().UintVar(&invCheckPeriod, flagInvCheckPeriod,
@@ -80,6 +87,11 @@ func main() {
 		"Transaction hard cap to prevent spamming attack")
 	viper.BindPFlag(coreante.FlagTxGasHardLimit, rootCmd.Flags().Lookup(coreante.FlagTxGasHardLimit))
 
+	// register gas price base denom flag, which can be also set in app.toml
+	rootCmd.PersistentFlags().String(coreante.FlagGasPriceBaseDenom, "",
+		"Convert whitelisted fee coins to this denom with oracle exchange rates before checking minimum gas prices (e.g. uluna)")
+	viper.BindPFlag(coreante.FlagGasPriceBaseDenom, rootCmd.PersistentFlags().Lookup(coreante.FlagGasPriceBaseDenom))
+
 	err := executor.Execute()
 	if err != nil {
 		panic(err)
@@ -103,6 +115,21 @@ func newApp(logger log.Logger, db dbm.DB, traceStore io.Writer) abci.Application
 		panic(err)
 	}
 
+	// the genesis file of config.toml is relative to the home
+	genFile := viper.GetString("genesis_file")
+	if genFile != "" && !filepath.IsAbs(genFile) {
+		genFile = filepath.Join(viper.GetString(cli.HomeFlag), genFile)
+	}
+
+	softforks, err := app.ReadFeatureHeightsConfig(filepath.Join(viper.GetString(cli.HomeFlag), "config", "app.toml"))
+	if err != nil {
+		panic(err)
+	}
+
+	if err := app.LoadFeatureHeights(core.DefaultFeatureRegistry, genFile, softforks); err != nil {
+		panic(err)
+	}
+
 	return app.NewTerraApp(
 		logger, db, traceStore, true, invCheckPeriod, skipUpgradeHeights,
 		&wasmconfig.Config{BaseConfig: wasmconfig.BaseConfig{
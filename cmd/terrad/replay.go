@@ -1,23 +1,33 @@
 package main
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"syscall"
 	"time"
 
 	cpm "github.com/otiai10/copy"
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
 	tmos "github.com/tendermint/tendermint/libs/os"
+	"github.com/tendermint/tendermint/mock"
 	"github.com/tendermint/tendermint/proxy"
 	tmsm "github.com/tendermint/tendermint/state"
 	tmstore "github.com/tendermint/tendermint/store"
 	tm "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
 
 	"github.com/terra-project/core/app"
+	core "github.com/terra-project/core/types"
 	wasmconfig "github.com/terra-project/core/x/wasm/config"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
@@ -26,74 +36,174 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
+const (
+	flagCopyDataDir        = "copy-data-dir"
+	flagEndHeight          = "end-height"
+	flagCheckpointInterval = "checkpoint-interval"
+	flagMismatchReport     = "mismatch-report"
+	flagStateDiffDir       = "state-diff-dir"
+
+	replayCheckpointFile = "replay_checkpoint.json"
+	replayMismatchFile   = "replay_app_hash_mismatch.json"
+)
+
+// replayOptions are the options of the replay
+type replayOptions struct {
+	CopyDataDir        string
+	EndHeight          int64
+	CheckpointInterval int64
+	Pruning            string
+	MismatchReport     string
+	StateDiffDir       string
+}
+
+// replayCheckpoint is the progress of the replay saved in the data dir
+type replayCheckpoint struct {
+	ChainID string           `json:"chain_id"`
+	Height  int64            `json:"height"`
+	AppHash tmbytes.HexBytes `json:"app_hash"`
+	Time    time.Time        `json:"time"`
+}
+
+// appHashMismatchReport describes the block whose execution results
+// differ from the ones committed by the next block header
+type appHashMismatchReport struct {
+	Height                  int64               `json:"height"`
+	ExpectedAppHash         tmbytes.HexBytes    `json:"expected_app_hash"`
+	AppHash                 tmbytes.HexBytes    `json:"app_hash"`
+	ExpectedLastResultsHash tmbytes.HexBytes    `json:"expected_last_results_hash"`
+	LastResultsHash         tmbytes.HexBytes    `json:"last_results_hash"`
+	ABCIResponses           *tmsm.ABCIResponses `json:"abci_responses,omitempty"`
+	StateDiff               string              `json:"state_diff,omitempty"`
+}
+
 func replayCmd() *cobra.Command {
-	return &cobra.Command{
-		Use:   "replay <root-dir>",
-		Short: "Replay terra transactions",
-		RunE: func(_ *cobra.Command, args []string) error {
-			return replayTxs(args[0])
+	cmd := &cobra.Command{
+		Use:   "replay [root-dir]",
+		Short: "Replay the blocks of the blockstore on the application",
+		Long: `Replay the blocks stored in the blockstore of the root dir on the application state,
+from the last replayed height up to the end height or the tip of the blockstore.
+
+The replay is resumable; the progress is saved in data/replay_checkpoint.json of the
+root dir and running the command again continues from the last replayed block. Only
+the everything and nothing pruning strategies are supported, as the application state
+must be on the disk at every height to resume.
+
+When the results of a block differ from the ones committed by the next block header,
+the replay stops and writes an app hash mismatch report with the ABCI responses of
+the block. The writes and the deletes of each block on the stores can be written to
+the state diff dir to debug consensus failures.
+
+Example:
+	terrad replay ~/.terrad --copy-data-dir ~/.terrad_replay --end-height 100000 --state-diff-dir ./diff
+`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			opts := replayOptions{
+				CopyDataDir:        viper.GetString(flagCopyDataDir),
+				EndHeight:          viper.GetInt64(flagEndHeight),
+				CheckpointInterval: viper.GetInt64(flagCheckpointInterval),
+				MismatchReport:     viper.GetString(flagMismatchReport),
+				StateDiffDir:       viper.GetString(flagStateDiffDir),
+			}
+
+			// the pruning of viper is bound to the start command and the app config
+			opts.Pruning, _ = cmd.Flags().GetString(server.FlagPruning)
+			if opts.Pruning != storetypes.PruningOptionEverything && opts.Pruning != storetypes.PruningOptionNothing {
+				return fmt.Errorf("unsupported pruning strategy %s, use %s or %s",
+					opts.Pruning, storetypes.PruningOptionEverything, storetypes.PruningOptionNothing)
+			}
+
+			if opts.CheckpointInterval <= 0 {
+				return fmt.Errorf("--%s must be positive", flagCheckpointInterval)
+			}
+
+			return replayTxs(cmd.ErrOrStderr(), args[0], opts)
 		},
 		Args: cobra.ExactArgs(1),
 	}
-}
 
-func replayTxs(rootDir string) error {
+	cmd.Flags().String(flagCopyDataDir, "", "Copy the root dir to this dir and replay on the copy to preserve the original")
+	cmd.Flags().Int64(flagEndHeight, 0, "Stop after replaying this height; the tip of the blockstore if 0")
+	cmd.Flags().Int64(flagCheckpointInterval, 100, "Save the progress every N blocks")
+	cmd.Flags().String(server.FlagPruning, storetypes.PruningOptionEverything, "Pruning strategy of the application (everything|nothing)")
+	cmd.Flags().String(flagMismatchReport, "", "File of the app hash mismatch report; data/"+replayMismatchFile+" of the root dir by default")
+	cmd.Flags().String(flagStateDiffDir, "", "Write the store writes and deletes of each block to this dir")
+	return cmd
+}
 
-	if false {
-		// Copy the rootDir to a new directory, to preserve the old one.
-		fmt.Fprintln(os.Stderr, "Copying rootdir over")
-		oldRootDir := rootDir
-		rootDir = oldRootDir + "_replay"
-		if tmos.FileExists(rootDir) {
-			tmos.Exit(fmt.Sprintf("temporary copy dir %v already exists", rootDir))
+func replayTxs(out io.Writer, rootDir string, opts replayOptions) error {
+	if opts.CopyDataDir != "" {
+		// copy the root dir to a new directory, to preserve the old one
+		if tmos.FileExists(opts.CopyDataDir) {
+			return fmt.Errorf("copy dir %s already exists, replay it directly to resume", opts.CopyDataDir)
 		}
-		if err := cpm.Copy(oldRootDir, rootDir); err != nil {
+
+		fmt.Fprintf(out, "Copying %s to %s\n", rootDir, opts.CopyDataDir)
+		if err := cpm.Copy(rootDir, opts.CopyDataDir); err != nil {
 			return err
 		}
+
+		rootDir = opts.CopyDataDir
 	}
 
 	configDir := filepath.Join(rootDir, "config")
 	dataDir := filepath.Join(rootDir, "data")
 	ctx := server.NewDefaultContext()
 
+	if opts.MismatchReport == "" {
+		opts.MismatchReport = filepath.Join(dataDir, replayMismatchFile)
+	}
+
 	// App DB
-	fmt.Fprintln(os.Stderr, "Opening app database")
+	fmt.Fprintln(out, "Opening app database")
 	appDB, err := sdk.NewLevelDB("application", dataDir)
 	if err != nil {
 		return err
 	}
+	defer appDB.Close()
 
 	// TM DB
-	fmt.Fprintln(os.Stderr, "Opening tendermint state database")
+	fmt.Fprintln(out, "Opening tendermint state database")
 	tmDB, err := sdk.NewLevelDB("state", dataDir)
 	if err != nil {
 		return err
 	}
+	defer tmDB.Close()
 
 	// Blockchain DB
-	fmt.Fprintln(os.Stderr, "Opening blockstore database")
+	fmt.Fprintln(out, "Opening blockstore database")
 	bcDB, err := sdk.NewLevelDB("blockstore", dataDir)
 	if err != nil {
 		return err
 	}
+	defer bcDB.Close()
 
 	// TraceStore
+	var stateDiff *stateDiffWriter
 	var traceStoreWriter io.Writer
-	var traceStoreDir = filepath.Join(dataDir, "trace.log")
-	traceStoreWriter, err = os.OpenFile(
-		traceStoreDir,
-		os.O_WRONLY|os.O_APPEND|os.O_CREATE,
-		0666,
-	)
+	if opts.StateDiffDir != "" {
+		if err := tmos.EnsureDir(opts.StateDiffDir, 0755); err != nil {
+			return err
+		}
+
+		stateDiff = &stateDiffWriter{dir: opts.StateDiffDir}
+		traceStoreWriter = stateDiff
+	}
+
+	softforks, err := app.ReadFeatureHeightsConfig(filepath.Join(configDir, "app.toml"))
 	if err != nil {
 		return err
 	}
 
+	if err := app.LoadFeatureHeights(core.DefaultFeatureRegistry, filepath.Join(configDir, "genesis.json"), softforks); err != nil {
+		return err
+	}
+
 	// Application
-	fmt.Fprintln(os.Stderr, "Creating application")
+	fmt.Fprintln(out, "Creating application")
 	tapp := app.NewTerraApp(
-		ctx.Logger, appDB, traceStoreWriter, true, uint(1), map[int64]bool{},
-		wasmconfig.DefaultConfig(), baseapp.SetPruning(storetypes.PruneEverything), // nothing
+		ctx.Logger, appDB, traceStoreWriter, true, invCheckPeriod, map[int64]bool{},
+		wasmconfig.DefaultConfig(), baseapp.SetPruning(storetypes.NewPruningOptionsFromString(opts.Pruning)),
 	)
 
 	// Genesis
@@ -113,17 +223,16 @@ func replayTxs(rootDir string) error {
 	if err != nil {
 		return err
 	}
-	defer func() {
-		err = proxyApp.Stop()
-		if err != nil {
-			return
-		}
-	}()
+	defer proxyApp.Stop() // nolint: errcheck
 
 	state := tmsm.LoadState(tmDB)
 	if state.LastBlockHeight == 0 {
+		if tapp.LastBlockHeight() != 0 {
+			return fmt.Errorf("application is at height %d without the tendermint state", tapp.LastBlockHeight())
+		}
+
 		// Send InitChain msg
-		fmt.Fprintln(os.Stderr, "Sending InitChain msg")
+		fmt.Fprintln(out, "Sending InitChain msg")
 		validators := tm.TM2PB.ValidatorUpdates(genState.Validators)
 		csParams := tm.TM2PB.ConsensusParams(genDoc.ConsensusParams)
 		req := abci.RequestInitChain{
@@ -147,45 +256,225 @@ func replayTxs(rootDir string) error {
 		state = genState
 		state.Validators = newValidators
 		state.NextValidators = newValidators
+		tmsm.SaveState(tmDB, state)
+
+		// the genesis writes are not the diff of a block
+		if stateDiff != nil {
+			stateDiff.reset()
+		}
+	} else if err := checkReplayResume(out, dataDir, state, tapp.LastCommitID()); err != nil {
+		return err
 	}
 
 	// Create executor
-	fmt.Fprintln(os.Stderr, "Creating block executor")
-	blockExec := tmsm.NewBlockExecutor(tmDB, ctx.Logger, proxyApp.Consensus(), nil, tmsm.MockEvidencePool{})
+	fmt.Fprintln(out, "Creating block executor")
+	blockExec := tmsm.NewBlockExecutor(tmDB, ctx.Logger, proxyApp.Consensus(), mock.Mempool{}, tmsm.MockEvidencePool{})
 
 	// Create block store
-	fmt.Fprintln(os.Stderr, "Creating block store")
+	fmt.Fprintln(out, "Creating block store")
 	blockStore := tmstore.NewBlockStore(bcDB)
 
-	tz := []time.Duration{0, 0, 0}
-	for i := int(state.LastBlockHeight) + 1; ; i++ {
-		fmt.Fprintln(os.Stderr, "Running block ", i)
-		t1 := time.Now()
+	endHeight := blockStore.Height()
+	if opts.EndHeight > 0 {
+		if opts.EndHeight > endHeight {
+			return fmt.Errorf("end height %d is above the blockstore height %d", opts.EndHeight, endHeight)
+		}
 
-		// Apply block
-		fmt.Printf("loading and applying block %d\n", i)
-		blockmeta := blockStore.LoadBlockMeta(int64(i))
-		if blockmeta == nil {
-			fmt.Printf("Couldn't find block meta %d... done?\n", i)
-			return nil
+		endHeight = opts.EndHeight
+	}
+
+	if state.LastBlockHeight >= endHeight {
+		fmt.Fprintf(out, "Already replayed up to height %d\n", state.LastBlockHeight)
+		return nil
+	}
+
+	// stop at the end of the current block on interrupts, so the replay can be resumed
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigs)
+
+	fmt.Fprintf(out, "Replaying blocks %d to %d\n", state.LastBlockHeight+1, endHeight)
+	start := time.Now()
+	startHeight := state.LastBlockHeight
+	for height := state.LastBlockHeight + 1; height <= endHeight; height++ {
+		blockMeta := blockStore.LoadBlockMeta(height)
+		if blockMeta == nil {
+			return fmt.Errorf("couldn't find block meta %d", height)
 		}
-		block := blockStore.LoadBlock(int64(i))
+		block := blockStore.LoadBlock(height)
 		if block == nil {
-			return fmt.Errorf("couldn't find block %d", i)
+			return fmt.Errorf("couldn't find block %d", height)
 		}
 
-		t2 := time.Now()
+		// the header commits the results of the previous block
+		if !bytes.Equal(block.AppHash, state.AppHash) || !bytes.Equal(block.LastResultsHash, state.LastResultsHash) {
+			return writeAppHashMismatchReport(out, opts, tmDB, state, block, stateDiff)
+		}
 
-		state, _, err = blockExec.ApplyBlock(state, blockmeta.BlockID, block)
+		state, _, err = blockExec.ApplyBlock(state, blockMeta.BlockID, block)
 		if err != nil {
 			return err
 		}
 
-		t3 := time.Now()
-		tz[0] += t2.Sub(t1)
-		tz[1] += t3.Sub(t2)
+		if stateDiff != nil {
+			if err := stateDiff.flush(height); err != nil {
+				return err
+			}
+		}
+
+		interrupted := false
+		select {
+		case <-sigs:
+			interrupted = true
+		default:
+		}
+
+		if height%opts.CheckpointInterval == 0 || height == endHeight || interrupted {
+			if err := saveReplayCheckpoint(dataDir, state); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(out, "Replayed height %d, app hash %X, %.2f blocks/s\n",
+				height, state.AppHash, float64(height-startHeight)/time.Since(start).Seconds())
+		}
+
+		if interrupted {
+			fmt.Fprintf(out, "Interrupted at height %d, run the replay again to resume\n", height)
+			return nil
+		}
+	}
+
+	fmt.Fprintf(out, "Replayed %d blocks in %s\n", endHeight-startHeight, time.Since(start).Round(time.Second))
+	return nil
+}
+
+// checkReplayResume checks the application is committed at the height of the
+// tendermint state, and the checkpoint is of the chain and does not run ahead of the state
+func checkReplayResume(out io.Writer, dataDir string, state tmsm.State, lastCommitID storetypes.CommitID) error {
+	if lastCommitID.Version != state.LastBlockHeight {
+		return fmt.Errorf("application is at height %d but the tendermint state at %d, restore the copy of the root dir",
+			lastCommitID.Version, state.LastBlockHeight)
+	}
+
+	if !bytes.Equal(lastCommitID.Hash, state.AppHash) {
+		return fmt.Errorf("application hash %X differs from the tendermint state app hash %X at height %d",
+			lastCommitID.Hash, state.AppHash, state.LastBlockHeight)
+	}
+
+	bz, err := ioutil.ReadFile(filepath.Join(dataDir, replayCheckpointFile))
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var checkpoint replayCheckpoint
+	if err := json.Unmarshal(bz, &checkpoint); err != nil {
+		return err
+	}
+
+	if checkpoint.ChainID != state.ChainID {
+		return fmt.Errorf("checkpoint of chain %s cannot resume the replay of chain %s",
+			checkpoint.ChainID, state.ChainID)
+	}
+
+	if checkpoint.Height > state.LastBlockHeight {
+		return fmt.Errorf("checkpoint at height %d is ahead of the tendermint state at %d",
+			checkpoint.Height, state.LastBlockHeight)
+	}
+
+	fmt.Fprintf(out, "Resuming from height %d, checkpoint at height %d saved at %s\n",
+		state.LastBlockHeight, checkpoint.Height, checkpoint.Time.Format(time.RFC3339))
+	return nil
+}
+
+func saveReplayCheckpoint(dataDir string, state tmsm.State) error {
+	bz, err := json.MarshalIndent(replayCheckpoint{
+		ChainID: state.ChainID,
+		Height:  state.LastBlockHeight,
+		AppHash: state.AppHash,
+		Time:    time.Now().UTC(),
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return tmos.WriteFile(filepath.Join(dataDir, replayCheckpointFile), bz, 0644)
+}
+
+// writeAppHashMismatchReport writes the report of the last replayed block,
+// whose results differ from the ones in the header of the block
+func writeAppHashMismatchReport(out io.Writer, opts replayOptions, tmDB dbm.DB,
+	state tmsm.State, block *tm.Block, stateDiff *stateDiffWriter) error {
+	report := appHashMismatchReport{
+		Height:                  state.LastBlockHeight,
+		ExpectedAppHash:         block.AppHash,
+		AppHash:                 state.AppHash,
+		ExpectedLastResultsHash: block.LastResultsHash,
+		LastResultsHash:         state.LastResultsHash,
+	}
+
+	if responses, err := tmsm.LoadABCIResponses(tmDB, state.LastBlockHeight); err == nil {
+		report.ABCIResponses = responses
+	}
+
+	if stateDiff != nil {
+		report.StateDiff = stateDiff.file(state.LastBlockHeight)
+	}
+
+	bz, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return err
+	}
 
-		fmt.Fprintf(os.Stderr, "new app hash: %X\n", state.AppHash)
-		fmt.Fprintln(os.Stderr, tz)
+	if err := tmos.WriteFile(opts.MismatchReport, bz, 0644); err != nil {
+		return err
 	}
+
+	fmt.Fprintf(out, "App hash mismatch report written to %s\n", opts.MismatchReport)
+	return fmt.Errorf("app hash mismatch at height %d; expected %X, got %X",
+		state.LastBlockHeight, block.AppHash, state.AppHash)
+}
+
+// stateDiffWriter is the trace store writer collecting the store
+// operations of a block, which writes the writes and the deletes of
+// the block to <height>.jsonl of the dir
+type stateDiffWriter struct {
+	dir string
+	buf bytes.Buffer
+}
+
+var _ io.Writer = (*stateDiffWriter)(nil)
+
+// the trace operations are encoded with the operation as the first field
+var (
+	traceWritePrefix  = []byte(`{"operation":"write"`)
+	traceDeletePrefix = []byte(`{"operation":"delete"`)
+)
+
+// Write implements io.Writer
+func (w *stateDiffWriter) Write(p []byte) (int, error) {
+	return w.buf.Write(p)
+}
+
+func (w *stateDiffWriter) reset() {
+	w.buf.Reset()
+}
+
+func (w *stateDiffWriter) file(height int64) string {
+	return filepath.Join(w.dir, fmt.Sprintf("%d.jsonl", height))
+}
+
+func (w *stateDiffWriter) flush(height int64) error {
+	defer w.buf.Reset()
+
+	var diff bytes.Buffer
+	for _, line := range bytes.Split(w.buf.Bytes(), []byte("\n")) {
+		if bytes.HasPrefix(line, traceWritePrefix) || bytes.HasPrefix(line, traceDeletePrefix) {
+			diff.Write(line)
+			diff.WriteByte('\n')
+		}
+	}
+
+	return ioutil.WriteFile(w.file(height), diff.Bytes(), 0644)
 }
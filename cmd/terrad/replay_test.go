@@ -0,0 +1,147 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmsm "github.com/tendermint/tendermint/state"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/dbadapter"
+	"github.com/cosmos/cosmos-sdk/store/tracekv"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestCheckReplayResume(t *testing.T) {
+	appHash := []byte("app_hash")
+	state := tmsm.State{ChainID: "columbus", LastBlockHeight: 100, AppHash: appHash}
+	commitID := storetypes.CommitID{Version: 100, Hash: appHash}
+
+	tests := []struct {
+		name       string
+		checkpoint *tmsm.State
+		state      tmsm.State
+		commitID   storetypes.CommitID
+		output     string
+		err        string
+	}{
+		{"fresh start", nil, state, commitID, "", ""},
+		{"resume", &tmsm.State{ChainID: "columbus", LastBlockHeight: 90, AppHash: appHash}, state, commitID,
+			"Resuming from height 100, checkpoint at height 90", ""},
+		{"resume at the checkpoint", &state, state, commitID, "Resuming from height 100, checkpoint at height 100", ""},
+		{"mismatched chain id", &tmsm.State{ChainID: "tequila", LastBlockHeight: 90, AppHash: appHash}, state, commitID,
+			"", "checkpoint of chain tequila cannot resume the replay of chain columbus"},
+		{"checkpoint ahead of the state", &tmsm.State{ChainID: "columbus", LastBlockHeight: 110, AppHash: appHash}, state, commitID,
+			"", "checkpoint at height 110 is ahead of the tendermint state at 100"},
+		{"application at another height", nil, state, storetypes.CommitID{Version: 99, Hash: appHash},
+			"", "application is at height 99 but the tendermint state at 100"},
+		{"application hash mismatch", nil, state, storetypes.CommitID{Version: 100, Hash: []byte("other_hash")},
+			"", "differs from the tendermint state app hash"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dataDir, err := ioutil.TempDir("", "replay")
+			require.NoError(t, err)
+			defer os.RemoveAll(dataDir)
+
+			if tc.checkpoint != nil {
+				require.NoError(t, saveReplayCheckpoint(dataDir, *tc.checkpoint))
+			}
+
+			var out bytes.Buffer
+			err = checkReplayResume(&out, dataDir, tc.state, tc.commitID)
+			if tc.err != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			if tc.output == "" {
+				require.Empty(t, out.String())
+			} else {
+				require.Contains(t, out.String(), tc.output)
+			}
+		})
+	}
+}
+
+func TestSaveReplayCheckpoint(t *testing.T) {
+	dataDir, err := ioutil.TempDir("", "replay")
+	require.NoError(t, err)
+	defer os.RemoveAll(dataDir)
+
+	state := tmsm.State{ChainID: "columbus", LastBlockHeight: 100, AppHash: []byte("app_hash")}
+	require.NoError(t, saveReplayCheckpoint(dataDir, state))
+
+	bz, err := ioutil.ReadFile(filepath.Join(dataDir, replayCheckpointFile))
+	require.NoError(t, err)
+
+	var checkpoint replayCheckpoint
+	require.NoError(t, json.Unmarshal(bz, &checkpoint))
+	require.Equal(t, "columbus", checkpoint.ChainID)
+	require.Equal(t, int64(100), checkpoint.Height)
+	require.Equal(t, []byte("app_hash"), []byte(checkpoint.AppHash))
+}
+
+func TestStateDiffWriterFlush(t *testing.T) {
+	diffDir, err := ioutil.TempDir("", "statediff")
+	require.NoError(t, err)
+	defer os.RemoveAll(diffDir)
+
+	stateDiff := &stateDiffWriter{dir: diffDir}
+	store := tracekv.NewStore(dbadapter.Store{DB: dbm.NewMemDB()}, stateDiff, nil)
+
+	// the reads and the iterations are traced with the writes and the deletes
+	store.Set([]byte("key1"), []byte("value1"))
+	store.Set([]byte("key2"), []byte("value2"))
+	store.Get([]byte("key1"))
+	store.Has([]byte("key2"))
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+	}
+	iter.Close()
+	store.Delete([]byte("key2"))
+	require.NoError(t, stateDiff.flush(10))
+
+	bz, err := ioutil.ReadFile(filepath.Join(diffDir, "10.jsonl"))
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSuffix(string(bz), "\n"), "\n")
+	operations := make([]string, len(lines))
+	for i, line := range lines {
+		var op struct {
+			Operation string `json:"operation"`
+		}
+		require.NoError(t, json.Unmarshal([]byte(line), &op))
+		operations[i] = op.Operation
+	}
+	require.Equal(t, []string{"write", "write", "delete"}, operations)
+
+	// the buffer is reset by the flush, so a block without writes has an empty diff
+	store.Get([]byte("key1"))
+	require.NoError(t, stateDiff.flush(11))
+
+	bz, err = ioutil.ReadFile(stateDiff.file(11))
+	require.NoError(t, err)
+	require.Empty(t, bz)
+
+	// the writes reset before the block are not in the diff
+	store.Set([]byte("key3"), []byte("value3"))
+	stateDiff.reset()
+	store.Delete([]byte("key1"))
+	require.NoError(t, stateDiff.flush(12))
+
+	bz, err = ioutil.ReadFile(stateDiff.file(12))
+	require.NoError(t, err)
+	require.Equal(t, 1, bytes.Count(bz, []byte("\n")))
+	require.True(t, bytes.HasPrefix(bz, traceDeletePrefix))
+}
@@ -10,6 +10,7 @@ import (
 	"net"
 	"os"
 	"path/filepath"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -46,6 +47,11 @@ var (
 	flagNodeDaemonHome    = "node-daemon-home"
 	flagNodeCLIHome       = "node-cli-home"
 	flagStartingIPAddress = "starting-ip-address"
+	flagGenesisOverlay    = "genesis-overlay"
+	flagValidatorStake    = "validator-stake"
+	flagValidatorBalance  = "validator-balance"
+	flagAccountsFile      = "accounts-file"
+	flagDockerCompose     = "docker-compose"
 )
 
 // get cmd to initialize all files for tendermint testnet and application
@@ -60,8 +66,26 @@ necessary files (private validator, genesis, config, etc.).
 
 Note, strict routability for addresses is turned off in the config file.
 
+The genesis of each module can be customized with a JSON or YAML overlay, which
+is merged into the default genesis state of the modules, eg.
+
+	oracle:
+	  params:
+	    vote_period: 5
+	gov:
+	  voting_params:
+	    voting_period: "600000000000"
+
+The stake and the balance of each validator can be given one by one, or once for
+all the validators; extra pre-funded accounts are read from a CSV or JSON file in
+the format of add-genesis-accounts.
+
 Example:
 	terrad testnet --v 4 --output-dir ./output --starting-ip-address 192.168.10.2
+	terrad testnet --v 2 --genesis-overlay overlay.yaml \
+		--validator-stake 100000000 --validator-stake 200000000 \
+		--validator-balance 1000000000uluna,1000000000ukrw \
+		--accounts-file accounts.csv --docker-compose
 	`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			config := ctx.Config
@@ -75,11 +99,37 @@ Example:
 			startingIPAddress := viper.GetString(flagStartingIPAddress)
 			numValidators := viper.GetInt(flagNumValidators)
 
+			genOpts := testnetGenesisOptions{
+				AccountsFile:  viper.GetString(flagAccountsFile),
+				DockerCompose: viper.GetBool(flagDockerCompose),
+			}
+
+			// coins are comma separated, so the array flags are not read through viper
+			stakes, _ := cmd.Flags().GetStringArray(flagValidatorStake)
+			balances, _ := cmd.Flags().GetStringArray(flagValidatorBalance)
+			validators, err := parseValidatorSetups(numValidators, nodeDirPrefix, stakes, balances)
+			if err != nil {
+				return err
+			}
+			genOpts.Validators = validators
+
+			if overlayFile := viper.GetString(flagGenesisOverlay); overlayFile != "" {
+				if genOpts.Overlay, err = loadGenesisOverlay(overlayFile); err != nil {
+					return err
+				}
+			}
+
+			// the docker-compose file runs node<ID>/terrad of the output dir like networks/local
+			if genOpts.DockerCompose && (nodeDirPrefix != "node" || nodeDaemonHome != "terrad" || startingIPAddress == "") {
+				return fmt.Errorf("--%s requires the default --%s and --%s, and --%s",
+					flagDockerCompose, flagNodeDirPrefix, flagNodeDaemonHome, flagStartingIPAddress)
+			}
+
 			// override module codec
 			*(genutil.ModuleCdc) = *(tgenutil.ModuleCdc) // nolint
 
 			return InitTestnet(cmd, config, cdc, mbm, genAccIterator, outputDir, chainID,
-				minGasPrices, nodeDirPrefix, nodeDaemonHome, nodeCLIHome, startingIPAddress, numValidators)
+				minGasPrices, nodeDirPrefix, nodeDaemonHome, nodeCLIHome, startingIPAddress, numValidators, genOpts)
 		},
 	}
 
@@ -101,6 +151,13 @@ Example:
 		server.FlagMinGasPrices, fmt.Sprintf("0.000006%s", core.MicroLunaDenom),
 		"Minimum gas prices to accept for transactions; All fees in a tx must meet this minimum (e.g. 0.01photino,0.001uluna)")
 	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	cmd.Flags().String(flagGenesisOverlay, "", "JSON or YAML file of the module genesis states merged into the default genesis")
+	cmd.Flags().StringArray(flagValidatorStake, nil,
+		fmt.Sprintf("Self-delegation of the validator in %s, repeated for each validator or given once for all", core.MicroLunaDenom))
+	cmd.Flags().StringArray(flagValidatorBalance, nil,
+		"Balance of the validator account, repeated for each validator or given once for all")
+	cmd.Flags().String(flagAccountsFile, "", "CSV or JSON file of the extra pre-funded genesis accounts")
+	cmd.Flags().Bool(flagDockerCompose, false, "Write docker-compose.yml running the nodes to the output directory")
 	return cmd
 }
 
@@ -110,7 +167,7 @@ const nodeDirPerm = 0755
 func InitTestnet(cmd *cobra.Command, config *tmconfig.Config, cdc *codec.Codec,
 	mbm module.BasicManager, genAccIterator genutiltypes.GenesisAccountsIterator,
 	outputDir, chainID, minGasPrices, nodeDirPrefix, nodeDaemonHome,
-	nodeCLIHome, startingIPAddress string, numValidators int) error {
+	nodeCLIHome, startingIPAddress string, numValidators int, genOpts testnetGenesisOptions) error {
 
 	if chainID == "" {
 		chainID = "chain-" + tmrand.NewRand().Str(6)
@@ -198,20 +255,14 @@ func InitTestnet(cmd *cobra.Command, config *tmconfig.Config, cdc *codec.Codec,
 			return err
 		}
 
-		accTokens := sdk.TokensFromConsensusPower(1000)
-		accStakingTokens := sdk.TokensFromConsensusPower(500)
 		acc := authtypes.NewBaseAccountWithAddress(addr)
-		acc.Coins = sdk.Coins{
-			sdk.NewCoin(fmt.Sprintf("%stoken", nodeDirName), accTokens),
-			sdk.NewCoin(core.MicroLunaDenom, accStakingTokens),
-		}
+		acc.Coins = genOpts.Validators[i].Balance
 		accs = append(accs, &acc)
 
-		valTokens := sdk.TokensFromConsensusPower(100)
 		msg := staking.NewMsgCreateValidator(
 			sdk.ValAddress(addr),
 			valPubKeys[i],
-			sdk.NewCoin(core.MicroLunaDenom, valTokens),
+			sdk.NewCoin(core.MicroLunaDenom, genOpts.Validators[i].Stake),
 			staking.NewDescription(nodeDirName, "", "", "", ""),
 			staking.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec()),
 			sdk.OneInt(),
@@ -242,34 +293,66 @@ func InitTestnet(cmd *cobra.Command, config *tmconfig.Config, cdc *codec.Codec,
 		srvconfig.WriteConfigFile(terraConfigFilePath, terraConfig)
 	}
 
-	if err := initGenFiles(cdc, mbm, chainID, accs, genFiles, numValidators); err != nil {
+	genTime := tmtime.Now()
+	if genOpts.AccountsFile != "" {
+		var err error
+		if accs, err = readTestnetAccounts(genOpts.AccountsFile, genTime, accs); err != nil {
+			_ = os.RemoveAll(outputDir)
+			return err
+		}
+	}
+
+	if err := initGenFiles(cdc, mbm, chainID, accs, genFiles, numValidators, genOpts.Overlay); err != nil {
+		_ = os.RemoveAll(outputDir)
 		return err
 	}
 
 	err := collectGenFiles(
 		cdc, config, chainID, monikers, nodeIDs, valPubKeys, numValidators,
-		outputDir, nodeDirPrefix, nodeDaemonHome, genAccIterator,
+		outputDir, nodeDirPrefix, nodeDaemonHome, genAccIterator, genTime,
 	)
 	if err != nil {
 		return err
 	}
 
+	if genOpts.DockerCompose {
+		if err := writeDockerCompose(outputDir, startingIPAddress, numValidators); err != nil {
+			return err
+		}
+	}
+
 	cmd.PrintErrf("Successfully initialized %d node directories\n", numValidators)
 	return nil
 }
 
 func initGenFiles(cdc *codec.Codec, mbm module.BasicManager, chainID string,
-	accs []authexported.GenesisAccount, genFiles []string, numValidators int) error {
+	accs []authexported.GenesisAccount, genFiles []string, numValidators int,
+	overlay map[string]json.RawMessage) error {
 
 	appGenState := mbm.DefaultGenesis()
+	if err := applyGenesisOverlay(appGenState, overlay); err != nil {
+		return err
+	}
 
-	// set the accounts in the genesis state
+	// set the accounts in the genesis state, after the ones of the overlay
 	var authGenState authtypes.GenesisState
-	cdc.MustUnmarshalJSON(appGenState[authtypes.ModuleName], &authGenState)
+	if err := cdc.UnmarshalJSON(appGenState[authtypes.ModuleName], &authGenState); err != nil {
+		return err
+	}
 
-	authGenState.Accounts = accs
+	for _, acc := range accs {
+		if authGenState.Accounts.Contains(acc.GetAddress()) {
+			return fmt.Errorf("duplicate genesis account %s", acc.GetAddress())
+		}
+
+		authGenState.Accounts = append(authGenState.Accounts, acc)
+	}
 	appGenState[authtypes.ModuleName] = cdc.MustMarshalJSON(authGenState)
 
+	if err := mbm.ValidateGenesis(appGenState); err != nil {
+		return fmt.Errorf("invalid genesis state: %w", err)
+	}
+
 	appGenStateJSON, err := codec.MarshalJSONIndent(cdc, appGenState)
 	if err != nil {
 		return err
@@ -294,10 +377,9 @@ func collectGenFiles(
 	cdc *codec.Codec, config *tmconfig.Config, chainID string,
 	monikers, nodeIDs []string, valPubKeys []crypto.PubKey,
 	numValidators int, outputDir, nodeDirPrefix, nodeDaemonHome string,
-	genAccIterator genutiltypes.GenesisAccountsIterator) error {
+	genAccIterator genutiltypes.GenesisAccountsIterator, genTime time.Time) error {
 
 	var appState json.RawMessage
-	genTime := tmtime.Now()
 
 	for i := 0; i < numValidators; i++ {
 		nodeDirName := fmt.Sprintf("%s%d", nodeDirPrefix, i)
@@ -0,0 +1,302 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+
+	core "github.com/terra-project/core/types"
+)
+
+// validatorSetup is the self-delegation and the account balance of a testnet validator
+type validatorSetup struct {
+	Stake   sdk.Int
+	Balance sdk.Coins
+}
+
+// testnetGenesisOptions are the genesis customizations of the testnet
+type testnetGenesisOptions struct {
+	Validators    []validatorSetup
+	Overlay       map[string]json.RawMessage
+	AccountsFile  string
+	DockerCompose bool
+}
+
+// parseValidatorSetups returns the setups of the validators from the stakes and balances,
+// where a single value applies to all the validators
+func parseValidatorSetups(numValidators int, nodeDirPrefix string, stakes, balances []string) ([]validatorSetup, error) {
+	if len(stakes) > 1 && len(stakes) != numValidators {
+		return nil, fmt.Errorf("%d validator stakes are given for %d validators", len(stakes), numValidators)
+	}
+
+	if len(balances) > 1 && len(balances) != numValidators {
+		return nil, fmt.Errorf("%d validator balances are given for %d validators", len(balances), numValidators)
+	}
+
+	setups := make([]validatorSetup, numValidators)
+	for i := range setups {
+		setups[i] = validatorSetup{
+			Stake: sdk.TokensFromConsensusPower(100),
+			Balance: sdk.NewCoins(
+				sdk.NewCoin(fmt.Sprintf("%s%dtoken", nodeDirPrefix, i), sdk.TokensFromConsensusPower(1000)),
+				sdk.NewCoin(core.MicroLunaDenom, sdk.TokensFromConsensusPower(500)),
+			),
+		}
+
+		if len(stakes) != 0 {
+			stake, ok := sdk.NewIntFromString(validatorValue(stakes, i))
+			if !ok || !stake.IsPositive() {
+				return nil, fmt.Errorf("invalid stake of validator %d", i)
+			}
+
+			setups[i].Stake = stake
+		}
+
+		if len(balances) != 0 {
+			balance, err := sdk.ParseCoins(validatorValue(balances, i))
+			if err != nil {
+				return nil, fmt.Errorf("invalid balance of validator %d: %w", i, err)
+			}
+
+			setups[i].Balance = balance
+		}
+
+		if setups[i].Balance.AmountOf(core.MicroLunaDenom).LT(setups[i].Stake) {
+			return nil, fmt.Errorf("balance of validator %d cannot cover the stake %s%s",
+				i, setups[i].Stake, core.MicroLunaDenom)
+		}
+	}
+
+	return setups, nil
+}
+
+// validatorValue returns the value of the validator, or the single value given for all the validators
+func validatorValue(values []string, i int) string {
+	if len(values) == 1 {
+		return values[0]
+	}
+
+	return values[i]
+}
+
+// loadGenesisOverlay reads the genesis overlay of the modules from a JSON or YAML file
+func loadGenesisOverlay(file string) (map[string]json.RawMessage, error) {
+	bz, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(filepath.Ext(file)) {
+	case ".yaml", ".yml":
+		var overlay interface{}
+		if err := yaml.Unmarshal(bz, &overlay); err != nil {
+			return nil, err
+		}
+
+		if bz, err = json.Marshal(yamlToJSON(overlay)); err != nil {
+			return nil, err
+		}
+	}
+
+	var overlay map[string]json.RawMessage
+	if err := json.Unmarshal(bz, &overlay); err != nil {
+		return nil, fmt.Errorf("genesis overlay must be an object of the module genesis states: %w", err)
+	}
+
+	return overlay, nil
+}
+
+// yamlToJSON converts the maps decoded by yaml to the ones which can be encoded to JSON
+func yamlToJSON(value interface{}) interface{} {
+	switch value := value.(type) {
+	case map[interface{}]interface{}:
+		converted := make(map[string]interface{}, len(value))
+		for k, v := range value {
+			converted[fmt.Sprint(k)] = yamlToJSON(v)
+		}
+
+		return converted
+	case []interface{}:
+		for i, v := range value {
+			value[i] = yamlToJSON(v)
+		}
+	}
+
+	return value
+}
+
+// applyGenesisOverlay merges the overlay into the genesis state of each module
+func applyGenesisOverlay(appGenState map[string]json.RawMessage, overlay map[string]json.RawMessage) error {
+	for moduleName, moduleOverlay := range overlay {
+		moduleGenState, ok := appGenState[moduleName]
+		if !ok {
+			return fmt.Errorf("genesis overlay of unknown module %s", moduleName)
+		}
+
+		merged, err := mergeJSON(moduleGenState, moduleOverlay)
+		if err != nil {
+			return fmt.Errorf("failed to merge the genesis overlay of %s: %w", moduleName, err)
+		}
+
+		appGenState[moduleName] = merged
+	}
+
+	return nil
+}
+
+// mergeJSON merges the overlay into the base recursively; objects are merged
+// key by key and the other values of the overlay replace the base ones
+func mergeJSON(base, overlay json.RawMessage) (json.RawMessage, error) {
+	var baseValue, overlayValue interface{}
+	if err := unmarshalJSONNumber(base, &baseValue); err != nil {
+		return nil, err
+	}
+
+	if err := unmarshalJSONNumber(overlay, &overlayValue); err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(mergeValue(baseValue, overlayValue))
+}
+
+func unmarshalJSONNumber(bz []byte, value interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+	return decoder.Decode(value)
+}
+
+func mergeValue(base, overlay interface{}) interface{} {
+	switch overlay := overlay.(type) {
+	case map[string]interface{}:
+		baseMap, ok := base.(map[string]interface{})
+		if !ok {
+			return overlay
+		}
+
+		for k, v := range overlay {
+			baseMap[k] = mergeValue(baseMap[k], v)
+		}
+
+		return baseMap
+	case json.Number:
+		// amino encodes 64 bit integers and decimals as strings
+		if _, ok := base.(string); ok {
+			return overlay.String()
+		}
+	case float64, int, int64, uint64:
+		if _, ok := base.(string); ok {
+			return fmt.Sprint(overlay)
+		}
+	}
+
+	return overlay
+}
+
+// readTestnetAccounts reads the pre-funded accounts from a CSV or JSON file
+// in the format of add-genesis-accounts
+func readTestnetAccounts(file string, genesisTime time.Time, accs authexported.GenesisAccounts) (authexported.GenesisAccounts, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	addAccount := func(row int, genAcc authexported.GenesisAccount) error {
+		if accs.Contains(genAcc.GetAddress()) {
+			return fmt.Errorf("row %d: duplicate address %s", row, genAcc.GetAddress())
+		}
+
+		accs = append(accs, genAcc)
+		return nil
+	}
+
+	reader := bufio.NewReader(f)
+	switch ext := strings.ToLower(filepath.Ext(file)); ext {
+	case ".csv":
+		_, err = readGenesisAccountsCSV(reader, genesisTime, addAccount)
+	case ".json":
+		_, err = readGenesisAccountsJSON(reader, genesisTime, addAccount)
+	default:
+		err = fmt.Errorf("unknown accounts file format %s, use .csv or .json", ext)
+	}
+
+	return accs, err
+}
+
+var dockerComposeTemplate = template.Must(template.New("docker-compose").Parse(`version: '3'
+
+services:
+{{- range .Nodes }}
+  terradnode{{ .ID }}:
+    container_name: terradnode{{ .ID }}
+    image: "terramoney/core"
+    ports:
+      - "{{ .P2PPort }}-{{ .RPCPort }}:26656-26657"
+    environment:
+      - ID={{ .ID }}
+      - LOG=$${LOG:-terrad.log}
+    volumes:
+      - ./:/terrad:Z
+    networks:
+      localnet:
+        ipv4_address: {{ .IP }}
+{{ end }}
+networks:
+  localnet:
+    driver: bridge
+    ipam:
+      driver: default
+      config:
+      -
+        subnet: {{ .Subnet }}
+`))
+
+// writeDockerCompose writes the docker-compose file running the testnet nodes with the
+// terramoney/core image built from networks/local, which runs node<ID>/terrad of the output dir
+func writeDockerCompose(outputDir, startingIPAddress string, numValidators int) error {
+	type composeNode struct {
+		ID      int
+		IP      string
+		P2PPort int
+		RPCPort int
+	}
+
+	nodes := make([]composeNode, numValidators)
+	for i := range nodes {
+		ip, err := calculateIP(startingIPAddress, i)
+		if err != nil {
+			return err
+		}
+
+		// node0 uses the default ports, and the others follow two by two
+		p2pPort := 26656
+		if i > 0 {
+			p2pPort = 26657 + 2*i
+		}
+
+		nodes[i] = composeNode{ID: i, IP: ip, P2PPort: p2pPort, RPCPort: p2pPort + 1}
+	}
+
+	ip := strings.Split(startingIPAddress, ".")
+	var buf bytes.Buffer
+	if err := dockerComposeTemplate.Execute(&buf, struct {
+		Nodes  []composeNode
+		Subnet string
+	}{nodes, fmt.Sprintf("%s.%s.%s.0/16", ip[0], ip[1], ip[2])}); err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(filepath.Join(outputDir, "docker-compose.yml"), buf.Bytes(), 0644)
+}
@@ -0,0 +1,191 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/app"
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/oracle"
+	"github.com/terra-project/core/x/staking"
+)
+
+func TestParseValidatorSetups(t *testing.T) {
+	tests := []struct {
+		name     string
+		stakes   []string
+		balances []string
+		expect   []validatorSetup
+		err      string
+	}{
+		{"defaults", nil, nil, []validatorSetup{
+			{sdk.TokensFromConsensusPower(100), sdk.NewCoins(sdk.NewCoin("node0token", sdk.TokensFromConsensusPower(1000)),
+				sdk.NewCoin(core.MicroLunaDenom, sdk.TokensFromConsensusPower(500)))},
+			{sdk.TokensFromConsensusPower(100), sdk.NewCoins(sdk.NewCoin("node1token", sdk.TokensFromConsensusPower(1000)),
+				sdk.NewCoin(core.MicroLunaDenom, sdk.TokensFromConsensusPower(500)))},
+		}, ""},
+		{"single value for all", []string{"100"}, []string{"1000uluna"}, []validatorSetup{
+			{sdk.NewInt(100), sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1000))},
+			{sdk.NewInt(100), sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1000))},
+		}, ""},
+		{"value per validator", []string{"100", "200"}, []string{"1000uluna", "200uluna,10ukrw"}, []validatorSetup{
+			{sdk.NewInt(100), sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1000))},
+			{sdk.NewInt(200), sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 200), sdk.NewInt64Coin("ukrw", 10))},
+		}, ""},
+		{"stakes of other validators", []string{"100", "200", "300"}, nil, nil, "3 validator stakes are given for 2 validators"},
+		{"balances of other validators", nil, []string{"1uluna", "2uluna", "3uluna"}, nil, "3 validator balances are given for 2 validators"},
+		{"invalid stake", []string{"0"}, nil, nil, "invalid stake of validator 0"},
+		{"invalid balance", nil, []string{"uluna"}, nil, "invalid balance of validator 0"},
+		{"balance below the stake", []string{"100", "200"}, []string{"100uluna"}, nil, "balance of validator 1 cannot cover the stake 200uluna"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			setups, err := parseValidatorSetups(2, "node", tc.stakes, tc.balances)
+			if tc.err != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.err)
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, setups, len(tc.expect))
+			for i, setup := range setups {
+				require.True(t, tc.expect[i].Stake.Equal(setup.Stake))
+				require.True(t, tc.expect[i].Balance.IsEqual(setup.Balance))
+			}
+		})
+	}
+}
+
+func TestApplyGenesisOverlay(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "overlay")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	files := map[string]string{
+		"overlay.json": `{
+  "oracle": {"params": {"vote_period": 10, "whitelist": [{"name": "ukrw", "tobin_tax": "0.01"}]}},
+  "staking": {"params": {"max_validators": 50}}
+}`,
+		"overlay.yaml": `oracle:
+  params:
+    vote_period: 10
+    whitelist:
+    - name: ukrw
+      tobin_tax: "0.01"
+staking:
+  params:
+    max_validators: 50
+`,
+	}
+
+	cdc := app.MakeCodec()
+	for name, content := range files {
+		t.Run(name, func(t *testing.T) {
+			file := filepath.Join(tempDir, name)
+			require.NoError(t, ioutil.WriteFile(file, []byte(content), 0644))
+
+			overlay, err := loadGenesisOverlay(file)
+			require.NoError(t, err)
+
+			defaultGenState := app.ModuleBasics.DefaultGenesis()
+			appGenState := app.ModuleBasics.DefaultGenesis()
+			require.NoError(t, applyGenesisOverlay(appGenState, overlay))
+			require.NoError(t, app.ModuleBasics.ValidateGenesis(appGenState))
+
+			// the overlay values replace the default ones, and the others are kept
+			var oracleGenState, defaultOracleGenState oracle.GenesisState
+			cdc.MustUnmarshalJSON(appGenState[oracle.ModuleName], &oracleGenState)
+			cdc.MustUnmarshalJSON(defaultGenState[oracle.ModuleName], &defaultOracleGenState)
+			expectedOracleParams := defaultOracleGenState.Params
+			expectedOracleParams.VotePeriod = 10
+			expectedOracleParams.Whitelist = oracle.DenomList{{Name: "ukrw", TobinTax: sdk.NewDecWithPrec(1, 2)}}
+			require.Equal(t, expectedOracleParams, oracleGenState.Params)
+
+			var stakingGenState, defaultStakingGenState staking.GenesisState
+			cdc.MustUnmarshalJSON(appGenState[staking.ModuleName], &stakingGenState)
+			cdc.MustUnmarshalJSON(defaultGenState[staking.ModuleName], &defaultStakingGenState)
+			expectedStakingParams := defaultStakingGenState.Params
+			expectedStakingParams.MaxValidators = 50
+			require.Equal(t, expectedStakingParams, stakingGenState.Params)
+		})
+	}
+
+	// the overlay of a module not in the genesis
+	appGenState := app.ModuleBasics.DefaultGenesis()
+	err = applyGenesisOverlay(appGenState, map[string]json.RawMessage{"unknown": json.RawMessage(`{}`)})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "genesis overlay of unknown module unknown")
+
+	// an overlay which is not an object of the module genesis states
+	file := filepath.Join(tempDir, "invalid.json")
+	require.NoError(t, ioutil.WriteFile(file, []byte(`["oracle"]`), 0644))
+	_, err = loadGenesisOverlay(file)
+	require.Error(t, err)
+}
+
+func TestWriteDockerCompose(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "compose")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+
+	require.NoError(t, writeDockerCompose(tempDir, "192.168.10.2", 3))
+
+	bz, err := ioutil.ReadFile(filepath.Join(tempDir, "docker-compose.yml"))
+	require.NoError(t, err)
+
+	var compose struct {
+		Services map[string]struct {
+			ContainerName string   `yaml:"container_name"`
+			Image         string   `yaml:"image"`
+			Ports         []string `yaml:"ports"`
+			Environment   []string `yaml:"environment"`
+			Networks      map[string]struct {
+				IPv4Address string `yaml:"ipv4_address"`
+			} `yaml:"networks"`
+		} `yaml:"services"`
+		Networks map[string]struct {
+			IPAM struct {
+				Config []struct {
+					Subnet string `yaml:"subnet"`
+				} `yaml:"config"`
+			} `yaml:"ipam"`
+		} `yaml:"networks"`
+	}
+	require.NoError(t, yaml.Unmarshal(bz, &compose))
+	require.Len(t, compose.Services, 3)
+
+	expected := []struct {
+		name  string
+		ip    string
+		ports string
+	}{
+		{"terradnode0", "192.168.10.2", "26656-26657:26656-26657"},
+		{"terradnode1", "192.168.10.3", "26659-26660:26656-26657"},
+		{"terradnode2", "192.168.10.4", "26661-26662:26656-26657"},
+	}
+
+	for i, node := range expected {
+		service, ok := compose.Services[node.name]
+		require.True(t, ok, node.name)
+		require.Equal(t, node.name, service.ContainerName)
+		require.Equal(t, "terramoney/core", service.Image)
+		require.Equal(t, []string{node.ports}, service.Ports)
+		require.Contains(t, service.Environment, fmt.Sprintf("ID=%d", i))
+		require.Equal(t, node.ip, service.Networks["localnet"].IPv4Address)
+	}
+
+	require.Equal(t, "192.168.10.0/16", compose.Networks["localnet"].IPAM.Config[0].Subnet)
+
+	require.Error(t, writeDockerCompose(tempDir, "localhost", 1))
+}
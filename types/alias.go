@@ -11,39 +11,59 @@ import (
 )
 
 const (
-	MicroLunaDenom       = assets.MicroLunaDenom
-	MicroUSDDenom        = assets.MicroUSDDenom
-	MicroKRWDenom        = assets.MicroKRWDenom
-	MicroSDRDenom        = assets.MicroSDRDenom
-	MicroCNYDenom        = assets.MicroCNYDenom
-	MicroJPYDenom        = assets.MicroJPYDenom
-	MicroEURDenom        = assets.MicroEURDenom
-	MicroGBPDenom        = assets.MicroGBPDenom
-	MicroMNTDenom        = assets.MicroMNTDenom
-	MicroUnit            = assets.MicroUnit
-	BlocksPerMinute      = util.BlocksPerMinute
-	BlocksPerHour        = util.BlocksPerHour
-	BlocksPerDay         = util.BlocksPerDay
-	BlocksPerWeek        = util.BlocksPerWeek
-	BlocksPerMonth       = util.BlocksPerMonth
-	BlocksPerYear        = util.BlocksPerYear
-	CoinType             = util.CoinType
-	FullFundraiserPath   = util.FullFundraiserPath
-	Bech32PrefixAccAddr  = util.Bech32PrefixAccAddr
-	Bech32PrefixAccPub   = util.Bech32PrefixAccPub
-	Bech32PrefixValAddr  = util.Bech32PrefixValAddr
-	Bech32PrefixValPub   = util.Bech32PrefixValPub
-	Bech32PrefixConsAddr = util.Bech32PrefixConsAddr
-	Bech32PrefixConsPub  = util.Bech32PrefixConsPub
+	MicroLunaDenom              = assets.MicroLunaDenom
+	MicroUSDDenom               = assets.MicroUSDDenom
+	MicroKRWDenom               = assets.MicroKRWDenom
+	MicroSDRDenom               = assets.MicroSDRDenom
+	MicroCNYDenom               = assets.MicroCNYDenom
+	MicroJPYDenom               = assets.MicroJPYDenom
+	MicroEURDenom               = assets.MicroEURDenom
+	MicroGBPDenom               = assets.MicroGBPDenom
+	MicroMNTDenom               = assets.MicroMNTDenom
+	MicroUnit                   = assets.MicroUnit
+	BlocksPerMinute             = util.BlocksPerMinute
+	BlocksPerHour               = util.BlocksPerHour
+	BlocksPerDay                = util.BlocksPerDay
+	BlocksPerWeek               = util.BlocksPerWeek
+	BlocksPerMonth              = util.BlocksPerMonth
+	BlocksPerYear               = util.BlocksPerYear
+	CoinType                    = util.CoinType
+	FeatureSoftfork1            = util.FeatureSoftfork1
+	FeatureSoftfork2            = util.FeatureSoftfork2
+	FeatureSoftfork3            = util.FeatureSoftfork3
+	FeatureWasmQueryLimits      = util.FeatureWasmQueryLimits
+	FeatureWasmTypedEvents      = util.FeatureWasmTypedEvents
+	FeatureWasmCustomMsgs       = util.FeatureWasmCustomMsgs
+	FeatureOracleRewardTracking = util.FeatureOracleRewardTracking
+	FeatureTreasuryTaxCaps      = util.FeatureTreasuryTaxCaps
+	NotScheduledHeight          = util.NotScheduledHeight
+	FullFundraiserPath          = util.FullFundraiserPath
+	Bech32PrefixAccAddr         = util.Bech32PrefixAccAddr
+	Bech32PrefixAccPub          = util.Bech32PrefixAccPub
+	Bech32PrefixValAddr         = util.Bech32PrefixValAddr
+	Bech32PrefixValPub          = util.Bech32PrefixValPub
+	Bech32PrefixConsAddr        = util.Bech32PrefixConsAddr
+	Bech32PrefixConsPub         = util.Bech32PrefixConsPub
 )
 
 var (
 	// functions aliases
-	IsPeriodLastBlock    = util.IsPeriodLastBlock
-	IsWaitingForSoftfork = util.IsWaitingForSoftfork
-	IsSoftforkHeight     = util.IsSoftforkHeight
+	IsPeriodLastBlock         = util.IsPeriodLastBlock
+	IsWaitingForSoftfork      = util.IsWaitingForSoftfork
+	IsSoftforkHeight          = util.IsSoftforkHeight
+	IsFeatureActive           = util.IsFeatureActive
+	NewFeatureRegistry        = util.NewFeatureRegistry
+	IsFeatureActivationHeight = util.IsFeatureActivationHeight
+	WithSimulation            = util.WithSimulation
+	IsSimulation              = util.IsSimulation
+
+	// variable aliases
+	Features               = util.Features
+	DefaultFeatureRegistry = util.DefaultFeatureRegistry
 )
 
 type (
-	Base64Bytes = util.Base64Bytes
+	Base64Bytes     = util.Base64Bytes
+	FeatureStatus   = util.FeatureStatus
+	FeatureRegistry = util.FeatureRegistry
 )
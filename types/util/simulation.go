@@ -0,0 +1,17 @@
+package util
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+type simulationKey struct{}
+
+// WithSimulation marks the context as a tx simulation, so modules can
+// collect extra information which must never be produced on consensus
+func WithSimulation(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(simulationKey{}, true)
+}
+
+// IsSimulation returns whether the context is running a tx simulation
+func IsSimulation(ctx sdk.Context) bool {
+	simulate, ok := ctx.Value(simulationKey{}).(bool)
+	return ok && simulate
+}
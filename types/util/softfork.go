@@ -1,67 +1,218 @@
 package util
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	"fmt"
+	"math"
+	"sync"
 
-// IsWaitingForSoftfork returns whether current block
-// height is bigger than reserved softfork block height
-func IsWaitingForSoftfork(ctx sdk.Context, version uint8) bool {
-	if version == 1 {
-		// Expected time:
-		// MAINNET
-		// Fri Jan 01 2021 18:00:00 GMT+0900 (KST)
-		// Fri Jan 01 2021 09:00:00 GMT+0000 (UTC)
-		// Fri Jan 01 2021 01:00:00 GMT-0800 (PST)
-		//
-		// TEQUILA
-		// Fri Nov 27 2020 12:00:00 GMT+0900 (KST)
-		// Fri Nov 27 2020 03:00:00 GMT+0000 (UTC)
-		// Fri Nov 26 2020 19:00:00 GMT-0800 (KST)
-
-		return (ctx.ChainID() == "columbus-4" && ctx.BlockHeight() < 1200000) ||
-			(ctx.ChainID() == "tequila-0004" && ctx.BlockHeight() < 1350000)
-	} else if version == 2 {
-		// Expected time:
-		// MAINNET
-		// Tue Mar 30 2021 18:00:00 GMT+0900 (KST)
-		// Tue Mar 30 2021 09:00:00 GMT+0000 (UTC)
-		// Tue Mar 30 2021 01:00:00 GMT-0800 (PST)
-		//
-		// TEQUILA
-		// ASAP
-		return (ctx.ChainID() == "columbus-4" && ctx.BlockHeight() < 2380000) ||
-			(ctx.ChainID() == "tequila-0004" && ctx.BlockHeight() < 3052265)
-	} else if version == 3 {
-		// Expected time:
-		// MAINNET
-		// Tue Mar 30 2021 18:00:00 GMT+0900 (KST)
-		// Tue Mar 30 2021 09:00:00 GMT+0000 (UTC)
-		// Tue Mar 30 2021 01:00:00 GMT-0800 (PST)
-		//
-		// TEQUILA
-		// Tue Mar 25 2021 18:00:00 GMT+0900 (KST)
-		// Tue Mar 25 2021 09:00:00 GMT+0000 (UTC)
-		// Tue Mar 25 2021 01:00:00 GMT-0800 (PST)
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Names of the softfork features
+const (
+	FeatureSoftfork1 = "softfork-1"
+	FeatureSoftfork2 = "softfork-2"
+	FeatureSoftfork3 = "softfork-3"
+
+	// FeatureWasmQueryLimits enables the gas caps of the contract sub-queries, the
+	// contract queries of the wasm custom route and the max depth of nested contract
+	// queries; the custom wasm queries returned nothing before
+	FeatureWasmQueryLimits = "wasm-query-limits"
+
+	// FeatureWasmTypedEvents enables the typed wasm-<type> events of the contracts,
+	// whose logs were all in the from_contract event before
+	FeatureWasmTypedEvents = "wasm-typed-events"
+
+	// FeatureWasmCustomMsgs enables the custom msgs of the wasm route, which
+	// were ignored before
+	FeatureWasmCustomMsgs = "wasm-custom-msgs"
+
+	// FeatureOracleRewardTracking enables the tracking of the seigniorage rewards sent
+	// to the oracle reward pool and not yet given out to the ballot winners
+	FeatureOracleRewardTracking = "oracle-reward-tracking"
+
+	// FeatureTreasuryTaxCaps enables storing the TaxPolicy cap as the tax cap of the
+	// denoms of the supply without a tax cap, which were capped by it without storing it
+	FeatureTreasuryTaxCaps = "treasury-tax-caps"
+)
+
+// NotScheduledHeight is the activation height of the features which are not
+// scheduled on a chain yet
+const NotScheduledHeight = int64(math.MaxInt64)
+
+// Features are the names of all the softfork features
+var Features = []string{
+	FeatureSoftfork1, FeatureSoftfork2, FeatureSoftfork3,
+	FeatureWasmQueryLimits, FeatureWasmTypedEvents, FeatureWasmCustomMsgs,
+	FeatureOracleRewardTracking, FeatureTreasuryTaxCaps,
+}
+
+// FeatureStatus is the activation status of a feature at a height
+type FeatureStatus struct {
+	Name             string `json:"name" yaml:"name"`
+	ActivationHeight int64  `json:"activation_height" yaml:"activation_height"`
+	Active           bool   `json:"active" yaml:"active"`
+}
+
+// String implements fmt.Stringer
+func (fs FeatureStatus) String() string {
+	return fmt.Sprintf("%s: activation height %d, active %t", fs.Name, fs.ActivationHeight, fs.Active)
+}
+
+// FeatureRegistry holds the activation heights of the features per chain ID;
+// the features without an activation height of the chain are active from the genesis
+type FeatureRegistry struct {
+	mtx     sync.RWMutex
+	heights map[string]map[string]int64
+}
+
+// NewFeatureRegistry returns a registry without activation heights
+func NewFeatureRegistry() *FeatureRegistry {
+	return &FeatureRegistry{heights: make(map[string]map[string]int64)}
+}
+
+// SetActivationHeight sets the activation height of the feature on the chain
+func (r *FeatureRegistry) SetActivationHeight(chainID, feature string, height int64) error {
+	if !isFeature(feature) {
+		return fmt.Errorf("unknown feature %s", feature)
+	}
+
+	if height < 0 {
+		return fmt.Errorf("negative activation height %d of %s", height, feature)
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.heights[chainID] == nil {
+		r.heights[chainID] = make(map[string]int64)
+	}
 
-		return (ctx.ChainID() == "columbus-4" && ctx.BlockHeight() < 2380000) ||
-			(ctx.ChainID() == "tequila-0004" && ctx.BlockHeight() < 3150000)
+	r.heights[chainID][feature] = height
+	return nil
+}
+
+// ActivationHeight returns the activation height of the feature on the chain
+func (r *FeatureRegistry) ActivationHeight(chainID, feature string) int64 {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	return r.heights[chainID][feature]
+}
+
+// IsActive returns whether the feature is active at the height of the chain
+func (r *FeatureRegistry) IsActive(chainID, feature string, height int64) bool {
+	return height >= r.ActivationHeight(chainID, feature)
+}
+
+// Statuses returns the status of all the features at the height of the chain
+func (r *FeatureRegistry) Statuses(chainID string, height int64) []FeatureStatus {
+	statuses := make([]FeatureStatus, len(Features))
+	for i, feature := range Features {
+		activationHeight := r.ActivationHeight(chainID, feature)
+		statuses[i] = FeatureStatus{
+			Name:             feature,
+			ActivationHeight: activationHeight,
+			Active:           height >= activationHeight,
+		}
+	}
+
+	return statuses
+}
+
+func isFeature(feature string) bool {
+	for _, f := range Features {
+		if f == feature {
+			return true
+		}
 	}
 
 	return false
 }
 
+// DefaultFeatureRegistry is the registry of the app, with the activation
+// heights of the public networks; the heights of the other networks are
+// loaded from the genesis and the app config
+var DefaultFeatureRegistry = NewFeatureRegistry()
+
+func init() {
+	for chainID, heights := range map[string]map[string]int64{
+		// MAINNET
+		// softfork-1: Fri Jan 01 2021 09:00:00 GMT+0000 (UTC)
+		// softfork-2, softfork-3: Tue Mar 30 2021 09:00:00 GMT+0000 (UTC)
+		// wasm-query-limits, wasm-typed-events, wasm-custom-msgs, oracle-reward-tracking,
+		// treasury-tax-caps: not scheduled
+		"columbus-4": {
+			FeatureSoftfork1:            1200000,
+			FeatureSoftfork2:            2380000,
+			FeatureSoftfork3:            2380000,
+			FeatureWasmQueryLimits:      NotScheduledHeight,
+			FeatureWasmTypedEvents:      NotScheduledHeight,
+			FeatureWasmCustomMsgs:       NotScheduledHeight,
+			FeatureOracleRewardTracking: NotScheduledHeight,
+			FeatureTreasuryTaxCaps:      NotScheduledHeight,
+		},
+		// TEQUILA
+		// softfork-1: Fri Nov 27 2020 03:00:00 GMT+0000 (UTC)
+		// softfork-2: ASAP
+		// softfork-3: Tue Mar 25 2021 09:00:00 GMT+0000 (UTC)
+		// wasm-query-limits, wasm-typed-events, wasm-custom-msgs, oracle-reward-tracking,
+		// treasury-tax-caps: not scheduled
+		"tequila-0004": {
+			FeatureSoftfork1:            1350000,
+			FeatureSoftfork2:            3052265,
+			FeatureSoftfork3:            3150000,
+			FeatureWasmQueryLimits:      NotScheduledHeight,
+			FeatureWasmTypedEvents:      NotScheduledHeight,
+			FeatureWasmCustomMsgs:       NotScheduledHeight,
+			FeatureOracleRewardTracking: NotScheduledHeight,
+			FeatureTreasuryTaxCaps:      NotScheduledHeight,
+		},
+	} {
+		for feature, height := range heights {
+			if err := DefaultFeatureRegistry.SetActivationHeight(chainID, feature, height); err != nil {
+				panic(err)
+			}
+		}
+	}
+}
+
+// IsFeatureActive returns whether the feature is active at the current block height
+func IsFeatureActive(ctx sdk.Context, feature string) bool {
+	return DefaultFeatureRegistry.IsActive(ctx.ChainID(), feature, ctx.BlockHeight())
+}
+
+// IsFeatureActivationHeight returns whether the feature is activated at the current block
+// height; the features active from the genesis have no activation height
+func IsFeatureActivationHeight(ctx sdk.Context, feature string) bool {
+	height := DefaultFeatureRegistry.ActivationHeight(ctx.ChainID(), feature)
+	return height > 0 && height == ctx.BlockHeight()
+}
+
+// softforkFeatures are the features of the softfork versions; the versions are
+// fixed, and the features added later are checked by their names
+var softforkFeatures = map[uint8]string{
+	1: FeatureSoftfork1,
+	2: FeatureSoftfork2,
+	3: FeatureSoftfork3,
+}
+
+// softforkFeature returns the feature of the softfork version
+func softforkFeature(version uint8) (string, bool) {
+	feature, ok := softforkFeatures[version]
+	return feature, ok
+}
+
+// IsWaitingForSoftfork returns whether current block
+// height is bigger than reserved softfork block height
+func IsWaitingForSoftfork(ctx sdk.Context, version uint8) bool {
+	feature, ok := softforkFeature(version)
+	return ok && !IsFeatureActive(ctx, feature)
+}
+
 // IsSoftforkHeight return whether current block
 // height is the targeted softfork height
 func IsSoftforkHeight(ctx sdk.Context, version uint8) bool {
-	if version == 1 {
-		return (ctx.ChainID() == "columbus-4" && ctx.BlockHeight() == 1200000) ||
-			(ctx.ChainID() == "tequila-0004" && ctx.BlockHeight() == 1350000)
-	} else if version == 2 {
-		return (ctx.ChainID() == "columbus-4" && ctx.BlockHeight() == 2380000) ||
-			(ctx.ChainID() == "tequila-0004" && ctx.BlockHeight() == 3052265)
-	} else if version == 3 {
-		return (ctx.ChainID() == "columbus-4" && ctx.BlockHeight() == 2380000) ||
-			(ctx.ChainID() == "tequila-0004" && ctx.BlockHeight() == 3150000)
-	}
-
-	return false
+	feature, ok := softforkFeature(version)
+	return ok && IsFeatureActivationHeight(ctx, feature)
 }
@@ -8,11 +8,20 @@ import (
 	"github.com/terra-project/core/x/auth/internal/types"
 )
 
+const (
+	QueryVestingSchedule = types.QueryVestingSchedule
+)
+
 var (
 	// functions aliases
-	RegisterCodec            = types.RegisterCodec
-	RegisterAccountTypeCodec = types.RegisterAccountTypeCodec
+	RegisterCodec                 = types.RegisterCodec
+	RegisterAccountTypeCodec      = types.RegisterAccountTypeCodec
+	NewQueryVestingScheduleParams = types.NewQueryVestingScheduleParams
 
 	// variable aliases
 	ModuleCdc = types.ModuleCdc
 )
+
+type (
+	QueryVestingScheduleParams = types.QueryVestingScheduleParams
+)
@@ -9,21 +9,25 @@ import (
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
 // numbers, checks signatures & account numbers, and deducts fees from the first
-// signer.
+// signer or its fee granter.
 func NewAnteHandler(
 	ak keeper.AccountKeeper,
 	supplyKeeper types.SupplyKeeper,
 	oracleKeeper OracleKeeper,
 	treasuryKeeper TreasuryKeeper,
+	marketKeeper MarketKeeper,
+	feeGrantKeeper FeeGrantKeeper,
 	sigGasConsumer cosmosante.SignatureVerificationGasConsumer) sdk.AnteHandler {
 	return sdk.ChainAnteDecorators(
-		cosmosante.NewSetUpContextDecorator(),        // outermost AnteDecorator. SetUpContext must be called first
-		NewSpammingPreventionDecorator(oracleKeeper), // spamming prevention
-		NewTaxFeeDecorator(treasuryKeeper),           // mempool gas fee validation & record tax proceeds
+		cosmosante.NewSetUpContextDecorator(),            // outermost AnteDecorator. SetUpContext must be called first
+		NewSimulationDecorator(),                         // marks the context of a simulated tx
+		NewSpammingPreventionDecorator(oracleKeeper),     // spamming prevention
+		NewTaxFeeDecorator(treasuryKeeper, marketKeeper), // mempool gas fee validation & record tax proceeds
 		cosmosante.NewValidateBasicDecorator(),
 		cosmosante.NewValidateMemoDecorator(ak),
 		cosmosante.NewConsumeGasForTxSizeDecorator(ak),
-		cosmosante.NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
+		NewFeeGrantDecorator(ak, feeGrantKeeper), // FeeGrantDecorator must be called before SetPubKeyDecorator to create grantee accounts
+		cosmosante.NewSetPubKeyDecorator(ak),     // SetPubKeyDecorator must be called before all signature verification decorators
 		cosmosante.NewValidateSigCountDecorator(ak),
 		cosmosante.NewDeductFeeDecorator(ak, supplyKeeper),
 		cosmosante.NewSigGasConsumeDecorator(ak, sigGasConsumer),
@@ -15,3 +15,13 @@ type TreasuryKeeper interface {
 type OracleKeeper interface {
 	ValidateFeeder(ctx sdk.Context, feederAddr sdk.AccAddress, validatorAddr sdk.ValAddress, checkBonded bool) error
 }
+
+// MarketKeeper for converting fee coins into the gas price base denom
+type MarketKeeper interface {
+	ComputeInternalSwap(ctx sdk.Context, offerCoin sdk.DecCoin, askDenom string) (sdk.DecCoin, error)
+}
+
+// FeeGrantKeeper for paying fees with fee allowances
+type FeeGrantKeeper interface {
+	UseGrantedFees(ctx sdk.Context, granteeAddr sdk.AccAddress, fee sdk.Coins) (granterAddr sdk.AccAddress, ok bool)
+}
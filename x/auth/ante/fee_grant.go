@@ -0,0 +1,49 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
+)
+
+// FeeGrantDecorator pays the fee (gas fee + stability tax) of a transaction from
+// a granter's account, when the fee payer cannot afford the fee by itself and
+// has a fee allowance which accepts the fee. The fee is moved to the fee payer,
+// so the following DeductFeeDecorator deducts it as usual.
+// CONTRACT: Tx must implement FeeTx interface to use FeeGrantDecorator
+type FeeGrantDecorator struct {
+	ak             keeper.AccountKeeper
+	feeGrantKeeper FeeGrantKeeper
+}
+
+// NewFeeGrantDecorator returns new fee grant decorator instance
+func NewFeeGrantDecorator(ak keeper.AccountKeeper, feeGrantKeeper FeeGrantKeeper) FeeGrantDecorator {
+	return FeeGrantDecorator{
+		ak:             ak,
+		feeGrantKeeper: feeGrantKeeper,
+	}
+}
+
+// AnteHandle handles paying fees with fee allowances
+func (fgd FeeGrantDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	fee := feeTx.GetFee()
+	if fee.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	// the fee payer can pay the fee by itself
+	feePayer := feeTx.FeePayer()
+	if acc := fgd.ak.GetAccount(ctx, feePayer); acc != nil && acc.SpendableCoins(ctx.BlockTime()).IsAllGTE(fee) {
+		return next(ctx, tx, simulate)
+	}
+
+	// when no allowance can cover the fee, DeductFeeDecorator will reject the tx
+	fgd.feeGrantKeeper.UseGrantedFees(ctx, feePayer, fee)
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,80 @@
+package ante_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/auth"
+	"github.com/terra-project/core/x/auth/ante"
+	"github.com/terra-project/core/x/feegrant"
+)
+
+func TestFeeGrantDecorator(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set(flags.FlagHome, tempDir)
+
+	// setup
+	tapp, ctx := createTestApp()
+	ctx = ctx.WithBlockTime(time.Now())
+
+	ak := tapp.GetAccountKeeper()
+	fk := tapp.GetFeeGrantKeeper()
+	fgd := ante.NewFeeGrantDecorator(ak, fk)
+	antehandler := sdk.ChainAnteDecorators(fgd)
+
+	// keys and addresses
+	priv1, _, granteeAddr := types.KeyTestPubAddr()
+	_, _, granterAddr := types.KeyTestPubAddr()
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	msgs := []sdk.Msg{types.NewTestMsg(granteeAddr)}
+
+	granterAcc := ak.NewAccountWithAddress(ctx, granterAddr)
+	require.NoError(t, granterAcc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1000000))))
+	ak.SetAccount(ctx, granterAcc)
+
+	feeAmount := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1000))
+	fee := auth.NewStdFee(100000, feeAmount)
+	tx := types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+
+	// no allowance; the grantee account is not created
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Nil(t, ak.GetAccount(ctx, granteeAddr))
+
+	spendLimit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1500))
+	fk.SetFeeAllowance(ctx, granterAddr, granteeAddr,
+		feegrant.NewFeeAllowanceGrant(feegrant.NewBasicFeeAllowance(spendLimit), ctx.BlockTime().Add(time.Hour)))
+
+	// fee is moved from granter to grantee
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, feeAmount, ak.GetAccount(ctx, granteeAddr).GetCoins())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 999000)), ak.GetAccount(ctx, granterAddr).GetCoins())
+
+	grant, found := fk.GetFeeAllowance(ctx, granterAddr, granteeAddr)
+	require.True(t, found)
+	require.Equal(t, feegrant.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 500))), grant.Allowance)
+
+	// grantee can pay by itself, so the allowance is not used
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err)
+	require.Equal(t, feeAmount, ak.GetAccount(ctx, granteeAddr).GetCoins())
+
+	grant, found = fk.GetFeeAllowance(ctx, granterAddr, granteeAddr)
+	require.True(t, found)
+	require.Equal(t, feegrant.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 500))), grant.Allowance)
+}
@@ -0,0 +1,25 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+)
+
+// SimulationDecorator marks the context of a simulated tx, which lets the modules
+// collect simulation-only information such as the wasm gas trace
+type SimulationDecorator struct{}
+
+// NewSimulationDecorator returns new simulation decorator instance
+func NewSimulationDecorator() SimulationDecorator {
+	return SimulationDecorator{}
+}
+
+// AnteHandle marks the context when the tx is simulated
+func (sd SimulationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	if simulate {
+		ctx = core.WithSimulation(ctx)
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,34 @@
+package ante_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/auth/ante"
+)
+
+func TestSimulationDecorator(t *testing.T) {
+	_, ctx := createTestApp()
+
+	var marked bool
+	next := func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		marked = core.IsSimulation(ctx)
+		return ctx, nil
+	}
+
+	sd := ante.NewSimulationDecorator()
+	require.False(t, core.IsSimulation(ctx))
+
+	newCtx, err := sd.AnteHandle(ctx, nil, false, next)
+	require.NoError(t, err)
+	require.False(t, marked)
+	require.False(t, core.IsSimulation(newCtx))
+
+	newCtx, err = sd.AnteHandle(ctx, nil, true, next)
+	require.NoError(t, err)
+	require.True(t, marked)
+	require.True(t, core.IsSimulation(newCtx))
+}
@@ -6,8 +6,10 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/spf13/viper"
 
 	core "github.com/terra-project/core/types"
+	vestingtypes "github.com/terra-project/core/x/auth/vesting/types"
 	marketexported "github.com/terra-project/core/x/market/exported"
 	msgauthexported "github.com/terra-project/core/x/msgauth/exported"
 	oracleexported "github.com/terra-project/core/x/oracle/exported"
@@ -22,6 +24,11 @@ type FeeTx interface {
 	FeePayer() sdk.AccAddress
 }
 
+// FlagGasPriceBaseDenom defines the denom to which all whitelisted fee coins are
+// converted before comparing them with the minimum gas price of that denom.
+// Empty value disables the conversion and keeps the denom by denom comparison.
+const FlagGasPriceBaseDenom = "gas-price-base-denom"
+
 // TaxFeeDecorator will check if the transaction's fee is at least as large
 // as tax + the local validator's minimum gasFee (defined in validator config)
 // and record tax proceeds to treasury module to track tax proceeds.
@@ -31,12 +38,14 @@ type FeeTx interface {
 // CONTRACT: Tx must implement FeeTx to use MempoolFeeDecorator
 type TaxFeeDecorator struct {
 	treasuryKeeper TreasuryKeeper
+	marketKeeper   MarketKeeper
 }
 
 // NewTaxFeeDecorator returns new tax fee decorator instance
-func NewTaxFeeDecorator(treasuryKeeper TreasuryKeeper) TaxFeeDecorator {
+func NewTaxFeeDecorator(treasuryKeeper TreasuryKeeper, marketKeeper MarketKeeper) TaxFeeDecorator {
 	return TaxFeeDecorator{
 		treasuryKeeper: treasuryKeeper,
+		marketKeeper:   marketKeeper,
 	}
 }
 
@@ -56,7 +65,13 @@ func (tfd TaxFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool,
 
 		// Mempool fee validation
 		if ctx.IsCheckTx() && !(isOracleTx(ctx, feeTx.GetMsgs()) && gas <= 1000000) {
-			if err := EnsureSufficientMempoolFees(ctx, gas, feeCoins, taxes); err != nil {
+			if baseDenom := viper.GetString(FlagGasPriceBaseDenom); baseDenom != "" {
+				err = EnsureSufficientMempoolFeesInBaseDenom(ctx, tfd.marketKeeper, gas, feeCoins, taxes, baseDenom)
+			} else {
+				err = EnsureSufficientMempoolFees(ctx, gas, feeCoins, taxes)
+			}
+
+			if err != nil {
 				return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, err.Error())
 			}
 		}
@@ -109,6 +124,49 @@ func EnsureSufficientMempoolFees(ctx sdk.Context, gas uint64, feeCoins sdk.Coins
 	return nil
 }
 
+// EnsureSufficientMempoolFeesInBaseDenom verifies that the given transaction has supplied
+// enough fees(gas + stability) to cover a proposer's minimum fees, where the gas fee part
+// is compared in the configured base denom. Every fee coin is converted to the base denom
+// with the oracle exchange rates; coins without an effective exchange rate are ignored.
+// When the proposer has no minimum gas price for the base denom, it falls back to
+// the denom by denom comparison of EnsureSufficientMempoolFees.
+//
+// Contract: This should only be called during CheckTx as it cannot be part of
+// consensus.
+func EnsureSufficientMempoolFeesInBaseDenom(ctx sdk.Context, mk MarketKeeper, gas uint64, feeCoins sdk.Coins, taxes sdk.Coins, baseDenom string) error {
+	minGasPrice := ctx.MinGasPrices().AmountOf(baseDenom)
+	if !minGasPrice.IsPositive() {
+		return EnsureSufficientMempoolFees(ctx, gas, feeCoins, taxes)
+	}
+
+	// Determine the required fee in the base denom, where fee = ceil(minGasPrice * gasLimit).
+	requiredFee := sdk.NewCoin(baseDenom, minGasPrice.Mul(sdk.NewDec(int64(gas))).Ceil().RoundInt())
+
+	// Before checking gas prices, remove taxed from fee
+	gasFeeCoins, hasNeg := feeCoins.SafeSub(taxes)
+	if hasNeg {
+		return fmt.Errorf("insufficient fees; got: %q, required: %q = %q(gas) +%q(stability)", feeCoins, taxes.Add(requiredFee), requiredFee, taxes)
+	}
+
+	paidFee := sdk.NewDecCoin(baseDenom, sdk.ZeroInt())
+	for _, coin := range gasFeeCoins {
+		converted, err := mk.ComputeInternalSwap(ctx, sdk.NewDecCoinFromCoin(coin), baseDenom)
+		if err != nil {
+			// skip the coins which are not whitelisted by oracle
+			continue
+		}
+
+		paidFee = paidFee.Add(converted)
+	}
+
+	if paidFee.Amount.LT(requiredFee.Amount.ToDec()) {
+		return fmt.Errorf("insufficient fees; got: %q(%q in %s), required: %q = %q(gas) +%q(stability)",
+			feeCoins, paidFee, baseDenom, taxes.Add(requiredFee), requiredFee, taxes)
+	}
+
+	return nil
+}
+
 // FilterMsgAndComputeTax computes the stability tax on MsgSend and MsgMultiSend.
 func FilterMsgAndComputeTax(ctx sdk.Context, tk TreasuryKeeper, msgs []sdk.Msg) sdk.Coins {
 	taxes := sdk.Coins{}
@@ -128,9 +186,18 @@ func FilterMsgAndComputeTax(ctx sdk.Context, tk TreasuryKeeper, msgs []sdk.Msg)
 		case wasmexported.MsgInstantiateContract:
 			taxes = taxes.Add(computeTax(ctx, tk, msg.InitCoins)...)
 
+		case wasmexported.MsgInstantiateContract2:
+			taxes = taxes.Add(computeTax(ctx, tk, msg.InitCoins)...)
+
 		case wasmexported.MsgExecuteContract:
 			taxes = taxes.Add(computeTax(ctx, tk, msg.Coins)...)
 
+		case vestingtypes.MsgCreateVestingAccount:
+			taxes = taxes.Add(computeTax(ctx, tk, msg.Amount)...)
+
+		case vestingtypes.MsgAddVestingSchedule:
+			taxes = taxes.Add(computeTax(ctx, tk, msg.Amount)...)
+
 		case msgauthexported.MsgExecAuthorized:
 			taxes = taxes.Add(FilterMsgAndComputeTax(ctx, tk, msg.Msgs)...)
 		}
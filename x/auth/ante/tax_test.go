@@ -50,7 +50,7 @@ func TestEnsureMempoolFeesGas(t *testing.T) {
 	// setup
 	tapp, ctx := createTestApp()
 
-	mtd := ante.NewTaxFeeDecorator(tapp.GetTreasuryKeeper())
+	mtd := ante.NewTaxFeeDecorator(tapp.GetTreasuryKeeper(), tapp.GetMarketKeeper())
 	antehandler := sdk.ChainAnteDecorators(mtd)
 
 	// keys and addresses
@@ -108,7 +108,7 @@ func TestEnsureMempoolFeesSend(t *testing.T) {
 	ctx = ctx.WithMinGasPrices(lowGasPrice)
 
 	tk := tapp.GetTreasuryKeeper()
-	mtd := ante.NewTaxFeeDecorator(tk)
+	mtd := ante.NewTaxFeeDecorator(tk, tapp.GetMarketKeeper())
 	antehandler := sdk.ChainAnteDecorators(mtd)
 
 	// keys and addresses
@@ -148,7 +148,7 @@ func TestEnsureMempoolFeesMultiSend(t *testing.T) {
 	ctx = ctx.WithMinGasPrices(lowGasPrice)
 
 	tk := tapp.GetTreasuryKeeper()
-	mtd := ante.NewTaxFeeDecorator(tk)
+	mtd := ante.NewTaxFeeDecorator(tk, tapp.GetMarketKeeper())
 	antehandler := sdk.ChainAnteDecorators(mtd)
 
 	// keys and addresses
@@ -196,7 +196,7 @@ func TestEnsureMempoolFeesInstantiateContract(t *testing.T) {
 	ctx = ctx.WithMinGasPrices(lowGasPrice)
 
 	tk := tapp.GetTreasuryKeeper()
-	mtd := ante.NewTaxFeeDecorator(tk)
+	mtd := ante.NewTaxFeeDecorator(tk, tapp.GetMarketKeeper())
 	antehandler := sdk.ChainAnteDecorators(mtd)
 
 	// keys and addresses
@@ -236,7 +236,7 @@ func TestEnsureMempoolFeesExecuteContract(t *testing.T) {
 	ctx = ctx.WithMinGasPrices(lowGasPrice)
 
 	tk := tapp.GetTreasuryKeeper()
-	mtd := ante.NewTaxFeeDecorator(tk)
+	mtd := ante.NewTaxFeeDecorator(tk, tapp.GetMarketKeeper())
 	antehandler := sdk.ChainAnteDecorators(mtd)
 
 	// keys and addresses
@@ -276,7 +276,7 @@ func TestEnsureMempoolFeesExecAuthorized(t *testing.T) {
 	ctx = ctx.WithMinGasPrices(lowGasPrice)
 
 	tk := tapp.GetTreasuryKeeper()
-	mtd := ante.NewTaxFeeDecorator(tk)
+	mtd := ante.NewTaxFeeDecorator(tk, tapp.GetMarketKeeper())
 	antehandler := sdk.ChainAnteDecorators(mtd)
 
 	// keys and addresses
@@ -317,7 +317,7 @@ func TestEnsureNoMempoolFeesForOracleMessages(t *testing.T) {
 	ctx = ctx.WithMinGasPrices(lowGasPrice)
 
 	tk := tapp.GetTreasuryKeeper()
-	mtd := ante.NewTaxFeeDecorator(tk)
+	mtd := ante.NewTaxFeeDecorator(tk, tapp.GetMarketKeeper())
 	antehandler := sdk.ChainAnteDecorators(mtd)
 
 	// keys and addresses
@@ -331,3 +331,108 @@ func TestEnsureNoMempoolFeesForOracleMessages(t *testing.T) {
 	_, err = antehandler(ctx, tx, false)
 	require.NoError(t, err)
 }
+
+func TestEnsureMempoolFeesInBaseDenom(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set(flags.FlagHome, tempDir)
+	viper.Set(ante.FlagGasPriceBaseDenom, core.MicroLunaDenom)
+	defer viper.Set(ante.FlagGasPriceBaseDenom, "")
+
+	// setup
+	tapp, ctx := createTestApp()
+
+	// 1 uluna = 10 uusd
+	tapp.GetOracleKeeper().SetLunaExchangeRate(ctx, core.MicroUSDDenom, sdk.NewDec(10))
+
+	// only uluna gas price is configured; 0.015uluna * 100000 gas = 1500uluna
+	lunaGasPrice := sdk.NewDecCoinFromDec(core.MicroLunaDenom, sdk.NewDecWithPrec(15, 3))
+	ctx = ctx.WithMinGasPrices([]sdk.DecCoin{lunaGasPrice}).WithIsCheckTx(true)
+
+	mtd := ante.NewTaxFeeDecorator(tapp.GetTreasuryKeeper(), tapp.GetMarketKeeper())
+	antehandler := sdk.ChainAnteDecorators(mtd)
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	msgs := []sdk.Msg{types.NewTestMsg(addr1)}
+
+	// 14990uusd = 1499uluna
+	fee := auth.NewStdFee(100000, sdk.NewCoins(sdk.NewInt64Coin(core.MicroUSDDenom, 14990)))
+	tx := types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+	_, err = antehandler(ctx, tx, false)
+	require.Error(t, err, "Decorator should have errored on too low fee in base denom")
+
+	// 15000uusd = 1500uluna
+	fee.Amount = sdk.NewCoins(sdk.NewInt64Coin(core.MicroUSDDenom, 15000))
+	tx = types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err, "Decorator should not have errored on uusd fee equal to uluna gas price")
+
+	// 5000uusd + 1000uluna = 1500uluna
+	fee.Amount = sdk.NewCoins(sdk.NewInt64Coin(core.MicroUSDDenom, 5000), sdk.NewInt64Coin(core.MicroLunaDenom, 1000))
+	tx = types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err, "Decorator should sum up all fee coins in base denom")
+
+	// ukrw has no exchange rate, so it cannot be counted
+	fee.Amount = sdk.NewCoins(sdk.NewInt64Coin(core.MicroKRWDenom, 100000000))
+	tx = types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+	_, err = antehandler(ctx, tx, false)
+	require.Error(t, err, "Decorator should ignore fee coins without exchange rate")
+
+	// without conversion, uusd fee cannot cover uluna gas price
+	viper.Set(ante.FlagGasPriceBaseDenom, "")
+	fee.Amount = sdk.NewCoins(sdk.NewInt64Coin(core.MicroUSDDenom, 15000))
+	tx = types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+	_, err = antehandler(ctx, tx, false)
+	require.Error(t, err, "Decorator should compare fees denom by denom when conversion is disabled")
+}
+
+func TestEnsureMempoolFeesInBaseDenomWithTax(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set(flags.FlagHome, tempDir)
+	viper.Set(ante.FlagGasPriceBaseDenom, core.MicroLunaDenom)
+	defer viper.Set(ante.FlagGasPriceBaseDenom, "")
+
+	// setup
+	tapp, ctx := createTestApp()
+
+	// 1 uluna = 1 usdr
+	tapp.GetOracleKeeper().SetLunaExchangeRate(ctx, core.MicroSDRDenom, sdk.OneDec())
+
+	lunaGasPrice := sdk.NewDecCoinFromDec(core.MicroLunaDenom, sdk.NewDecWithPrec(15, 3))
+	ctx = ctx.WithMinGasPrices([]sdk.DecCoin{lunaGasPrice}).WithIsCheckTx(true)
+
+	tk := tapp.GetTreasuryKeeper()
+	mtd := ante.NewTaxFeeDecorator(tk, tapp.GetMarketKeeper())
+	antehandler := sdk.ChainAnteDecorators(mtd)
+
+	// keys and addresses
+	priv1, _, addr1 := types.KeyTestPubAddr()
+	privs, accNums, seqs := []crypto.PrivKey{priv1}, []uint64{0}, []uint64{0}
+
+	sendAmount := int64(1000000)
+	sendCoins := sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, sendAmount))
+	msgs := []sdk.Msg{bank.NewMsgSend(addr1, addr1, sendCoins)}
+
+	expectedTax := tk.GetTaxRate(ctx).MulInt64(sendAmount).TruncateInt()
+	if taxCap := tk.GetTaxCap(ctx, core.MicroSDRDenom); expectedTax.GT(taxCap) {
+		expectedTax = taxCap
+	}
+
+	// tax only; gas fee is not covered
+	fee := auth.NewStdFee(100000, sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, expectedTax)))
+	tx := types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+	_, err = antehandler(ctx, tx, false)
+	require.Error(t, err, "Decorator should errored on low fee for base denom gasPrice + tax")
+
+	// tax + 1500usdr(=1500uluna) gas fee
+	fee.Amount = sdk.NewCoins(sdk.NewCoin(core.MicroSDRDenom, expectedTax.AddRaw(1500)))
+	tx = types.NewTestTx(ctx, msgs, privs, accNums, seqs, fee)
+	_, err = antehandler(ctx, tx, false)
+	require.NoError(t, err, "Decorator should not have errored on fee higher than base denom gasPrice + tax")
+}
@@ -15,6 +15,8 @@ import (
 	tutils "github.com/terra-project/core/x/auth/client/utils"
 )
 
+const flagTrace = "trace"
+
 // GetTxFeesEstimateCommand will create a send tx and sign it with the given key.
 func GetTxFeesEstimateCommand(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -25,6 +27,10 @@ func GetTxFeesEstimateCommand(cdc *codec.Codec) *cobra.Command {
 Estimate fees for the given stdTx
 
 $ terracli tx estimate-fee [file] --gas-adjustment 1.4 --gas-prices 0.015uluna
+
+To get the gas breakdown of the wasm msgs in the tx, use the --trace flag
+
+$ terracli tx estimate-fee [file] --trace
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cliCtx := context.NewCLIContext().WithCodec(cdc)
@@ -44,13 +50,13 @@ $ terracli tx estimate-fee [file] --gas-adjustment 1.4 --gas-prices 0.015uluna
 				}
 			}
 
-			fees, gas, err := tutils.ComputeFeesWithStdTx(cliCtx, stdTx, gasAdjustment, gasPrices)
+			fees, gas, traces, err := tutils.ComputeFeesWithGasTraces(cliCtx, stdTx, gasAdjustment, gasPrices, viper.GetBool(flagTrace))
 
 			if err != nil {
 				return err
 			}
 
-			response := tutils.EstimateFeeResp{Fees: fees, Gas: gas}
+			response := tutils.EstimateFeeResp{Fees: fees, Gas: gas, GasTraces: traces}
 			return cliCtx.PrintOutput(response)
 		},
 	}
@@ -59,6 +65,7 @@ $ terracli tx estimate-fee [file] --gas-adjustment 1.4 --gas-prices 0.015uluna
 
 	cmd.Flags().Float64(flags.FlagGasAdjustment, flags.DefaultGasAdjustment, "adjustment factor to be multiplied against the estimate returned by the tx simulation; if the gas limit is set manually this flag is ignored ")
 	cmd.Flags().String(flags.FlagGasPrices, "", "Gas prices to determine the transaction fee (e.g. 10uluna)")
+	cmd.Flags().Bool(flagTrace, false, "Simulate the tx and return the gas traces of the wasm msgs")
 	// cmd.MarkFlagRequired(client.FlagGasAdjustment)
 
 	return cmd
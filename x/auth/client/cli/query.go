@@ -0,0 +1,58 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/terra-project/core/x/auth/internal/types"
+	vestingtypes "github.com/terra-project/core/x/auth/vesting/types"
+)
+
+// GetCmdQueryVestingSchedule implements the query vesting schedule command.
+func GetCmdQueryVestingSchedule(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "vesting-schedule [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the vesting schedule and the future unlock events of a vesting account",
+		Long: strings.TrimSpace(`
+Query the vesting schedule of a graded vesting account. For each denom, it shows the
+schedules, the vested, vesting and delegated vesting amounts at the latest block, and the
+future unlock events with the cumulative vested amounts. The coins vest linearly between
+two consecutive unlock events,
+
+$ terracli query account vesting-schedule terra...
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := cliCtx.Codec.MarshalJSON(types.NewQueryVestingScheduleParams(addr))
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", authtypes.QuerierRoute, types.QueryVestingSchedule), bz)
+			if err != nil {
+				return err
+			}
+
+			var timeline vestingtypes.VestingTimeline
+			if err := cdc.UnmarshalJSON(res, &timeline); err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(timeline)
+		},
+	}
+}
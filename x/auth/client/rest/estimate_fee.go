@@ -13,6 +13,7 @@ import (
 
 // EstimateTxFeeRequestHandlerFn returns estimated tx fee. In particular,
 // it takes 'auto' for the gas field, then simulates and computes gas consumption.
+// The wasm gas traces of the simulation are returned on trace request.
 func EstimateTxFeeRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req utils.EstimateFeeReq
@@ -35,13 +36,13 @@ func EstimateTxFeeRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 			return
 		}
 
-		fees, gas, err := utils.ComputeFeesWithStdTx(cliCtx, req.Tx, gasAdjustment, req.GasPrices)
+		fees, gas, traces, err := utils.ComputeFeesWithGasTraces(cliCtx, req.Tx, gasAdjustment, req.GasPrices, req.Trace)
 		if err != nil {
 			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
-		response := utils.EstimateFeeResp{Fees: fees, Gas: gas}
+		response := utils.EstimateFeeResp{Fees: fees, Gas: gas, GasTraces: traces}
 		rest.PostProcessResponse(w, cliCtx, response)
 	}
 }
@@ -9,11 +9,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	genutilrest "github.com/cosmos/cosmos-sdk/x/genutil/client/rest"
 
+	"github.com/gorilla/mux"
 	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/types"
 	"github.com/terra-project/core/client/lcd"
+	tauthtypes "github.com/terra-project/core/x/auth/internal/types"
 )
 
 // TxQueryMaxHeightRange maximum allowed height range for /txs query
@@ -129,3 +132,35 @@ func validateTxHeightRange(r *http.Request) error {
 
 	return nil
 }
+
+// QueryVestingScheduleRequestHandlerFn implements a REST handler that queries the vesting
+// schedule and the future unlock events of a vesting account.
+func QueryVestingScheduleRequestHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		addr, err := sdk.AccAddressFromBech32(mux.Vars(r)["address"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		bz, err := cliCtx.Codec.MarshalJSON(tauthtypes.NewQueryVestingScheduleParams(addr))
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", authtypes.QuerierRoute, tauthtypes.QueryVestingSchedule), bz)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
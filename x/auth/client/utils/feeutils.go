@@ -12,6 +12,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	core "github.com/terra-project/core/types"
+	vestingtypes "github.com/terra-project/core/x/auth/vesting/types"
 
 	marketexported "github.com/terra-project/core/x/market/exported"
 	msgauthexported "github.com/terra-project/core/x/msgauth/exported"
@@ -25,21 +26,26 @@ type (
 		Tx            auth.StdTx   `json:"tx"`
 		GasAdjustment string       `json:"gas_adjustment"`
 		GasPrices     sdk.DecCoins `json:"gas_prices"`
+		// Trace requests the wasm gas traces of the simulation
+		Trace bool `json:"trace,omitempty"`
 	}
 
 	// EstimateFeeResp defines a tx encoding response.
 	EstimateFeeResp struct {
 		Fees sdk.Coins `json:"fees"`
 		Gas  uint64    `json:"gas"`
+		// GasTraces are the gas breakdowns of the wasm msgs, only filled on trace request
+		GasTraces []wasmexported.GasTrace `json:"gas_traces,omitempty"`
 	}
 )
 
 // String implements fmt.Stringer interface
 func (r EstimateFeeResp) String() string {
 	return fmt.Sprintf(`EstimateFeeResp
-	fees: %s,
-	gas:  %d`,
-		r.Fees, r.Gas)
+	fees:       %s,
+	gas:        %d,
+	gas_traces: %v`,
+		r.Fees, r.Gas, r.GasTraces)
 }
 
 // ComputeFeesWithStdTx returns fee amount with given stdTx.
@@ -49,10 +55,23 @@ func ComputeFeesWithStdTx(
 	gasAdjustment float64,
 	gasPrices sdk.DecCoins) (fees sdk.Coins, gas uint64, err error) {
 
+	fees, gas, _, err = ComputeFeesWithGasTraces(cliCtx, tx, gasAdjustment, gasPrices, false)
+	return
+}
+
+// ComputeFeesWithGasTraces returns fee amount with given stdTx, and the wasm gas
+// traces of the simulation when trace is set. The tx is always simulated on trace.
+func ComputeFeesWithGasTraces(
+	cliCtx context.CLIContext,
+	tx auth.StdTx,
+	gasAdjustment float64,
+	gasPrices sdk.DecCoins,
+	trace bool) (fees sdk.Coins, gas uint64, traces []wasmexported.GasTrace, err error) {
+
 	gas = tx.Fee.Gas
 	sim := (gas == 0)
 
-	if sim {
+	if sim || trace {
 		tx.Signatures = []auth.StdSignature{}
 
 		signers := make(map[string]bool)
@@ -67,22 +86,31 @@ func ComputeFeesWithStdTx(
 
 		txBytes, err := utils.GetTxEncoder(cliCtx.Codec)(tx)
 		if err != nil {
-			return nil, 0, err
+			return nil, 0, nil, err
 		}
 
-		_, adj, err := utils.CalculateGas(cliCtx.QueryWithData, cliCtx.Codec, txBytes, gasAdjustment)
+		simRes, adj, err := utils.CalculateGas(cliCtx.QueryWithData, cliCtx.Codec, txBytes, gasAdjustment)
 
 		if err != nil {
-			return nil, 0, err
+			return nil, 0, nil, err
 		}
 
-		gas = adj
+		if trace && simRes.Result != nil {
+			traces, err = wasmexported.ParseGasTraces(simRes.Result.Events)
+			if err != nil {
+				return nil, 0, nil, err
+			}
+		}
+
+		if sim {
+			gas = adj
+		}
 	}
 
 	// Computes taxes of the msgs
 	taxes, err := filterMsgAndComputeTax(cliCtx, tx.Msgs)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, nil, err
 	}
 
 	fees = fees.Add(taxes...)
@@ -223,12 +251,36 @@ func filterMsgAndComputeTax(cliCtx context.CLIContext, msgs []sdk.Msg) (taxes sd
 
 			taxes = taxes.Add(tax...)
 
+		case wasmexported.MsgInstantiateContract2:
+			tax, err := computeTax(cliCtx, taxRate, msg.InitCoins)
+			if err != nil {
+				return nil, err
+			}
+
+			taxes = taxes.Add(tax...)
+
 		case wasmexported.MsgExecuteContract:
 			tax, err := computeTax(cliCtx, taxRate, msg.Coins)
 			if err != nil {
 				return nil, err
 			}
 
+			taxes = taxes.Add(tax...)
+
+		case vestingtypes.MsgCreateVestingAccount:
+			tax, err := computeTax(cliCtx, taxRate, msg.Amount)
+			if err != nil {
+				return nil, err
+			}
+
+			taxes = taxes.Add(tax...)
+
+		case vestingtypes.MsgAddVestingSchedule:
+			tax, err := computeTax(cliCtx, taxRate, msg.Amount)
+			if err != nil {
+				return nil, err
+			}
+
 			taxes = taxes.Add(tax...)
 		}
 	}
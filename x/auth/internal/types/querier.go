@@ -0,0 +1,20 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// query endpoints supported by the auth querier on top of the cosmos auth queries
+const (
+	QueryVestingSchedule = "vesting_schedule"
+)
+
+// QueryVestingScheduleParams defines the params for querying the vesting schedule of an account
+type QueryVestingScheduleParams struct {
+	Address sdk.AccAddress `json:"address"`
+}
+
+// NewQueryVestingScheduleParams returns QueryVestingScheduleParams instance
+func NewQueryVestingScheduleParams(addr sdk.AccAddress) QueryVestingScheduleParams {
+	return QueryVestingScheduleParams{Address: addr}
+}
@@ -69,6 +69,7 @@ func (AppModuleBasic) GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 type AppModule struct {
 	AppModuleBasic
 	cosmosAppModule CosmosAppModule
+	accountKeeper   AccountKeeper
 }
 
 // NewAppModule creates a new AppModule object
@@ -76,6 +77,7 @@ func NewAppModule(accountKeeper AccountKeeper) AppModule {
 	return AppModule{
 		AppModuleBasic:  AppModuleBasic{},
 		cosmosAppModule: NewCosmosAppModule(accountKeeper),
+		accountKeeper:   accountKeeper,
 	}
 }
 
@@ -103,7 +105,7 @@ func (am AppModule) NewHandler() sdk.Handler {
 func (am AppModule) QuerierRoute() string { return am.cosmosAppModule.QuerierRoute() }
 
 // NewQuerierHandler returns the auth module sdk.Querier.
-func (am AppModule) NewQuerierHandler() sdk.Querier { return am.cosmosAppModule.NewQuerierHandler() }
+func (am AppModule) NewQuerierHandler() sdk.Querier { return newQuerier(am.accountKeeper) }
 
 // InitGenesis performs genesis initialization for the auth module.
 func (am AppModule) InitGenesis(ctx sdk.Context, data json.RawMessage) []abci.ValidatorUpdate {
@@ -0,0 +1,50 @@
+package auth
+
+import (
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/terra-project/core/x/auth/internal/types"
+	vestingtypes "github.com/terra-project/core/x/auth/vesting/types"
+)
+
+// newQuerier returns the auth querier which serves the vesting schedule query
+// and passes the other queries to the cosmos auth querier
+func newQuerier(ak AccountKeeper) sdk.Querier {
+	cosmosQuerier := NewQuerier(ak)
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case types.QueryVestingSchedule:
+			return queryVestingSchedule(ctx, req, ak)
+		default:
+			return cosmosQuerier(ctx, path, req)
+		}
+	}
+}
+
+func queryVestingSchedule(ctx sdk.Context, req abci.RequestQuery, ak AccountKeeper) ([]byte, error) {
+	var params types.QueryVestingScheduleParams
+	if err := ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	account := ak.GetAccount(ctx, params.Address)
+	if account == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", params.Address)
+	}
+
+	vestingAccount, ok := account.(*vestingtypes.LazyGradedVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrap(vestingtypes.ErrNotGradedVestingAccount, params.Address.String())
+	}
+
+	bz, err := codec.MarshalJSONIndent(ModuleCdc, vestingAccount.GetVestingTimeline(ctx.BlockTime()))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
@@ -98,7 +98,12 @@ func RandomizedGenState(simState *module.SimulationState) {
 // RandomGenesisAccounts returns randomly generated genesis accounts
 func RandomGenesisAccounts(simState *module.SimulationState) (genesisAccs exported.GenesisAccounts) {
 	for i, acc := range simState.Accounts {
-		coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(simState.InitialStake)), sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(simState.InitialStake)))
+		// the accounts hold terra to pay the stability tax on its transfers
+		coins := sdk.NewCoins(
+			sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(simState.InitialStake)),
+			sdk.NewCoin(core.MicroLunaDenom, sdk.NewInt(simState.InitialStake)),
+			sdk.NewCoin(core.MicroSDRDenom, sdk.NewInt(simState.InitialStake)),
+		)
 		bacc := types.NewBaseAccountWithAddress(acc.Address)
 		if err := bacc.SetCoins(coins); err != nil {
 			panic(err)
@@ -6,15 +6,31 @@ import (
 	"github.com/terra-project/core/x/auth/vesting/types"
 )
 
+const (
+	ModuleName = types.ModuleName
+	RouterKey  = types.RouterKey
+)
+
 var (
 	// functions aliases
 	RegisterCodec                  = types.RegisterCodec
 	NewBaseVestingAccount          = authtypes.NewBaseVestingAccount
 	NewLazyGradedVestingAccountRaw = types.NewLazyGradedVestingAccountRaw
 	NewLazyGradedVestingAccount    = types.NewLazyGradedVestingAccount
+	NewLazySchedule                = types.NewLazySchedule
+	NewVestingSchedule             = types.NewVestingSchedule
+	NewMsgCreateVestingAccount     = types.NewMsgCreateVestingAccount
+	NewMsgAddVestingSchedule       = types.NewMsgAddVestingSchedule
+	NewMsgClawback                 = types.NewMsgClawback
 
 	// variable aliases
-	VestingCdc = types.VestingCdc
+	VestingCdc                 = types.VestingCdc
+	ErrAccountExists           = types.ErrAccountExists
+	ErrNotGradedVestingAccount = types.ErrNotGradedVestingAccount
+	ErrInvalidFunder           = types.ErrInvalidFunder
+	ErrNoClawback              = types.ErrNoClawback
+	ErrInvalidVestingSchedules = types.ErrInvalidVestingSchedules
+	ErrNoFunder                = types.ErrNoFunder
 )
 
 type (
@@ -25,4 +41,8 @@ type (
 	LazySchedules    = types.LazySchedules
 	VestingSchedule  = types.VestingSchedule
 	VestingSchedules = types.VestingSchedules
+
+	MsgCreateVestingAccount = types.MsgCreateVestingAccount
+	MsgAddVestingSchedule   = types.MsgAddVestingSchedule
+	MsgClawback             = types.MsgClawback
 )
@@ -0,0 +1,229 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/terra-project/core/x/auth/vesting/types"
+)
+
+// Flags for vesting commands
+const (
+	FlagFunder = "funder"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	vestingTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Vesting transactions subcommands",
+		Long:                       "Create graded vesting accounts, add vesting schedules to them and claw back unvested coins",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	vestingTxCmd.AddCommand(flags.PostCommands(
+		GetCmdCreateVestingAccount(cdc),
+		GetCmdAddVestingSchedule(cdc),
+		GetCmdClawback(cdc),
+	)...)
+
+	return vestingTxCmd
+}
+
+// GetCmdCreateVestingAccount will create a create vesting account tx and sign it with the given key.
+func GetCmdCreateVestingAccount(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-vesting-account [to-address] [amount] [denom|start|end|ratio][,[denom|start|end|ratio]]",
+		Short: "Create a new graded vesting account funded with the amount",
+		Long: strings.TrimSpace(`
+Create a new lazy graded vesting account funded with the amount from your account.
+'start' and 'end' of the vesting schedules are unix timestamps in seconds, and the ratios
+of each denom must sum to one,
+
+$ terracli tx vesting create-vesting-account terra... 1000000uluna 'uluna|1609459200|1640995200|0.5,uluna|1640995200|1672531200|0.5' --from mykey
+
+With a funder, the funder can add vesting schedules to the account and claw back the unvested coins,
+
+$ terracli tx vesting create-vesting-account terra... 1000000uluna 'uluna|1609459200|1640995200|1' --funder terra... --from mykey
+`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			vestingSchedules, err := ParseVestingSchedules(args[2])
+			if err != nil {
+				return err
+			}
+
+			var funderAddr sdk.AccAddress
+			if funder := viper.GetString(FlagFunder); len(funder) != 0 {
+				funderAddr, err = sdk.AccAddressFromBech32(funder)
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgCreateVestingAccount(cliCtx.GetFromAddress(), toAddr, amount, vestingSchedules, funderAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().String(FlagFunder, "", "address which can add vesting schedules and claw back the unvested coins")
+
+	return cmd
+}
+
+// GetCmdAddVestingSchedule will create an add vesting schedule tx and sign it with the given key.
+func GetCmdAddVestingSchedule(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-vesting-schedule [to-address] [amount] [denom|start|end|ratio][,[denom|start|end|ratio]]",
+		Short: "Add the amount vesting by the schedules to a graded vesting account",
+		Long: strings.TrimSpace(`
+Send the amount to a lazy graded vesting account, which vests by the given schedules.
+The coins already vested stay vested. Only the funder can add schedules to the account,
+which must have been created with a funder.
+
+$ terracli tx vesting add-vesting-schedule terra... 1000000uluna 'uluna|1609459200|1640995200|1' --from mykey
+`),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			toAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			vestingSchedules, err := ParseVestingSchedules(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddVestingSchedule(cliCtx.GetFromAddress(), toAddr, amount, vestingSchedules)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdClawback will create a clawback tx and sign it with the given key.
+func GetCmdClawback(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clawback [account-address]",
+		Short: "Claw back the unvested coins of a graded vesting account",
+		Long: strings.TrimSpace(`
+Claw back the unvested coins held by a lazy graded vesting account to the funder.
+The delegated unvested coins keep vesting and can be clawed back after undelegation,
+
+$ terracli tx vesting clawback terra... --from funder
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			accountAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgClawback(cliCtx.GetFromAddress(), accountAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// ParseVestingSchedules parses comma separated vesting schedules in the format of
+// denom|start|end|ratio, where start and end are unix timestamps in seconds
+func ParseVestingSchedules(unparsedSchedules string) (types.VestingSchedules, error) {
+	var vestingSchedules types.VestingSchedules
+	for _, unparsedSchedule := range strings.Split(unparsedSchedules, ",") {
+		items := strings.Split(strings.TrimSpace(unparsedSchedule), "|")
+		if len(items) != 4 {
+			return nil, fmt.Errorf("vesting schedule parse error: %s", unparsedSchedule)
+		}
+
+		denom := items[0]
+		startTime, err := strconv.ParseInt(items[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		endTime, err := strconv.ParseInt(items[2], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		ratio, err := sdk.NewDecFromStr(items[3])
+		if err != nil {
+			return nil, err
+		}
+
+		lazySchedule := types.NewLazySchedule(startTime, endTime, ratio)
+
+		found := false
+		for i, vestingSchedule := range vestingSchedules {
+			if vestingSchedule.Denom == denom {
+				vestingSchedules[i].LazySchedules = append(vestingSchedule.LazySchedules, lazySchedule)
+				found = true
+				break
+			}
+		}
+
+		if !found {
+			vestingSchedules = append(vestingSchedules, types.NewVestingSchedule(denom, types.LazySchedules{lazySchedule}))
+		}
+	}
+
+	return vestingSchedules, nil
+}
@@ -0,0 +1,40 @@
+package rest
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	"github.com/terra-project/core/x/auth/vesting/types"
+)
+
+// nolint
+const (
+	RestAccount = "account"
+)
+
+// RegisterRoutes register routes for tx broadcast
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	registerTxRoutes(cliCtx, r)
+}
+
+// CreateVestingAccountRequest defines the properties of a create vesting account request's body.
+type CreateVestingAccountRequest struct {
+	BaseReq          rest.BaseReq           `json:"base_req" yaml:"base_req"`
+	Amount           sdk.Coins              `json:"amount" yaml:"amount"`
+	VestingSchedules types.VestingSchedules `json:"vesting_schedules" yaml:"vesting_schedules"`
+	FunderAddress    sdk.AccAddress         `json:"funder_address,omitempty" yaml:"funder_address,omitempty"`
+}
+
+// AddVestingScheduleRequest defines the properties of an add vesting schedule request's body.
+type AddVestingScheduleRequest struct {
+	BaseReq          rest.BaseReq           `json:"base_req" yaml:"base_req"`
+	Amount           sdk.Coins              `json:"amount" yaml:"amount"`
+	VestingSchedules types.VestingSchedules `json:"vesting_schedules" yaml:"vesting_schedules"`
+}
+
+// ClawbackRequest defines the properties of a clawback request's body.
+type ClawbackRequest struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+}
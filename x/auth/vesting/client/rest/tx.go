@@ -0,0 +1,121 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/terra-project/core/x/auth/vesting/types"
+)
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(fmt.Sprintf("/vesting/accounts/{%s}", RestAccount), createVestingAccountHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/vesting/accounts/{%s}/schedules", RestAccount), addVestingScheduleHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/vesting/accounts/{%s}/clawback", RestAccount), clawbackHandler(cliCtx)).Methods("POST")
+}
+
+func parseAddresses(w http.ResponseWriter, r *http.Request, from string) (fromAddr, accountAddr sdk.AccAddress, ok bool) {
+	accountAddr, err := sdk.AccAddressFromBech32(mux.Vars(r)[RestAccount])
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return nil, nil, false
+	}
+
+	fromAddr, err = sdk.AccAddressFromBech32(from)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return nil, nil, false
+	}
+
+	return fromAddr, accountAddr, true
+}
+
+func createVestingAccountHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req CreateVestingAccountRequest
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, accountAddr, ok := parseAddresses(w, r, req.BaseReq.From)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgCreateVestingAccount(fromAddr, accountAddr, req.Amount, req.VestingSchedules, req.FunderAddress)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func addVestingScheduleHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req AddVestingScheduleRequest
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		fromAddr, accountAddr, ok := parseAddresses(w, r, req.BaseReq.From)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgAddVestingSchedule(fromAddr, accountAddr, req.Amount, req.VestingSchedules)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func clawbackHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req ClawbackRequest
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		funderAddr, accountAddr, ok := parseAddresses(w, r, req.BaseReq.From)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgClawback(funderAddr, accountAddr)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,175 @@
+package vesting
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/terra-project/core/x/auth/vesting/types"
+)
+
+// NewHandler returns a handler for "vesting" type messages.
+func NewHandler(ak types.AccountKeeper, bk types.BankKeeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		switch msg := msg.(type) {
+		case MsgCreateVestingAccount:
+			return handleMsgCreateVestingAccount(ctx, ak, bk, msg)
+		case MsgAddVestingSchedule:
+			return handleMsgAddVestingSchedule(ctx, ak, bk, msg)
+		case MsgClawback:
+			return handleMsgClawback(ctx, ak, bk, msg)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized vesting message type: %T", msg)
+		}
+	}
+}
+
+func handleMsgCreateVestingAccount(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, msg MsgCreateVestingAccount) (*sdk.Result, error) {
+	if !bk.GetSendEnabled(ctx) {
+		return nil, bank.ErrSendDisabled
+	}
+
+	if bk.BlacklistedAddr(msg.ToAddress) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive transactions", msg.ToAddress)
+	}
+
+	if acc := ak.GetAccount(ctx, msg.ToAddress); acc != nil {
+		return nil, sdkerrors.Wrap(types.ErrAccountExists, msg.ToAddress.String())
+	}
+
+	// the coins are sent after the account is created, so the original vesting is set without
+	// checking the balance
+	baseAccount := authtypes.NewBaseAccountWithAddress(msg.ToAddress)
+	baseVestingAccount := &BaseVestingAccount{
+		BaseAccount:     &baseAccount,
+		OriginalVesting: msg.Amount,
+	}
+
+	vestingAccount := NewLazyGradedVestingAccountRaw(baseVestingAccount, msg.VestingSchedules)
+	vestingAccount.FunderAddress = msg.FunderAddress
+
+	ak.SetAccount(ctx, ak.NewAccount(ctx, vestingAccount))
+
+	if err := bk.SendCoins(ctx, msg.FromAddress, msg.ToAddress, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeCreateVestingAccount,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.ToAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgAddVestingSchedule(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, msg MsgAddVestingSchedule) (*sdk.Result, error) {
+	if !bk.GetSendEnabled(ctx) {
+		return nil, bank.ErrSendDisabled
+	}
+
+	vestingAccount, err := getLazyGradedVestingAccount(ctx, ak, msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	// only the funder can add schedules, which change the unlock curve of the account
+	// and are clawed back by the funder; the account without a funder takes no more schedules
+	if vestingAccount.FunderAddress.Empty() {
+		return nil, sdkerrors.Wrap(types.ErrNoFunder, msg.ToAddress.String())
+	}
+
+	if !vestingAccount.FunderAddress.Equals(msg.FromAddress) {
+		return nil, sdkerrors.Wrap(types.ErrInvalidFunder, msg.FromAddress.String())
+	}
+
+	if err := bk.SendCoins(ctx, msg.FromAddress, msg.ToAddress, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	vestingAccount, err = getLazyGradedVestingAccount(ctx, ak, msg.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	vestingAccount.AddVestingSchedules(msg.Amount, msg.VestingSchedules)
+	ak.SetAccount(ctx, vestingAccount)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeAddVestingSchedule,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.ToAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FromAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgClawback(ctx sdk.Context, ak types.AccountKeeper, bk types.BankKeeper, msg MsgClawback) (*sdk.Result, error) {
+	vestingAccount, err := getLazyGradedVestingAccount(ctx, ak, msg.AccountAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if vestingAccount.FunderAddress.Empty() || !vestingAccount.FunderAddress.Equals(msg.FunderAddress) {
+		return nil, sdkerrors.Wrap(types.ErrInvalidFunder, msg.FunderAddress.String())
+	}
+
+	clawback := vestingAccount.Clawback(ctx.BlockHeader().Time)
+	if clawback.Empty() {
+		return nil, types.ErrNoClawback
+	}
+
+	// the clawback coins are spendable once removed from the original vesting
+	ak.SetAccount(ctx, vestingAccount)
+
+	if err := bk.SendCoins(ctx, msg.AccountAddress, msg.FunderAddress, clawback); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeClawback,
+			sdk.NewAttribute(types.AttributeKeyAccount, msg.AccountAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyFunder, msg.FunderAddress.String()),
+			sdk.NewAttribute(types.AttributeKeyAmount, clawback.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.FunderAddress.String()),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func getLazyGradedVestingAccount(ctx sdk.Context, ak types.AccountKeeper, addr sdk.AccAddress) (*LazyGradedVestingAccount, error) {
+	acc := ak.GetAccount(ctx, addr)
+	if acc == nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "account %s does not exist", addr)
+	}
+
+	vestingAccount, ok := acc.(*LazyGradedVestingAccount)
+	if !ok {
+		return nil, sdkerrors.Wrap(types.ErrNotGradedVestingAccount, addr.String())
+	}
+
+	return vestingAccount, nil
+}
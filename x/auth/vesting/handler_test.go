@@ -0,0 +1,142 @@
+package vesting
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+func setupTestInput() (sdk.Context, auth.AccountKeeper, bank.BaseKeeper) {
+	db := dbm.NewMemDB()
+
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+	ms.LoadLatestVersion()
+
+	ctx := sdk.NewContext(ms, abci.Header{Time: time.Unix(1000, 0)}, false, log.NewNopLogger())
+
+	cdc := codec.New()
+	auth.RegisterCodec(cdc)
+	RegisterCodec(cdc)
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	paramsKeeper := params.NewKeeper(params.ModuleCdc, keyParams, tkeyParams)
+	accountKeeper := auth.NewAccountKeeper(cdc, keyAcc, paramsKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, paramsKeeper.Subspace(bank.DefaultParamspace), make(map[string]bool))
+	bankKeeper.SetSendEnabled(ctx, true)
+	accountKeeper.SetParams(ctx, auth.DefaultParams())
+
+	return ctx, accountKeeper, bankKeeper
+}
+
+func TestHandlerVestingAccount(t *testing.T) {
+	ctx, accountKeeper, bankKeeper := setupTestInput()
+	handler := NewHandler(accountKeeper, bankKeeper)
+
+	fromAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	funderAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	toAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+
+	coins := sdk.NewCoins(sdk.NewInt64Coin("foo", 10000))
+	fromAcc := accountKeeper.NewAccountWithAddress(ctx, fromAddr)
+	require.NoError(t, fromAcc.SetCoins(coins))
+	accountKeeper.SetAccount(ctx, fromAcc)
+	funderAcc := accountKeeper.NewAccountWithAddress(ctx, funderAddr)
+	require.NoError(t, funderAcc.SetCoins(coins))
+	accountKeeper.SetAccount(ctx, funderAcc)
+
+	// vests linearly for 1000 seconds from the block time
+	amount := sdk.NewCoins(sdk.NewInt64Coin("foo", 1000))
+	schedules := VestingSchedules{
+		NewVestingSchedule("foo", LazySchedules{NewLazySchedule(1000, 2000, sdk.OneDec())}),
+	}
+
+	_, err := handler(ctx, NewMsgCreateVestingAccount(fromAddr, toAddr, amount, schedules, funderAddr))
+	require.NoError(t, err)
+
+	acc, ok := accountKeeper.GetAccount(ctx, toAddr).(*LazyGradedVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, amount, acc.GetCoins())
+	require.Equal(t, amount, acc.GetOriginalVesting())
+	require.Equal(t, funderAddr, acc.FunderAddress)
+	require.Equal(t, coins.Sub(amount), bankKeeper.GetCoins(ctx, fromAddr))
+
+	// the account already exists
+	_, err = handler(ctx, NewMsgCreateVestingAccount(fromAddr, toAddr, amount, schedules, nil))
+	require.Error(t, err)
+
+	// only the funder can add schedules
+	_, err = handler(ctx, NewMsgAddVestingSchedule(fromAddr, toAddr, amount, schedules))
+	require.True(t, ErrInvalidFunder.Is(err))
+
+	_, err = handler(ctx, NewMsgAddVestingSchedule(funderAddr, toAddr, amount, schedules))
+	require.NoError(t, err)
+
+	acc = accountKeeper.GetAccount(ctx, toAddr).(*LazyGradedVestingAccount)
+	require.Equal(t, amount.Add(amount...), acc.GetOriginalVesting())
+	require.Equal(t, amount.Add(amount...), acc.GetCoins())
+
+	// only the funder can claw back
+	ctx = ctx.WithBlockTime(time.Unix(1500, 0))
+	_, err = handler(ctx, NewMsgClawback(fromAddr, toAddr))
+	require.Error(t, err)
+
+	_, err = handler(ctx, NewMsgClawback(funderAddr, toAddr))
+	require.NoError(t, err)
+
+	acc = accountKeeper.GetAccount(ctx, toAddr).(*LazyGradedVestingAccount)
+	require.Equal(t, amount, acc.GetCoins())
+	require.Equal(t, acc.GetCoins(), acc.SpendableCoins(ctx.BlockTime()))
+	require.Equal(t, coins.Sub(amount).Add(amount...), bankKeeper.GetCoins(ctx, funderAddr))
+
+	// nothing left to claw back
+	_, err = handler(ctx, NewMsgClawback(funderAddr, toAddr))
+	require.Error(t, err)
+
+	// not a vesting account
+	_, err = handler(ctx, NewMsgClawback(funderAddr, fromAddr))
+	require.Error(t, err)
+
+	// the owner can't lock its own coins, which the funder would claw back
+	lockAmount := sdk.NewCoins(sdk.NewInt64Coin("foo", 100))
+	lockSchedules := VestingSchedules{
+		NewVestingSchedule("foo", LazySchedules{NewLazySchedule(2000, 3000, sdk.OneDec())}),
+	}
+	_, err = handler(ctx, NewMsgAddVestingSchedule(toAddr, toAddr, lockAmount, lockSchedules))
+	require.True(t, ErrInvalidFunder.Is(err))
+
+	acc = accountKeeper.GetAccount(ctx, toAddr).(*LazyGradedVestingAccount)
+	require.Equal(t, amount, acc.GetCoins())
+	require.Equal(t, acc.GetCoins(), acc.SpendableCoins(ctx.BlockTime()))
+
+	// no schedule is added to the account without a funder
+	noFunderAddr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
+	_, err = handler(ctx, NewMsgCreateVestingAccount(fromAddr, noFunderAddr, amount, schedules, nil))
+	require.NoError(t, err)
+
+	_, err = handler(ctx, NewMsgAddVestingSchedule(fromAddr, noFunderAddr, amount, schedules))
+	require.True(t, ErrNoFunder.Is(err))
+
+	_, err = handler(ctx, NewMsgAddVestingSchedule(noFunderAddr, noFunderAddr, amount, schedules))
+	require.True(t, ErrNoFunder.Is(err))
+}
@@ -0,0 +1,143 @@
+package vesting
+
+import (
+	"encoding/json"
+	"math/rand"
+
+	"github.com/gorilla/mux"
+	"github.com/spf13/cobra"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	sim "github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/terra-project/core/x/auth/vesting/client/cli"
+	"github.com/terra-project/core/x/auth/vesting/client/rest"
+	"github.com/terra-project/core/x/auth/vesting/simulation"
+)
+
+var (
+	_ module.AppModule           = AppModule{}
+	_ module.AppModuleBasic      = AppModuleBasic{}
+	_ module.AppModuleSimulation = AppModule{}
+)
+
+// AppModuleBasic defines the basic application module used by the vesting module.
+// The vesting accounts are stored by the auth module, so the module has no genesis state.
+type AppModuleBasic struct{}
+
+// Name returns the ModuleName
+func (AppModuleBasic) Name() string {
+	return ModuleName
+}
+
+// RegisterCodec registers the vesting types on the amino codec
+func (AppModuleBasic) RegisterCodec(cdc *codec.Codec) {
+	RegisterCodec(cdc)
+}
+
+// RegisterRESTRoutes registers all REST tx handlers
+func (AppModuleBasic) RegisterRESTRoutes(ctx context.CLIContext, r *mux.Router) {
+	rest.RegisterRoutes(ctx, r)
+}
+
+// GetQueryCmd returns no root query command for the vesting module.
+func (AppModuleBasic) GetQueryCmd(_ *codec.Codec) *cobra.Command { return nil }
+
+// GetTxCmd returns the transaction commands for this module
+func (AppModuleBasic) GetTxCmd(cdc *codec.Codec) *cobra.Command {
+	return cli.GetTxCmd(cdc)
+}
+
+// DefaultGenesis returns an empty genesis state of the vesting module.
+func (AppModuleBasic) DefaultGenesis() json.RawMessage {
+	return []byte("{}")
+}
+
+// ValidateGenesis performs genesis state validation for the vesting module.
+func (AppModuleBasic) ValidateGenesis(_ json.RawMessage) error {
+	return nil
+}
+
+// AppModule implements the sdk.AppModule interface
+type AppModule struct {
+	AppModuleBasic
+	accountKeeper auth.AccountKeeper
+	bankKeeper    bank.Keeper
+}
+
+// NewAppModule creates a new AppModule object
+func NewAppModule(accountKeeper auth.AccountKeeper, bankKeeper bank.Keeper) AppModule {
+	return AppModule{
+		AppModuleBasic: AppModuleBasic{},
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
+	}
+}
+
+// RegisterInvariants does nothing, there are no invariants to enforce
+func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+
+// Route returns the message routing key for the vesting module.
+func (AppModule) Route() string { return RouterKey }
+
+// NewHandler returns an sdk.Handler for the vesting module.
+func (am AppModule) NewHandler() sdk.Handler {
+	return NewHandler(am.accountKeeper, am.bankKeeper)
+}
+
+// QuerierRoute returns an empty string, the vesting accounts are queried from the auth module
+func (AppModule) QuerierRoute() string { return "" }
+
+// NewQuerierHandler returns nil, the vesting module has no querier
+func (AppModule) NewQuerierHandler() sdk.Querier { return nil }
+
+// InitGenesis does nothing, the vesting accounts are initialized by the auth module
+func (AppModule) InitGenesis(_ sdk.Context, _ json.RawMessage) []abci.ValidatorUpdate {
+	return nil
+}
+
+// ExportGenesis returns an empty genesis state of the vesting module.
+func (am AppModule) ExportGenesis(_ sdk.Context) json.RawMessage {
+	return am.DefaultGenesis()
+}
+
+// BeginBlock does nothing
+func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+
+// EndBlock does nothing
+func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	return nil
+}
+
+//____________________________________________________________________________
+
+// AppModuleSimulation functions
+
+// GenerateGenesisState does nothing, the vesting accounts are generated by the auth module
+func (AppModule) GenerateGenesisState(_ *module.SimulationState) {}
+
+// ProposalContents returns all the vesting content functions used to
+// simulate governance proposals.
+func (AppModule) ProposalContents(_ module.SimulationState) []sim.WeightedProposalContent {
+	return nil
+}
+
+// RandomizedParams creates randomized vesting param changes for the simulator.
+func (AppModule) RandomizedParams(_ *rand.Rand) []sim.ParamChange {
+	return nil
+}
+
+// RegisterStoreDecoder does nothing, the vesting module has no store
+func (AppModule) RegisterStoreDecoder(_ sdk.StoreDecoderRegistry) {}
+
+// WeightedOperations returns the all the vesting module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []sim.WeightedOperation {
+	return simulation.WeightedOperations(simState.AppParams, simState.Cdc, am.accountKeeper)
+}
@@ -0,0 +1,289 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/terra-project/core/x/auth/vesting/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgCreateVestingAccount = "op_weight_msg_create_vesting_account"
+	OpWeightMsgAddVestingSchedule   = "op_weight_msg_add_vesting_schedule"
+	OpWeightMsgClawback             = "op_weight_msg_clawback"
+)
+
+// maxLazySchedules bounds the lazy schedules of the accounts the schedules are added to,
+// as the gas of writing an account grows with its schedules
+const maxLazySchedules = 30
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simulation.AppParams, cdc *codec.Codec, ak authkeeper.AccountKeeper,
+) simulation.WeightedOperations {
+
+	var (
+		weightMsgCreateVestingAccount int
+		weightMsgAddVestingSchedule   int
+		weightMsgClawback             int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgCreateVestingAccount, &weightMsgCreateVestingAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateVestingAccount = simappparams.DefaultWeightMsgDelegate
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgAddVestingSchedule, &weightMsgAddVestingSchedule, nil,
+		func(_ *rand.Rand) {
+			weightMsgAddVestingSchedule = simappparams.DefaultWeightMsgUndelegate
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgClawback, &weightMsgClawback, nil,
+		func(_ *rand.Rand) {
+			weightMsgClawback = simappparams.DefaultWeightMsgUndelegate
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgCreateVestingAccount,
+			SimulateMsgCreateVestingAccount(ak),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgAddVestingSchedule,
+			SimulateMsgAddVestingSchedule(ak),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgClawback,
+			SimulateMsgClawback(ak),
+		),
+	}
+}
+
+// SimulateMsgCreateVestingAccount generates a MsgCreateVestingAccount with random values.
+// nolint: funlen
+func SimulateMsgCreateVestingAccount(ak authkeeper.AccountKeeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		from, _ := simulation.RandomAcc(r, accs)
+		to := simulation.RandomAccounts(r, 1)[0]
+		if ak.GetAccount(ctx, to.Address) != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, from.Address)
+		spendableCoins := account.SpendableCoins(ctx.BlockTime())
+		fees, err := simulation.RandomFees(r, ctx, spendableCoins)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		amount := simulation.RandSubsetCoins(r, spendableCoins.Sub(fees))
+		if amount.Empty() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// half of the accounts can be clawed back by the sender
+		var funderAddr sdk.AccAddress
+		if r.Intn(2) == 0 {
+			funderAddr = from.Address
+		}
+
+		msg := types.NewMsgCreateVestingAccount(from.Address, to.Address, amount, randomVestingSchedules(r, ctx, amount), funderAddr)
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			from.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			if strings.Contains(err.Error(), "insufficient fee") {
+				return simulation.NoOpMsg(types.ModuleName), nil, nil
+			}
+
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+func countLazySchedules(acc *types.LazyGradedVestingAccount) (n int) {
+	for _, vestingSchedule := range acc.GetVestingSchedules() {
+		n += len(vestingSchedule.LazySchedules)
+	}
+
+	return n
+}
+
+// SimulateMsgAddVestingSchedule generates a MsgAddVestingSchedule with random values.
+// nolint: funlen
+func SimulateMsgAddVestingSchedule(ak authkeeper.AccountKeeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		from, _ := simulation.RandomAcc(r, accs)
+
+		// find a vesting account funded by the sender
+		var toAddr sdk.AccAddress
+		ak.IterateAccounts(ctx, func(acc authexported.Account) bool {
+			vestingAcc, ok := acc.(*types.LazyGradedVestingAccount)
+			if ok && !vestingAcc.FunderAddress.Empty() && vestingAcc.FunderAddress.Equals(from.Address) &&
+				countLazySchedules(vestingAcc) < maxLazySchedules {
+				toAddr = vestingAcc.GetAddress()
+				return r.Intn(2) == 0
+			}
+
+			return false
+		})
+
+		if toAddr.Empty() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, from.Address)
+		spendableCoins := account.SpendableCoins(ctx.BlockTime())
+		fees, err := simulation.RandomFees(r, ctx, spendableCoins)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		amount := simulation.RandSubsetCoins(r, spendableCoins.Sub(fees))
+		if amount.Empty() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := types.NewMsgAddVestingSchedule(from.Address, toAddr, amount, randomVestingSchedules(r, ctx, amount))
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			from.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			if strings.Contains(err.Error(), "insufficient fee") {
+				return simulation.NoOpMsg(types.ModuleName), nil, nil
+			}
+
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// SimulateMsgClawback generates a MsgClawback with random values.
+// nolint: funlen
+func SimulateMsgClawback(ak authkeeper.AccountKeeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		// find a vesting account which has unvested coins to claw back by a funder among the accounts
+		var funder simulation.Account
+		var accountAddr sdk.AccAddress
+		ak.IterateAccounts(ctx, func(acc authexported.Account) bool {
+			vestingAcc, ok := acc.(*types.LazyGradedVestingAccount)
+			if !ok || vestingAcc.FunderAddress.Empty() || vestingAcc.GetVestingCoins(ctx.BlockTime()).Empty() {
+				return false
+			}
+
+			if simAcc, found := simulation.FindAccount(accs, vestingAcc.FunderAddress); found {
+				funder = simAcc
+				accountAddr = vestingAcc.GetAddress()
+				return r.Intn(2) == 0
+			}
+
+			return false
+		})
+
+		if accountAddr.Empty() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		// the clawback can be empty when the unvested coins are delegated
+		vestingAcc := ak.GetAccount(ctx, accountAddr).(*types.LazyGradedVestingAccount)
+		if vestingAcc.Clawback(ctx.BlockTime()).Empty() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, funder.Address)
+		fees, err := simulation.RandomFees(r, ctx, account.SpendableCoins(ctx.BlockTime()))
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		msg := types.NewMsgClawback(funder.Address, accountAddr)
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			funder.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
+
+// randomVestingSchedules returns the vesting schedules of the amount, each of which
+// vests in one or two random periods within a day from the block time
+func randomVestingSchedules(r *rand.Rand, ctx sdk.Context, amount sdk.Coins) types.VestingSchedules {
+	blockTime := ctx.BlockTime().Unix()
+
+	var vestingSchedules types.VestingSchedules
+	for _, coin := range amount {
+		startTime := blockTime + int64(simulation.RandIntBetween(r, 0, 43200))
+		endTime := startTime + int64(simulation.RandIntBetween(r, 1, 43200))
+
+		lazySchedules := types.LazySchedules{types.NewLazySchedule(startTime, endTime, sdk.OneDec())}
+		if r.Intn(2) == 0 {
+			ratio := sdk.NewDecWithPrec(int64(simulation.RandIntBetween(r, 1, 100)), 2)
+			lazySchedules = types.LazySchedules{
+				types.NewLazySchedule(blockTime, startTime, ratio),
+				types.NewLazySchedule(startTime, endTime, sdk.OneDec().Sub(ratio)),
+			}
+		}
+
+		vestingSchedules = append(vestingSchedules, types.NewVestingSchedule(coin.Denom, lazySchedules))
+	}
+
+	return vestingSchedules
+}
@@ -11,6 +11,10 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterInterface((*exported.VestingAccount)(nil), nil)
 	cdc.RegisterConcrete(&authtypes.BaseVestingAccount{}, "core/BaseVestingAccount", nil)
 	cdc.RegisterConcrete(&LazyGradedVestingAccount{}, "core/LazyGradedVestingAccount", nil)
+
+	cdc.RegisterConcrete(MsgCreateVestingAccount{}, "vesting/MsgCreateVestingAccount", nil)
+	cdc.RegisterConcrete(MsgAddVestingSchedule{}, "vesting/MsgAddVestingSchedule", nil)
+	cdc.RegisterConcrete(MsgClawback{}, "vesting/MsgClawback", nil)
 }
 
 // VestingCdc module wide codec
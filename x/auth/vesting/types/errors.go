@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/auth/vesting module sentinel errors
+var (
+	ErrAccountExists           = sdkerrors.Register(ModuleName, 2, "account already exists")
+	ErrNotGradedVestingAccount = sdkerrors.Register(ModuleName, 3, "account is not a lazy graded vesting account")
+	ErrInvalidFunder           = sdkerrors.Register(ModuleName, 4, "invalid funder address")
+	ErrNoClawback              = sdkerrors.Register(ModuleName, 5, "no unvested coins to claw back")
+	ErrInvalidVestingSchedules = sdkerrors.Register(ModuleName, 6, "invalid vesting schedules")
+	ErrNoFunder                = sdkerrors.Register(ModuleName, 7, "vesting account has no funder")
+)
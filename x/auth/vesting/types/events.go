@@ -0,0 +1,14 @@
+package types
+
+// vesting module events
+const (
+	EventTypeCreateVestingAccount = "create_vesting_account"
+	EventTypeAddVestingSchedule   = "add_vesting_schedule"
+	EventTypeClawback             = "clawback"
+
+	AttributeKeyFunder  = "funder"
+	AttributeKeyAccount = "account"
+	AttributeKeyAmount  = "amount"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,163 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgCreateVestingAccount creates a new lazy graded vesting account funded with
+// the amount, which vests by the vesting schedules. The funder, when given,
+// can add vesting schedules to the account and claw back the unvested coins.
+type MsgCreateVestingAccount struct {
+	FromAddress      sdk.AccAddress   `json:"from_address" yaml:"from_address"`
+	ToAddress        sdk.AccAddress   `json:"to_address" yaml:"to_address"`
+	Amount           sdk.Coins        `json:"amount" yaml:"amount"`
+	VestingSchedules VestingSchedules `json:"vesting_schedules" yaml:"vesting_schedules"`
+	FunderAddress    sdk.AccAddress   `json:"funder_address,omitempty" yaml:"funder_address,omitempty"`
+}
+
+// NewMsgCreateVestingAccount returns new MsgCreateVestingAccount instance
+func NewMsgCreateVestingAccount(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins,
+	vestingSchedules VestingSchedules, funderAddr sdk.AccAddress) MsgCreateVestingAccount {
+	return MsgCreateVestingAccount{
+		FromAddress:      fromAddr,
+		ToAddress:        toAddr,
+		Amount:           amount,
+		VestingSchedules: vestingSchedules,
+		FunderAddress:    funderAddr,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgCreateVestingAccount) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgCreateVestingAccount) Type() string { return "create_vesting_account" }
+
+// GetSigners implements sdk.Msg
+func (msg MsgCreateVestingAccount) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgCreateVestingAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(VestingCdc.MustMarshalJSON(msg))
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgCreateVestingAccount) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing from address")
+	}
+
+	if msg.ToAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing to address")
+	}
+
+	return validateAmountAndSchedules(msg.Amount, msg.VestingSchedules)
+}
+
+// MsgAddVestingSchedule adds the amount vesting by the vesting schedules to
+// an existing lazy graded vesting account. Only the funder can add schedules to
+// the account, which must have a funder.
+type MsgAddVestingSchedule struct {
+	FromAddress      sdk.AccAddress   `json:"from_address" yaml:"from_address"`
+	ToAddress        sdk.AccAddress   `json:"to_address" yaml:"to_address"`
+	Amount           sdk.Coins        `json:"amount" yaml:"amount"`
+	VestingSchedules VestingSchedules `json:"vesting_schedules" yaml:"vesting_schedules"`
+}
+
+// NewMsgAddVestingSchedule returns new MsgAddVestingSchedule instance
+func NewMsgAddVestingSchedule(fromAddr, toAddr sdk.AccAddress, amount sdk.Coins,
+	vestingSchedules VestingSchedules) MsgAddVestingSchedule {
+	return MsgAddVestingSchedule{
+		FromAddress:      fromAddr,
+		ToAddress:        toAddr,
+		Amount:           amount,
+		VestingSchedules: vestingSchedules,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgAddVestingSchedule) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgAddVestingSchedule) Type() string { return "add_vesting_schedule" }
+
+// GetSigners implements sdk.Msg
+func (msg MsgAddVestingSchedule) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FromAddress}
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgAddVestingSchedule) GetSignBytes() []byte {
+	return sdk.MustSortJSON(VestingCdc.MustMarshalJSON(msg))
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgAddVestingSchedule) ValidateBasic() error {
+	if msg.FromAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing from address")
+	}
+
+	if msg.ToAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing to address")
+	}
+
+	return validateAmountAndSchedules(msg.Amount, msg.VestingSchedules)
+}
+
+// MsgClawback claws the unvested coins of the account back to the funder
+type MsgClawback struct {
+	FunderAddress  sdk.AccAddress `json:"funder_address" yaml:"funder_address"`
+	AccountAddress sdk.AccAddress `json:"account_address" yaml:"account_address"`
+}
+
+// NewMsgClawback returns new MsgClawback instance
+func NewMsgClawback(funderAddr, accountAddr sdk.AccAddress) MsgClawback {
+	return MsgClawback{
+		FunderAddress:  funderAddr,
+		AccountAddress: accountAddr,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgClawback) Route() string { return RouterKey }
+
+// Type implements sdk.Msg
+func (msg MsgClawback) Type() string { return "clawback" }
+
+// GetSigners implements sdk.Msg
+func (msg MsgClawback) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.FunderAddress}
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgClawback) GetSignBytes() []byte {
+	return sdk.MustSortJSON(VestingCdc.MustMarshalJSON(msg))
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgClawback) ValidateBasic() error {
+	if msg.FunderAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing funder address")
+	}
+
+	if msg.AccountAddress.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing account address")
+	}
+
+	return nil
+}
+
+func validateAmountAndSchedules(amount sdk.Coins, vestingSchedules VestingSchedules) error {
+	if !amount.IsValid() || amount.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, amount.String())
+	}
+
+	if err := vestingSchedules.ValidateFor(amount); err != nil {
+		return sdkerrors.Wrap(ErrInvalidVestingSchedules, err.Error())
+	}
+
+	return nil
+}
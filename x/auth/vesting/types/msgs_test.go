@@ -0,0 +1,98 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestMsgCreateVestingAccount(t *testing.T) {
+	_, _, addr1 := KeyTestPubAddr()
+	_, _, addr2 := KeyTestPubAddr()
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100))
+	schedules := VestingSchedules{
+		NewVestingSchedule(feeDenom, LazySchedules{NewLazySchedule(100, 200, sdk.OneDec())}),
+		NewVestingSchedule(stakeDenom, LazySchedules{
+			NewLazySchedule(100, 200, sdk.NewDecWithPrec(5, 1)),
+			NewLazySchedule(200, 300, sdk.NewDecWithPrec(5, 1)),
+		}),
+	}
+
+	tests := []struct {
+		fromAddr      sdk.AccAddress
+		toAddr        sdk.AccAddress
+		amount        sdk.Coins
+		schedules     VestingSchedules
+		expectedError bool
+	}{
+		{addr1, addr2, amount, schedules, false},
+		{nil, addr2, amount, schedules, true},
+		{addr1, nil, amount, schedules, true},
+		{addr1, addr2, sdk.Coins{}, VestingSchedules{}, true},
+		{addr1, addr2, amount, schedules[:1], true},
+		{addr1, addr2, amount[:1], schedules, true},
+		{addr1, addr2, amount, VestingSchedules{schedules[0], schedules[0]}, true},
+		{addr1, addr2, amount, VestingSchedules{
+			schedules[0],
+			NewVestingSchedule(stakeDenom, LazySchedules{NewLazySchedule(100, 200, sdk.NewDecWithPrec(5, 1))}),
+		}, true},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgCreateVestingAccount(tc.fromAddr, tc.toAddr, tc.amount, tc.schedules, nil)
+		if tc.expectedError {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+			require.Equal(t, []sdk.AccAddress{tc.fromAddr}, msg.GetSigners())
+		}
+	}
+}
+
+func TestMsgAddVestingSchedule(t *testing.T) {
+	_, _, addr1 := KeyTestPubAddr()
+	_, _, addr2 := KeyTestPubAddr()
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 1000))
+	schedules := VestingSchedules{
+		NewVestingSchedule(feeDenom, LazySchedules{NewLazySchedule(100, 200, sdk.OneDec())}),
+	}
+
+	tests := []struct {
+		fromAddr      sdk.AccAddress
+		toAddr        sdk.AccAddress
+		amount        sdk.Coins
+		schedules     VestingSchedules
+		expectedError bool
+	}{
+		{addr1, addr2, amount, schedules, false},
+		{nil, addr2, amount, schedules, true},
+		{addr1, nil, amount, schedules, true},
+		{addr1, addr2, amount, nil, true},
+		{addr1, addr2, amount, VestingSchedules{
+			NewVestingSchedule(feeDenom, LazySchedules{NewLazySchedule(200, 100, sdk.OneDec())}),
+		}, true},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgAddVestingSchedule(tc.fromAddr, tc.toAddr, tc.amount, tc.schedules)
+		if tc.expectedError {
+			require.Error(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NoError(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMsgClawback(t *testing.T) {
+	_, _, addr1 := KeyTestPubAddr()
+	_, _, addr2 := KeyTestPubAddr()
+
+	require.NoError(t, NewMsgClawback(addr1, addr2).ValidateBasic())
+	require.Error(t, NewMsgClawback(nil, addr2).ValidateBasic())
+	require.Error(t, NewMsgClawback(addr1, nil).ValidateBasic())
+	require.Equal(t, []sdk.AccAddress{addr1}, NewMsgClawback(addr1, addr2).GetSigners())
+}
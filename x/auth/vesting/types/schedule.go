@@ -86,6 +86,52 @@ func (vs LazySchedules) String() string {
 		%s`, strings.Join(lazySchedulesListString, ", ")))
 }
 
+// sumRatio returns the sum of the ratios of the lazy schedules
+func (vs LazySchedules) sumRatio() sdk.Dec {
+	sumRatio := sdk.ZeroDec()
+	for _, lazySchedule := range vs {
+		sumRatio = sumRatio.Add(lazySchedule.GetRatio())
+	}
+
+	return sumRatio
+}
+
+// scale returns the lazy schedules with the ratios multiplied by the given ratio,
+// omitting the ones whose ratio becomes zero
+func (vs LazySchedules) scale(ratio sdk.Dec) LazySchedules {
+	var scaled LazySchedules
+	for _, lazySchedule := range vs {
+		scaledRatio := lazySchedule.GetRatio().Mul(ratio)
+		if !scaledRatio.IsPositive() {
+			continue
+		}
+
+		scaled = append(scaled, NewLazySchedule(lazySchedule.GetStartTime(), lazySchedule.GetEndTime(), scaledRatio))
+	}
+
+	return scaled
+}
+
+// remaining returns the parts of the lazy schedules which are not vested by blockTime
+func (vs LazySchedules) remaining(blockTime int64) LazySchedules {
+	var remaining LazySchedules
+	for _, lazySchedule := range vs {
+		startTime := lazySchedule.GetStartTime()
+		endTime := lazySchedule.GetEndTime()
+
+		if blockTime < startTime {
+			remaining = append(remaining, lazySchedule)
+		} else if blockTime < endTime {
+			ratio := lazySchedule.GetRatio().MulInt64(endTime - blockTime).QuoInt64(endTime - startTime)
+			if ratio.IsPositive() {
+				remaining = append(remaining, NewLazySchedule(blockTime, endTime, ratio))
+			}
+		}
+	}
+
+	return remaining
+}
+
 //-----------------------------------------------------------------------------
 // Vesting Lazy Schedule
 
@@ -165,6 +211,43 @@ func (vs VestingSchedule) String() string {
 // VestingSchedules stores all vesting schedules passed as part of a LazyGradedVestingAccount
 type VestingSchedules []VestingSchedule
 
+// GetVestingSchedule returns the VestingSchedule of the given denom
+func (vs VestingSchedules) GetVestingSchedule(denom string) (VestingSchedule, bool) {
+	for _, vestingSchedule := range vs {
+		if vestingSchedule.Denom == denom {
+			return vestingSchedule, true
+		}
+	}
+
+	return VestingSchedule{}, false
+}
+
+// ValidateFor checks that the vesting schedules are valid and
+// every denom of the amount has exactly one vesting schedule.
+func (vs VestingSchedules) ValidateFor(amount sdk.Coins) error {
+	if len(vs) != len(amount) {
+		return errors.New("every denom of the amount must have one vesting schedule")
+	}
+
+	denoms := make(map[string]bool)
+	for _, vestingSchedule := range vs {
+		if denoms[vestingSchedule.Denom] {
+			return fmt.Errorf("duplicate vesting schedule of %s", vestingSchedule.Denom)
+		}
+
+		denoms[vestingSchedule.Denom] = true
+		if !amount.AmountOf(vestingSchedule.Denom).IsPositive() {
+			return fmt.Errorf("vesting schedule of %s is not in the amount", vestingSchedule.Denom)
+		}
+
+		if err := vestingSchedule.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 // String implements stringer interface
 func (vs VestingSchedules) String() string {
 	vestingSchedulesListString := make([]string, len(vs))
@@ -0,0 +1,97 @@
+package types
+
+import (
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// UnlockEvent is a boundary of the vesting schedules where the vesting rate changes.
+// The coins vest linearly between two consecutive events.
+type UnlockEvent struct {
+	Time       int64   `json:"time" yaml:"time"`             // unix timestamp in seconds
+	Unlocked   sdk.Int `json:"unlocked" yaml:"unlocked"`     // amount vested since the previous event
+	Cumulative sdk.Int `json:"cumulative" yaml:"cumulative"` // total amount vested at the event
+}
+
+// DenomVestingTimeline is the vesting state of a denom at the block time
+// and the unlock events after the block time
+type DenomVestingTimeline struct {
+	Denom            string        `json:"denom" yaml:"denom"`
+	OriginalVesting  sdk.Int       `json:"original_vesting" yaml:"original_vesting"`
+	Vested           sdk.Int       `json:"vested" yaml:"vested"`
+	Vesting          sdk.Int       `json:"vesting" yaml:"vesting"`
+	DelegatedVesting sdk.Int       `json:"delegated_vesting" yaml:"delegated_vesting"`
+	LazySchedules    LazySchedules `json:"schedules" yaml:"schedules"`
+	UnlockEvents     []UnlockEvent `json:"unlock_events" yaml:"unlock_events"`
+}
+
+// VestingTimeline is the vesting timeline of a lazy graded vesting account
+type VestingTimeline struct {
+	Address sdk.AccAddress         `json:"address" yaml:"address"`
+	Time    int64                  `json:"time" yaml:"time"` // block time of the timeline
+	Denoms  []DenomVestingTimeline `json:"denoms" yaml:"denoms"`
+}
+
+// GetVestingTimeline returns the vesting timeline of the account from the block time.
+// The vested amounts are rounded the same way as GetVestedCoins.
+func (lgva LazyGradedVestingAccount) GetVestingTimeline(blockTime time.Time) VestingTimeline {
+	now := blockTime.Unix()
+	vestedCoins := lgva.GetVestedCoins(blockTime)
+
+	denoms := make([]DenomVestingTimeline, 0, len(lgva.OriginalVesting))
+	for _, ovc := range lgva.OriginalVesting {
+		vestedAmt := vestedCoins.AmountOf(ovc.Denom)
+		timeline := DenomVestingTimeline{
+			Denom:            ovc.Denom,
+			OriginalVesting:  ovc.Amount,
+			Vested:           vestedAmt,
+			Vesting:          ovc.Amount.Sub(vestedAmt),
+			DelegatedVesting: lgva.DelegatedVesting.AmountOf(ovc.Denom),
+			UnlockEvents:     []UnlockEvent{},
+		}
+
+		if vestingSchedule, exists := lgva.GetVestingSchedule(ovc.Denom); exists {
+			timeline.LazySchedules = vestingSchedule.LazySchedules
+
+			cumulative := vestedAmt
+			for _, eventTime := range vestingSchedule.boundariesAfter(now) {
+				vestedAmt := ovc.Amount.ToDec().Mul(vestingSchedule.GetVestedRatio(eventTime)).RoundInt()
+				timeline.UnlockEvents = append(timeline.UnlockEvents, UnlockEvent{
+					Time:       eventTime,
+					Unlocked:   vestedAmt.Sub(cumulative),
+					Cumulative: vestedAmt,
+				})
+
+				cumulative = vestedAmt
+			}
+		}
+
+		denoms = append(denoms, timeline)
+	}
+
+	return VestingTimeline{
+		Address: lgva.GetAddress(),
+		Time:    now,
+		Denoms:  denoms,
+	}
+}
+
+// boundariesAfter returns the sorted unique start and end times of the lazy schedules after blockTime
+func (vs VestingSchedule) boundariesAfter(blockTime int64) []int64 {
+	seen := make(map[int64]bool)
+
+	var boundaries []int64
+	for _, lazySchedule := range vs.LazySchedules {
+		for _, t := range []int64{lazySchedule.GetStartTime(), lazySchedule.GetEndTime()} {
+			if t > blockTime && !seen[t] {
+				seen[t] = true
+				boundaries = append(boundaries, t)
+			}
+		}
+	}
+
+	sort.Slice(boundaries, func(i, j int) bool { return boundaries[i] < boundaries[j] })
+	return boundaries
+}
@@ -30,6 +30,7 @@ type vestingAccountYAML struct {
 
 	// custom fields based on concrete vesting type which can be omitted
 	VestingSchedules VestingSchedules `json:"vesting_schedules,omitempty" yaml:"vesting_schedules,omitempty"`
+	FunderAddress    sdk.AccAddress   `json:"funder_address,omitempty" yaml:"funder_address,omitempty"`
 }
 
 // To prevent stack overflow
@@ -46,6 +47,7 @@ type vestingAccountJSON struct {
 
 	// custom fields based on concrete vesting type which can be omitted
 	VestingSchedules VestingSchedules `json:"vesting_schedules,omitempty" yaml:"vesting_schedules,omitempty"`
+	FunderAddress    sdk.AccAddress   `json:"funder_address,omitempty" yaml:"funder_address,omitempty"`
 }
 
 //-----------------------------------------------------------------------------
@@ -68,6 +70,9 @@ type LazyGradedVestingAccount struct {
 	*vesttypes.BaseVestingAccount
 
 	VestingSchedules VestingSchedules `json:"vesting_schedules"`
+
+	// FunderAddress can claw back the unvested coins; empty for the accounts which cannot be clawed back
+	FunderAddress sdk.AccAddress `json:"funder_address,omitempty"`
 }
 
 // NewLazyGradedVestingAccountRaw creates a new LazyGradedVestingAccount object from BaseVestingAccount
@@ -86,7 +91,10 @@ func NewLazyGradedVestingAccount(baseAcc *authtypes.BaseAccount, lazyVestingSche
 		EndTime:         0,
 	}
 
-	return &LazyGradedVestingAccount{baseVestingAcc, lazyVestingSchedules}
+	return &LazyGradedVestingAccount{
+		BaseVestingAccount: baseVestingAcc,
+		VestingSchedules:   lazyVestingSchedules,
+	}
 }
 
 // GetVestingSchedules returns the VestingSchedules of the graded lazy vesting account
@@ -144,6 +152,119 @@ func (lgva *LazyGradedVestingAccount) TrackDelegation(blockTime time.Time, amoun
 	lgva.trackDelegation(lgva.GetVestingCoins(blockTime), amount)
 }
 
+// AddVestingSchedules adds the amount vesting by the schedules to the original vesting.
+// The schedule of a denom already in the original vesting is merged with the new one
+// weighted by the amounts, so the coins vested so far stay vested.
+//
+// CONTRACT: every denom of the amount has a valid schedule.
+func (lgva *LazyGradedVestingAccount) AddVestingSchedules(amount sdk.Coins, vestingSchedules VestingSchedules) {
+	for _, coin := range amount {
+		vestingSchedule, _ := vestingSchedules.GetVestingSchedule(coin.Denom)
+		originalAmt := lgva.OriginalVesting.AmountOf(coin.Denom)
+		totalAmt := originalAmt.Add(coin.Amount).ToDec()
+
+		var lazySchedules LazySchedules
+		if originalAmt.IsPositive() {
+			currentSchedule, exists := lgva.GetVestingSchedule(coin.Denom)
+			if !exists {
+				// the original vesting without schedule is already vested
+				currentSchedule = NewVestingSchedule(coin.Denom, LazySchedules{NewLazySchedule(0, 0, sdk.OneDec())})
+			}
+
+			lazySchedules = currentSchedule.LazySchedules.scale(originalAmt.ToDec().Quo(totalAmt))
+		}
+
+		lazySchedules = append(lazySchedules, vestingSchedule.LazySchedules.scale(coin.Amount.ToDec().Quo(totalAmt))...)
+		lgva.setVestingSchedule(NewVestingSchedule(coin.Denom, lazySchedules))
+	}
+
+	lgva.OriginalVesting = lgva.OriginalVesting.Add(amount...)
+}
+
+// Clawback removes the unvested coins, which are not delegated, from the original vesting
+// and returns the ones held by the account to be clawed back. The unvested coins tracked
+// as delegated vesting are not held by the account, so they keep vesting by the remaining
+// schedule and can be clawed back once they are undelegated.
+func (lgva *LazyGradedVestingAccount) Clawback(blockTime time.Time) sdk.Coins {
+	var removed, clawback sdk.Coins
+	bc := lgva.GetCoins()
+
+	for _, vestingCoin := range lgva.GetVestingCoins(blockTime) {
+		delVestingAmt := lgva.DelegatedVesting.AmountOf(vestingCoin.Denom)
+
+		// compute max(V - DV, 0), which is the locked amount of the base coins
+		unvestedAmt := sdk.MaxInt(vestingCoin.Amount.Sub(delVestingAmt), sdk.ZeroInt())
+		if !unvestedAmt.IsPositive() {
+			continue
+		}
+
+		lgva.resetVestingSchedule(vestingCoin.Denom, blockTime.Unix(), vestingCoin.Amount, unvestedAmt)
+		removed = removed.Add(sdk.NewCoin(vestingCoin.Denom, unvestedAmt))
+
+		// the base coins can be less than the locked amount when the delegation was slashed
+		if clawbackAmt := sdk.MinInt(unvestedAmt, bc.AmountOf(vestingCoin.Denom)); clawbackAmt.IsPositive() {
+			clawback = clawback.Add(sdk.NewCoin(vestingCoin.Denom, clawbackAmt))
+		}
+	}
+
+	lgva.OriginalVesting = lgva.OriginalVesting.Sub(removed)
+	return clawback
+}
+
+// resetVestingSchedule replaces the schedule of the denom for the original vesting less the
+// removed amount, keeping the coins vested by blockTime vested and the remaining vesting
+// coins vesting as before.
+func (lgva *LazyGradedVestingAccount) resetVestingSchedule(denom string, blockTime int64, vestingAmt, removedAmt sdk.Int) {
+	originalAmt := lgva.OriginalVesting.AmountOf(denom)
+	vestedAmt := originalAmt.Sub(vestingAmt)
+	remainingAmt := vestingAmt.Sub(removedAmt)
+	totalAmt := originalAmt.Sub(removedAmt)
+	if totalAmt.IsZero() {
+		lgva.removeVestingSchedule(denom)
+		return
+	}
+
+	var lazySchedules LazySchedules
+	if vestedAmt.IsPositive() {
+		lazySchedules = append(lazySchedules, NewLazySchedule(0, 0, vestedAmt.ToDec().QuoInt(totalAmt)))
+	}
+
+	if remainingAmt.IsPositive() {
+		remainingRatio := remainingAmt.ToDec().QuoInt(totalAmt)
+		vestingSchedule, _ := lgva.GetVestingSchedule(denom)
+		remainingSchedules := vestingSchedule.LazySchedules.remaining(blockTime)
+		if sumRatio := remainingSchedules.sumRatio(); sumRatio.IsPositive() {
+			lazySchedules = append(lazySchedules, remainingSchedules.scale(remainingRatio.Quo(sumRatio))...)
+		} else {
+			lazySchedules = append(lazySchedules, NewLazySchedule(blockTime, blockTime, remainingRatio))
+		}
+	}
+
+	lgva.setVestingSchedule(NewVestingSchedule(denom, lazySchedules))
+}
+
+// setVestingSchedule replaces the schedule of the same denom or appends the schedule
+func (lgva *LazyGradedVestingAccount) setVestingSchedule(vestingSchedule VestingSchedule) {
+	for i, vs := range lgva.VestingSchedules {
+		if vs.Denom == vestingSchedule.Denom {
+			lgva.VestingSchedules[i] = vestingSchedule
+			return
+		}
+	}
+
+	lgva.VestingSchedules = append(lgva.VestingSchedules, vestingSchedule)
+}
+
+// removeVestingSchedule removes the schedule of the denom
+func (lgva *LazyGradedVestingAccount) removeVestingSchedule(denom string) {
+	for i, vs := range lgva.VestingSchedules {
+		if vs.Denom == denom {
+			lgva.VestingSchedules = append(lgva.VestingSchedules[:i], lgva.VestingSchedules[i+1:]...)
+			return
+		}
+	}
+}
+
 // GetStartTime returns zero since a lazy graded vesting account has no start time.
 func (lgva LazyGradedVestingAccount) GetStartTime() int64 {
 	return 0
@@ -183,6 +304,7 @@ func (lgva LazyGradedVestingAccount) MarshalYAML() (interface{}, error) {
 		DelegatedVesting: lgva.DelegatedVesting,
 		EndTime:          lgva.EndTime,
 		VestingSchedules: lgva.VestingSchedules,
+		FunderAddress:    lgva.FunderAddress,
 	}
 
 	if lgva.PubKey != nil {
@@ -215,6 +337,7 @@ func (lgva LazyGradedVestingAccount) MarshalJSON() ([]byte, error) {
 		DelegatedVesting: lgva.DelegatedVesting,
 		EndTime:          lgva.EndTime,
 		VestingSchedules: lgva.VestingSchedules,
+		FunderAddress:    lgva.FunderAddress,
 	}
 
 	return codec.Cdc.MarshalJSON(alias)
@@ -236,6 +359,7 @@ func (lgva *LazyGradedVestingAccount) UnmarshalJSON(bz []byte) error {
 	}
 
 	lgva.VestingSchedules = alias.VestingSchedules
+	lgva.FunderAddress = alias.FunderAddress
 
 	return nil
 }
@@ -432,3 +432,218 @@ func TestLazyGradedVestingAccountJSON(t *testing.T) {
 	require.NoError(t, codec.Cdc.UnmarshalJSON(bz, &a))
 	require.Equal(t, acc.String(), a.String())
 }
+
+func TestAddVestingSchedulesLazyVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	_, _, addr := KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(origCoins)
+	lgva := NewLazyGradedVestingAccount(&bacc, VestingSchedules{
+		NewVestingSchedule(feeDenom, []LazySchedule{
+			NewLazySchedule(now.Unix(), endTime.Unix(), sdk.NewDec(1)),
+		}),
+	})
+
+	// add the same amount of fee vesting for the next 24 hours after 12 hours,
+	// and stake which has been vested without a schedule
+	addedCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	lgva.AddVestingSchedules(addedCoins, VestingSchedules{
+		NewVestingSchedule(feeDenom, []LazySchedule{
+			NewLazySchedule(now.Add(12*time.Hour).Unix(), endTime.Add(12*time.Hour).Unix(), sdk.NewDec(1)),
+		}),
+		NewVestingSchedule(stakeDenom, []LazySchedule{
+			NewLazySchedule(now.Unix(), endTime.Unix(), sdk.NewDec(1)),
+		}),
+	})
+	lgva.SetCoins(origCoins.Add(addedCoins...))
+	require.NoError(t, lgva.Validate())
+	require.Equal(t, origCoins.Add(addedCoins...), lgva.GetOriginalVesting())
+
+	// require the coins vested before the addition to stay vested
+	vestedCoins := lgva.GetVestedCoins(now)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, vestedCoins)
+
+	vestedCoins = lgva.GetVestedCoins(now.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 150)}, vestedCoins)
+
+	vestedCoins = lgva.GetVestedCoins(endTime)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1500), sdk.NewInt64Coin(stakeDenom, 200)}, vestedCoins)
+
+	vestedCoins = lgva.GetVestedCoins(endTime.Add(12 * time.Hour))
+	require.Equal(t, origCoins.Add(addedCoins...), vestedCoins)
+}
+
+func TestClawbackLazyVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	_, _, addr := KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(origCoins)
+	lgva := NewLazyGradedVestingAccount(&bacc, VestingSchedules{
+		NewVestingSchedule(feeDenom, []LazySchedule{
+			NewLazySchedule(now.Unix(), endTime.Unix(), sdk.NewDec(1)),
+		}),
+		NewVestingSchedule(stakeDenom, []LazySchedule{
+			NewLazySchedule(now.Unix(), endTime.Unix(), sdk.NewDec(1)),
+		}),
+	})
+
+	// require the unvested coins to be clawed back after 12 hours
+	clawback := lgva.Clawback(now.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, clawback)
+	lgva.SetCoins(origCoins.Sub(clawback))
+	require.NoError(t, lgva.Validate())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 500), sdk.NewInt64Coin(stakeDenom, 50)}, lgva.GetOriginalVesting())
+
+	// require the vested coins to be spendable and nothing left to vest
+	require.Nil(t, lgva.GetVestingCoins(now.Add(12*time.Hour)))
+	require.Equal(t, lgva.GetCoins(), lgva.SpendableCoins(now.Add(12*time.Hour)))
+
+	// require nothing to be clawed back again
+	require.Nil(t, lgva.Clawback(endTime))
+
+	// require all coins to be clawed back at the beginning
+	bacc = authtypes.NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(origCoins)
+	lgva = NewLazyGradedVestingAccount(&bacc, VestingSchedules{
+		NewVestingSchedule(feeDenom, []LazySchedule{
+			NewLazySchedule(now.Unix(), endTime.Unix(), sdk.NewDec(1)),
+		}),
+	})
+
+	clawback = lgva.Clawback(now)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000)}, clawback)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 100)}, lgva.GetOriginalVesting())
+	require.Empty(t, lgva.GetVestingSchedules())
+}
+
+func TestClawbackDelegatedLazyVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	_, _, addr := KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 1000)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(origCoins)
+	lgva := NewLazyGradedVestingAccount(&bacc, VestingSchedules{
+		NewVestingSchedule(stakeDenom, []LazySchedule{
+			NewLazySchedule(now.Unix(), endTime.Unix(), sdk.NewDec(1)),
+		}),
+	})
+
+	// delegate all the coins, nothing is held by the account to claw back
+	lgva.TrackDelegation(now, origCoins)
+	lgva.SetCoins(nil)
+	require.Nil(t, lgva.Clawback(now.Add(12*time.Hour)))
+
+	// delegate 300 vesting coins, the other 200 unvested coins are clawed back after 12 hours
+	bacc = authtypes.NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(origCoins)
+	lgva = NewLazyGradedVestingAccount(&bacc, VestingSchedules{
+		NewVestingSchedule(stakeDenom, []LazySchedule{
+			NewLazySchedule(now.Unix(), endTime.Unix(), sdk.NewDec(1)),
+		}),
+	})
+
+	delegated := sdk.Coins{sdk.NewInt64Coin(stakeDenom, 300)}
+	lgva.TrackDelegation(now, delegated)
+	lgva.SetCoins(origCoins.Sub(delegated))
+
+	clawback := lgva.Clawback(now.Add(12 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 200)}, clawback)
+	lgva.SetCoins(lgva.GetCoins().Sub(clawback))
+	require.NoError(t, lgva.Validate())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 800)}, lgva.GetOriginalVesting())
+
+	// require the delegated vesting coins to keep vesting
+	require.Equal(t, delegated, lgva.GetVestingCoins(now.Add(12*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 500)}, lgva.SpendableCoins(now.Add(12*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 650)}, lgva.GetVestedCoins(now.Add(18*time.Hour)))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 800)}, lgva.GetVestedCoins(endTime))
+
+	// require the undelegated coins to be clawed back
+	lgva.TrackUndelegation(delegated)
+	lgva.SetCoins(lgva.GetCoins().Add(delegated...))
+
+	clawback = lgva.Clawback(now.Add(18 * time.Hour))
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 150)}, clawback)
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 650)}, lgva.GetOriginalVesting())
+	require.Equal(t, sdk.Coins{sdk.NewInt64Coin(stakeDenom, 650)}, lgva.GetVestedCoins(now.Add(18*time.Hour)))
+}
+
+func TestLazyGradedVestingAccountFunderJSON(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	_, _, addr := KeyTestPubAddr()
+	_, _, funder := KeyTestPubAddr()
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000)})
+	acc := NewLazyGradedVestingAccount(&bacc, VestingSchedules{
+		NewVestingSchedule(feeDenom, []LazySchedule{
+			NewLazySchedule(now.Unix(), endTime.Unix(), sdk.NewDec(1)),
+		}),
+	})
+	acc.FunderAddress = funder
+
+	bz, err := codec.Cdc.MarshalJSON(acc)
+	require.NoError(t, err)
+
+	var a LazyGradedVestingAccount
+	require.NoError(t, codec.Cdc.UnmarshalJSON(bz, &a))
+	require.Equal(t, funder, a.FunderAddress)
+	require.Equal(t, acc.String(), a.String())
+}
+
+func TestGetVestingTimelineLazyVestingAcc(t *testing.T) {
+	now := tmtime.Now()
+	endTime := now.Add(24 * time.Hour)
+
+	_, _, addr := KeyTestPubAddr()
+	origCoins := sdk.Coins{sdk.NewInt64Coin(feeDenom, 1000), sdk.NewInt64Coin(stakeDenom, 100)}
+	bacc := authtypes.NewBaseAccountWithAddress(addr)
+	bacc.SetCoins(origCoins)
+	lgva := NewLazyGradedVestingAccount(&bacc, VestingSchedules{
+		NewVestingSchedule(feeDenom, []LazySchedule{
+			NewLazySchedule(now.Unix(), endTime.Unix(), sdk.NewDecWithPrec(5, 1)),
+			NewLazySchedule(endTime.Unix(), endTime.Unix(), sdk.NewDecWithPrec(5, 1)),
+		}),
+	})
+	lgva.TrackDelegation(now, sdk.Coins{sdk.NewInt64Coin(feeDenom, 300)})
+
+	timeline := lgva.GetVestingTimeline(now.Add(12 * time.Hour))
+	require.Equal(t, addr, timeline.Address)
+	require.Equal(t, now.Add(12*time.Hour).Unix(), timeline.Time)
+	require.Len(t, timeline.Denoms, 2)
+
+	// fee vests 250 linearly until the end time and 500 at the end time
+	feeTimeline := timeline.Denoms[0]
+	require.Equal(t, feeDenom, feeTimeline.Denom)
+	require.Equal(t, sdk.NewInt(1000), feeTimeline.OriginalVesting)
+	require.Equal(t, sdk.NewInt(250), feeTimeline.Vested)
+	require.Equal(t, sdk.NewInt(750), feeTimeline.Vesting)
+	require.Equal(t, sdk.NewInt(300), feeTimeline.DelegatedVesting)
+	require.Equal(t, lgva.VestingSchedules[0].LazySchedules, feeTimeline.LazySchedules)
+	require.Equal(t, []UnlockEvent{
+		{Time: endTime.Unix(), Unlocked: sdk.NewInt(750), Cumulative: sdk.NewInt(1000)},
+	}, feeTimeline.UnlockEvents)
+
+	// stake without a schedule is vested
+	stakeTimeline := timeline.Denoms[1]
+	require.Equal(t, stakeDenom, stakeTimeline.Denom)
+	require.Equal(t, sdk.NewInt(100), stakeTimeline.Vested)
+	require.True(t, stakeTimeline.Vesting.IsZero())
+	require.Empty(t, stakeTimeline.UnlockEvents)
+
+	// every boundary after the block time is an unlock event
+	timeline = lgva.GetVestingTimeline(now.Add(-time.Hour))
+	require.Equal(t, []UnlockEvent{
+		{Time: now.Unix(), Unlocked: sdk.ZeroInt(), Cumulative: sdk.ZeroInt()},
+		{Time: endTime.Unix(), Unlocked: sdk.NewInt(1000), Cumulative: sdk.NewInt(1000)},
+	}, timeline.Denoms[0].UnlockEvents)
+}
@@ -0,0 +1,235 @@
+package wasm
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	wasmTypes "github.com/CosmWasm/go-cosmwasm/types"
+
+	wasm "github.com/terra-project/core/x/wasm/exported"
+)
+
+var _ wasm.WasmQuerierInterface = WasmQuerier{}
+var _ wasm.WasmMsgParserInterface = WasmMsgParser{}
+
+// WasmMsgParser - wasm msg parser for distribution msgs
+type WasmMsgParser struct{}
+
+// NewWasmMsgParser returns distribution wasm msg parser
+func NewWasmMsgParser() WasmMsgParser {
+	return WasmMsgParser{}
+}
+
+// Parse implements wasm distribution msg parser
+func (WasmMsgParser) Parse(_ sdk.AccAddress, _ wasmTypes.CosmosMsg) ([]sdk.Msg, error) {
+	return nil, nil
+}
+
+// CosmosMsg contains distribution msgs of the contract as a delegator
+type CosmosMsg struct {
+	WithdrawDelegatorReward *WithdrawDelegatorRewardMsg `json:"withdraw_delegator_reward,omitempty"`
+	SetWithdrawAddress      *SetWithdrawAddressMsg      `json:"set_withdraw_address,omitempty"`
+}
+
+// WithdrawDelegatorRewardMsg withdraws the rewards of the delegation to the validator
+type WithdrawDelegatorRewardMsg struct {
+	Validator string `json:"validator"`
+}
+
+// SetWithdrawAddressMsg sets the address the rewards are withdrawn to
+type SetWithdrawAddressMsg struct {
+	Address string `json:"address"`
+}
+
+// ParseCustom implements custom parser
+func (WasmMsgParser) ParseCustom(contractAddr sdk.AccAddress, data json.RawMessage) ([]sdk.Msg, error) {
+	var msg CosmosMsg
+	err := json.Unmarshal(data, &msg)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to parse distribution custom msg")
+	}
+
+	var sdkMsg sdk.Msg
+	if msg.WithdrawDelegatorReward != nil {
+		validator, err := sdk.ValAddressFromBech32(msg.WithdrawDelegatorReward.Validator)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.WithdrawDelegatorReward.Validator)
+		}
+
+		sdkMsg = distribution.NewMsgWithdrawDelegatorReward(contractAddr, validator)
+	} else if msg.SetWithdrawAddress != nil {
+		withdrawAddr, err := sdk.AccAddressFromBech32(msg.SetWithdrawAddress.Address)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, msg.SetWithdrawAddress.Address)
+		}
+
+		sdkMsg = distribution.NewMsgSetWithdrawAddress(contractAddr, withdrawAddr)
+	} else {
+		return nil, sdkerrors.Wrap(wasm.ErrInvalidMsg, "Unknown variant of Distribution")
+	}
+
+	return []sdk.Msg{sdkMsg}, sdkMsg.ValidateBasic()
+}
+
+// WasmQuerier - distribution query interface for wasm contract
+type WasmQuerier struct {
+	keeper  distribution.Keeper
+	querier sdk.Querier
+}
+
+// NewWasmQuerier returns distribution wasm querier
+func NewWasmQuerier(keeper distribution.Keeper) WasmQuerier {
+	return WasmQuerier{keeper, distribution.NewQuerier(keeper)}
+}
+
+// Query - implement query function
+func (WasmQuerier) Query(_ sdk.Context, _ wasmTypes.QueryRequest) ([]byte, error) { return nil, nil }
+
+// CosmosQuery contains various distribution queries
+type CosmosQuery struct {
+	Rewards         *RewardsQuery   `json:"rewards,omitempty"`
+	TotalRewards    *DelegatorQuery `json:"total_rewards,omitempty"`
+	WithdrawAddress *DelegatorQuery `json:"withdraw_address,omitempty"`
+	CommunityPool   *struct{}       `json:"community_pool,omitempty"`
+}
+
+// RewardsQuery queries the rewards of the delegation to the validator
+type RewardsQuery struct {
+	Delegator string `json:"delegator"`
+	Validator string `json:"validator"`
+}
+
+// DelegatorQuery queries the delegator
+type DelegatorQuery struct {
+	Delegator string `json:"delegator"`
+}
+
+// RewardsQueryResponse - rewards query response for wasm module
+type RewardsQueryResponse struct {
+	// the decimal rewards are truncated as they are withdrawn
+	Rewards wasmTypes.Coins `json:"rewards"`
+}
+
+// ValidatorRewards - rewards of the delegation to a validator
+type ValidatorRewards struct {
+	Validator string          `json:"validator"`
+	Rewards   wasmTypes.Coins `json:"rewards"`
+}
+
+// TotalRewardsQueryResponse - total rewards query response for wasm module
+type TotalRewardsQueryResponse struct {
+	Rewards []ValidatorRewards `json:"rewards"`
+	Total   wasmTypes.Coins    `json:"total"`
+}
+
+// WithdrawAddressQueryResponse - withdraw address query response for wasm module
+type WithdrawAddressQueryResponse struct {
+	WithdrawAddress string `json:"withdraw_address"`
+}
+
+// CommunityPoolQueryResponse - community pool query response for wasm module
+type CommunityPoolQueryResponse struct {
+	Pool wasmTypes.Coins `json:"pool"`
+}
+
+// QueryCustom implements custom query interface
+func (querier WasmQuerier) QueryCustom(ctx sdk.Context, data json.RawMessage) ([]byte, error) {
+	var query CosmosQuery
+	err := json.Unmarshal(data, &query)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	var res interface{}
+	switch {
+	case query.Rewards != nil:
+		delegator, err := sdk.AccAddressFromBech32(query.Rewards.Delegator)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, query.Rewards.Delegator)
+		}
+
+		validator, err := sdk.ValAddressFromBech32(query.Rewards.Validator)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, query.Rewards.Validator)
+		}
+
+		var rewards sdk.DecCoins
+		if err := querier.queryDistribution(ctx, distribution.QueryDelegationRewards,
+			distribution.NewQueryDelegationRewardsParams(delegator, validator), &rewards); err != nil {
+			return nil, err
+		}
+
+		res = RewardsQueryResponse{Rewards: encodeDecCoins(rewards)}
+	case query.TotalRewards != nil:
+		delegator, err := sdk.AccAddressFromBech32(query.TotalRewards.Delegator)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, query.TotalRewards.Delegator)
+		}
+
+		var totalRewards distribution.QueryDelegatorTotalRewardsResponse
+		if err := querier.queryDistribution(ctx, distribution.QueryDelegatorTotalRewards,
+			distribution.NewQueryDelegatorParams(delegator), &totalRewards); err != nil {
+			return nil, err
+		}
+
+		rewards := make([]ValidatorRewards, len(totalRewards.Rewards))
+		for i, reward := range totalRewards.Rewards {
+			rewards[i] = ValidatorRewards{
+				Validator: reward.ValidatorAddress.String(),
+				Rewards:   encodeDecCoins(reward.Reward),
+			}
+		}
+
+		res = TotalRewardsQueryResponse{Rewards: rewards, Total: encodeDecCoins(totalRewards.Total)}
+	case query.WithdrawAddress != nil:
+		delegator, err := sdk.AccAddressFromBech32(query.WithdrawAddress.Delegator)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, query.WithdrawAddress.Delegator)
+		}
+
+		withdrawAddr := querier.keeper.GetDelegatorWithdrawAddr(ctx, delegator)
+		res = WithdrawAddressQueryResponse{WithdrawAddress: withdrawAddr.String()}
+	case query.CommunityPool != nil:
+		res = CommunityPoolQueryResponse{Pool: encodeDecCoins(querier.keeper.GetFeePoolCommunityCoins(ctx))}
+	default:
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// queryDistribution runs the rewards queries through the distribution querier,
+// which computes the rewards in a cache-wrapped context
+func (querier WasmQuerier) queryDistribution(ctx sdk.Context, path string, params interface{}, res interface{}) error {
+	bz, err := json.Marshal(params)
+	if err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	resBz, err := querier.querier(ctx, []string{path}, abci.RequestQuery{Data: bz})
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(resBz, res); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	return nil
+}
+
+// encodeDecCoins truncates the decimal coins and encodes them to wasm coins
+func encodeDecCoins(coins sdk.DecCoins) wasmTypes.Coins {
+	truncated, _ := coins.TruncateDecimal()
+	return wasm.EncodeSdkCoins(truncated)
+}
@@ -0,0 +1,98 @@
+package wasm
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/distribution"
+	"github.com/cosmos/cosmos-sdk/x/mock"
+)
+
+func TestEncoding(t *testing.T) {
+	_, addrs := mock.GeneratePrivKeyAddressPairs(2)
+
+	valAddr := make(sdk.ValAddress, sdk.AddrLen)
+	valAddr[0] = 12
+
+	cases := map[string]struct {
+		sender sdk.AccAddress
+		input  CosmosMsg
+		// set if valid
+		output []sdk.Msg
+		// set if invalid
+		isError bool
+	}{
+		"withdraw delegator reward": {
+			sender: addrs[0],
+			input: CosmosMsg{
+				WithdrawDelegatorReward: &WithdrawDelegatorRewardMsg{
+					Validator: valAddr.String(),
+				},
+			},
+			output: []sdk.Msg{
+				distribution.MsgWithdrawDelegatorReward{
+					DelegatorAddress: addrs[0],
+					ValidatorAddress: valAddr,
+				},
+			},
+		},
+		"withdraw delegator reward from non-validator": {
+			sender: addrs[0],
+			input: CosmosMsg{
+				WithdrawDelegatorReward: &WithdrawDelegatorRewardMsg{
+					Validator: addrs[1].String(),
+				},
+			},
+			isError: true,
+		},
+		"set withdraw address": {
+			sender: addrs[0],
+			input: CosmosMsg{
+				SetWithdrawAddress: &SetWithdrawAddressMsg{
+					Address: addrs[1].String(),
+				},
+			},
+			output: []sdk.Msg{
+				distribution.MsgSetWithdrawAddress{
+					DelegatorAddress: addrs[0],
+					WithdrawAddress:  addrs[1],
+				},
+			},
+		},
+		"set invalid withdraw address": {
+			sender: addrs[0],
+			input: CosmosMsg{
+				SetWithdrawAddress: &SetWithdrawAddressMsg{
+					Address: "invalid",
+				},
+			},
+			isError: true,
+		},
+		"empty msg": {
+			sender:  addrs[0],
+			input:   CosmosMsg{},
+			isError: true,
+		},
+	}
+
+	parser := NewWasmMsgParser()
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			bz, err := json.Marshal(tc.input)
+			require.NoError(t, err)
+
+			res, err := parser.ParseCustom(tc.sender, bz)
+			if tc.isError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.output, res)
+			}
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package feegrant
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// EndBlocker is called at the end of every block
+func EndBlocker(ctx sdk.Context, k Keeper) {
+
+	// clears all the mature fee allowances
+	matureFeeAllowances := k.DequeueAllMatureFeeAllowanceQueue(ctx)
+	for _, pair := range matureFeeAllowances {
+		k.RevokeFeeAllowance(ctx, pair.GranterAddress, pair.GranteeAddress)
+	}
+}
@@ -0,0 +1,26 @@
+package feegrant
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/terra-project/core/x/feegrant/internal/types"
+)
+
+func (s *TestSuite) TestMature() {
+	coins := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1_000_000_000)))
+
+	allowance := types.NewBasicFeeAllowance(coins)
+	msg := types.NewMsgGrantFeeAllowance(granterAddr, granteeAddr, allowance, time.Hour)
+
+	_, err := s.handler(s.ctx, msg)
+	s.Require().NoError(err)
+
+	EndBlocker(s.ctx, s.keeper)
+	_, found := s.keeper.GetFeeAllowance(s.ctx, granterAddr, granteeAddr)
+	s.Require().True(found)
+
+	EndBlocker(s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Hour)), s.keeper)
+	_, found = s.keeper.GetFeeAllowance(s.ctx, granterAddr, granteeAddr)
+	s.Require().False(found)
+}
@@ -0,0 +1,71 @@
+// nolint
+// autogenerated code using github.com/rigelrozanski/multitool
+// aliases generated for the following subdirectories:
+// ALIASGEN: github.com/terra-project/core/x/feegrant/internal/keeper
+// ALIASGEN: github.com/terra-project/core/x/feegrant/internal/types
+package feegrant
+
+import (
+	"github.com/terra-project/core/x/feegrant/internal/keeper"
+	"github.com/terra-project/core/x/feegrant/internal/types"
+)
+
+const (
+	EventGrantFeeAllowance     = types.EventGrantFeeAllowance
+	EventRevokeFeeAllowance    = types.EventRevokeFeeAllowance
+	EventUseFeeAllowance       = types.EventUseFeeAllowance
+	AttributeKeyGranteeAddress = types.AttributeKeyGranteeAddress
+	AttributeKeyGranterAddress = types.AttributeKeyGranterAddress
+	AttributeValueCategory     = types.AttributeValueCategory
+	ModuleName                 = types.ModuleName
+	StoreKey                   = types.StoreKey
+	RouterKey                  = types.RouterKey
+	QuerierRoute               = types.QuerierRoute
+	QueryFeeAllowance          = types.QueryFeeAllowance
+	QueryFeeAllowances         = types.QueryFeeAllowances
+)
+
+var (
+	// functions aliases
+	NewKeeper                           = keeper.NewKeeper
+	NewQuerier                          = keeper.NewQuerier
+	SetupTestInput                      = keeper.SetupTestInput
+	NewFeeAllowanceGrant                = types.NewFeeAllowanceGrant
+	NewBasicFeeAllowance                = types.NewBasicFeeAllowance
+	NewPeriodicFeeAllowance             = types.NewPeriodicFeeAllowance
+	RegisterCodec                       = types.RegisterCodec
+	NewGenesisState                     = types.NewGenesisState
+	DefaultGenesisState                 = types.DefaultGenesisState
+	ValidateGenesis                     = types.ValidateGenesis
+	GetFeeAllowanceKey                  = types.GetFeeAllowanceKey
+	GetFeeAllowancesByGranteeKey        = types.GetFeeAllowancesByGranteeKey
+	GetFeeAllowanceTimeKey              = types.GetFeeAllowanceTimeKey
+	ExtractAddressesFromFeeAllowanceKey = types.ExtractAddressesFromFeeAllowanceKey
+	NewMsgGrantFeeAllowance             = types.NewMsgGrantFeeAllowance
+	NewMsgRevokeFeeAllowance            = types.NewMsgRevokeFeeAllowance
+	NewQueryFeeAllowanceParams          = types.NewQueryFeeAllowanceParams
+	NewQueryFeeAllowancesParams         = types.NewQueryFeeAllowancesParams
+
+	// variable aliases
+	ModuleCdc            = types.ModuleCdc
+	ErrInvalidPeriod     = types.ErrInvalidPeriod
+	ErrInvalidAllowance  = types.ErrInvalidAllowance
+	ErrNoFeeAllowance    = types.ErrNoFeeAllowance
+	FeeAllowanceKey      = types.FeeAllowanceKey
+	FeeAllowanceQueueKey = types.FeeAllowanceQueueKey
+)
+
+type (
+	Keeper                   = keeper.Keeper
+	FeeAllowance             = types.FeeAllowance
+	FeeAllowanceGrant        = types.FeeAllowanceGrant
+	GGPair                   = types.GGPair
+	BasicFeeAllowance        = types.BasicFeeAllowance
+	PeriodicFeeAllowance     = types.PeriodicFeeAllowance
+	FeeAllowanceEntry        = types.FeeAllowanceEntry
+	GenesisState             = types.GenesisState
+	MsgGrantFeeAllowance     = types.MsgGrantFeeAllowance
+	MsgRevokeFeeAllowance    = types.MsgRevokeFeeAllowance
+	QueryFeeAllowanceParams  = types.QueryFeeAllowanceParams
+	QueryFeeAllowancesParams = types.QueryFeeAllowancesParams
+)
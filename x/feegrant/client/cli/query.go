@@ -0,0 +1,121 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/terra-project/core/x/feegrant/internal/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	feeGrantQueryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the fee grant module",
+		Long:                       "",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feeGrantQueryCmd.AddCommand(flags.GetCommands(
+		GetCmdQueryFeeAllowance(queryRoute, cdc),
+		GetCmdQueryFeeAllowances(queryRoute, cdc),
+	)...)
+
+	return feeGrantQueryCmd
+}
+
+// GetCmdQueryFeeAllowance implements the query fee allowance command.
+func GetCmdQueryFeeAllowance(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "allowance [granter-addr] [grantee-addr]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Query fee allowance between a granter-grantee pair",
+		Long: strings.TrimSpace(`
+Query fee allowance between a granter-grantee pair,
+
+$ terracli query feegrant allowance terra... terra...
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granterAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			granteeAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryFeeAllowanceParams(granterAddr, granteeAddr)
+			bz, err := cliCtx.Codec.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFeeAllowance), bz)
+			if err != nil {
+				return err
+			}
+
+			var grant types.FeeAllowanceGrant
+			err = cdc.UnmarshalJSON(res, &grant)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(grant)
+		},
+	}
+}
+
+// GetCmdQueryFeeAllowances implements the query fee allowances command.
+func GetCmdQueryFeeAllowances(storeName string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "allowances [grantee-addr]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query all fee allowances given to a grantee",
+		Long: strings.TrimSpace(`
+Query all fee allowances given to a grantee,
+
+$ terracli query feegrant allowances terra...
+		`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			granteeAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryFeeAllowancesParams(granteeAddr)
+			bz, err := cliCtx.Codec.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryFeeAllowances), bz)
+			if err != nil {
+				return err
+			}
+
+			var entries []types.FeeAllowanceEntry
+			err = cdc.UnmarshalJSON(res, &entries)
+			if err != nil {
+				return err
+			}
+
+			return cliCtx.PrintOutput(entries)
+		},
+	}
+}
@@ -0,0 +1,141 @@
+package cli
+
+import (
+	"bufio"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/terra-project/core/x/feegrant/internal/types"
+)
+
+// Flags for fee grant commands
+const (
+	FlagPeriod           = "period"
+	FlagSpendPeriod      = "spend-period"
+	FlagPeriodSpendLimit = "period-spend-limit"
+)
+
+// GetTxCmd returns the transaction commands for this module
+func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
+	feeGrantTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Fee grant transactions subcommands",
+		Long:                       "Grant and revoke fee allowances to let other addresses pay fees from your account",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feeGrantTxCmd.AddCommand(flags.PostCommands(
+		GetCmdGrantFeeAllowance(cdc),
+		GetCmdRevokeFeeAllowance(cdc),
+	)...)
+
+	return feeGrantTxCmd
+}
+
+// GetCmdGrantFeeAllowance will create a grant fee allowance tx and sign it with the given key.
+func GetCmdGrantFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee-address] [spend-limit]",
+		Short: "Grant fee allowance to an address",
+		Long: strings.TrimSpace(`
+Grant fee allowance to an address to let the address pay 
+transaction fees and stability taxes from your account,
+
+$ terracli tx feegrant grant terra... 1000000uluna,10000000ukrw --from [granter]
+
+Or, you can give a periodic allowance which refills the period spend limit every spend period,
+
+$ terracli tx feegrant grant terra... 1000000uluna --spend-period 86400 --period-spend-limit 10000uluna --from [granter]
+
+An empty spend limit ("") means no total spend limit.
+				`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			granter := cliCtx.FromAddress
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			limit, err := sdk.ParseCoins(args[1])
+			if err != nil {
+				return err
+			}
+
+			var allowance types.FeeAllowance = types.NewBasicFeeAllowance(limit)
+			if spendPeriod := viper.GetInt64(FlagSpendPeriod); spendPeriod > 0 {
+				periodLimit, err := sdk.ParseCoins(viper.GetString(FlagPeriodSpendLimit))
+				if err != nil {
+					return err
+				}
+
+				allowance = types.NewPeriodicFeeAllowance(limit, time.Duration(spendPeriod)*time.Second, periodLimit)
+			}
+
+			period := time.Duration(viper.GetInt64(FlagPeriod)) * time.Second
+
+			msg := types.NewMsgGrantFeeAllowance(granter, grantee, allowance, period)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+
+	cmd.Flags().Int64(FlagPeriod, int64(3600*24*365), "The second unit of time duration which the fee allowance is active for the user; Default is a year")
+	cmd.Flags().Int64(FlagSpendPeriod, 0, "The second unit of time duration which the period spend limit is refilled; Zero means basic allowance")
+	cmd.Flags().String(FlagPeriodSpendLimit, "", "The maximum amount of coins can be spent in a spend period")
+
+	return cmd
+}
+
+// GetCmdRevokeFeeAllowance will create a revoke fee allowance tx and sign it with the given key.
+func GetCmdRevokeFeeAllowance(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [grantee-address]",
+		Short: "Revoke fee allowance",
+		Long: strings.TrimSpace(`
+Revoke fee allowance from an address,
+
+$ terracli tx feegrant revoke terra... --from [granter]
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(authclient.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContextWithInput(inBuf).WithCodec(cdc)
+
+			granter := cliCtx.FromAddress
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRevokeFeeAllowance(granter, grantee)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return authclient.CompleteAndBroadcastTxCLI(txBldr, cliCtx, []sdk.Msg{msg})
+		},
+	}
+	return cmd
+}
@@ -0,0 +1,37 @@
+package rest
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+)
+
+//nolint
+const (
+	RestGranter = "granter"
+	RestGrantee = "grantee"
+)
+
+// RegisterRoutes register routes for querier and tx broadcast
+func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	registerQueryRoutes(cliCtx, r)
+	registerTxRoutes(cliCtx, r)
+}
+
+// GrantRequest defines the properties of a grant request's body.
+// When SpendPeriod is positive, a periodic fee allowance is granted.
+type GrantRequest struct {
+	BaseReq          rest.BaseReq  `json:"base_req" yaml:"base_req"`
+	Period           time.Duration `json:"period"`
+	SpendLimit       sdk.Coins     `json:"spend_limit,omitempty"`
+	SpendPeriod      time.Duration `json:"spend_period,omitempty"`
+	PeriodSpendLimit sdk.Coins     `json:"period_spend_limit,omitempty"`
+}
+
+// RevokeRequest defines the properties of a revoke request's body.
+type RevokeRequest struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+}
@@ -0,0 +1,111 @@
+package rest
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/mux"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/terra-project/core/x/feegrant/internal/types"
+)
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(fmt.Sprintf("/feegrant/granters/{%s}/grantees/{%s}/allowance", RestGranter, RestGrantee), grantHandler(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/feegrant/granters/{%s}/grantees/{%s}/allowance/revoke", RestGranter, RestGrantee), revokeHandler(cliCtx)).Methods("POST")
+}
+
+func parseGranterGrantee(w http.ResponseWriter, r *http.Request, from string) (granterAddr, granteeAddr sdk.AccAddress, ok bool) {
+	vars := mux.Vars(r)
+
+	granterAddr, err := sdk.AccAddressFromBech32(vars[RestGranter])
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return nil, nil, false
+	}
+
+	granteeAddr, err = sdk.AccAddressFromBech32(vars[RestGrantee])
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return nil, nil, false
+	}
+
+	fromAddr, err := sdk.AccAddressFromBech32(from)
+	if err != nil {
+		rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		return nil, nil, false
+	}
+
+	if !bytes.Equal(fromAddr, granterAddr) {
+		rest.WriteErrorResponse(w, http.StatusUnauthorized, "must use own granter address")
+		return nil, nil, false
+	}
+
+	return granterAddr, granteeAddr, true
+}
+
+func grantHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req GrantRequest
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		granterAddr, granteeAddr, ok := parseGranterGrantee(w, r, req.BaseReq.From)
+		if !ok {
+			return
+		}
+
+		var allowance types.FeeAllowance = types.NewBasicFeeAllowance(req.SpendLimit)
+		if req.SpendPeriod > 0 {
+			allowance = types.NewPeriodicFeeAllowance(req.SpendLimit, req.SpendPeriod, req.PeriodSpendLimit)
+		}
+
+		msg := types.NewMsgGrantFeeAllowance(granterAddr, granteeAddr, allowance, req.Period)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func revokeHandler(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RevokeRequest
+
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		granterAddr, granteeAddr, ok := parseGranterGrantee(w, r, req.BaseReq.From)
+		if !ok {
+			return
+		}
+
+		msg := types.NewMsgRevokeFeeAllowance(granterAddr, granteeAddr)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		authclient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,11 @@
+// nolint
+package exported
+
+import (
+	"github.com/terra-project/core/x/feegrant/internal/types"
+)
+
+type (
+	MsgGrantFeeAllowance  = types.MsgGrantFeeAllowance
+	MsgRevokeFeeAllowance = types.MsgRevokeFeeAllowance
+)
@@ -0,0 +1,35 @@
+package feegrant
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// InitGenesis register all exported fee allowance entries
+func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	for _, entry := range data.FeeAllowanceEntries {
+		keeper.SetFeeAllowance(ctx, entry.Granter, entry.Grantee, FeeAllowanceGrant{
+			Allowance:  entry.Allowance,
+			Expiration: entry.Expiration,
+		})
+
+		keeper.InsertFeeAllowanceQueue(ctx, entry.Granter, entry.Grantee, entry.Expiration)
+	}
+}
+
+// ExportGenesis writes the current store values
+// to a genesis file, which can be imported again
+// with InitGenesis
+func ExportGenesis(ctx sdk.Context, keeper Keeper) (data GenesisState) {
+	var entries []FeeAllowanceEntry
+	keeper.IterateFeeAllowances(ctx, func(granter, grantee sdk.AccAddress, grant FeeAllowanceGrant) bool {
+		entries = append(entries, FeeAllowanceEntry{
+			Granter:    granter,
+			Grantee:    grantee,
+			Allowance:  grant.Allowance,
+			Expiration: grant.Expiration,
+		})
+		return false
+	})
+
+	return NewGenesisState(entries)
+}
@@ -0,0 +1,27 @@
+package feegrant
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/terra-project/core/x/feegrant/internal/types"
+)
+
+func (s *TestSuite) TestGenesisExportImport() {
+	coins := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1_000_000_000)))
+
+	now := s.ctx.BlockHeader().Time
+	grant := NewFeeAllowanceGrant(types.NewPeriodicFeeAllowance(coins, time.Minute, coins), now.Add(time.Hour))
+	s.keeper.SetFeeAllowance(s.ctx, granterAddr, granteeAddr, grant)
+	genesis := ExportGenesis(s.ctx, s.keeper)
+	s.Require().NoError(ValidateGenesis(genesis))
+
+	// Clear keeper
+	s.keeper.RevokeFeeAllowance(s.ctx, granterAddr, granteeAddr)
+
+	InitGenesis(s.ctx, s.keeper, genesis)
+	newGenesis := ExportGenesis(s.ctx, s.keeper)
+
+	s.Require().Equal(genesis, newGenesis)
+	s.Require().Len(s.keeper.GetFeeAllowanceQueueTimeSlice(s.ctx, grant.Expiration), 1)
+}
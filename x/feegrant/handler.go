@@ -0,0 +1,73 @@
+package feegrant
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/terra-project/core/x/feegrant/internal/types"
+)
+
+// NewHandler returns a handler for "feegrant" type messages.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		switch msg := msg.(type) {
+		case MsgGrantFeeAllowance:
+			return handleMsgGrantFeeAllowance(ctx, msg, k)
+		case MsgRevokeFeeAllowance:
+			return handleMsgRevokeFeeAllowance(ctx, msg, k)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized fee grant message type: %T", msg)
+		}
+	}
+}
+
+func handleMsgGrantFeeAllowance(ctx sdk.Context, msg MsgGrantFeeAllowance, k Keeper) (*sdk.Result, error) {
+	expiration := ctx.BlockTime().Add(msg.Period)
+
+	// remove the old expiration from the queue when the grant is overwritten
+	if grant, found := k.GetFeeAllowance(ctx, msg.Granter, msg.Grantee); found {
+		k.RevokeFromFeeAllowanceQueue(ctx, msg.Granter, msg.Grantee, grant.Expiration)
+	}
+
+	k.SetFeeAllowance(ctx, msg.Granter, msg.Grantee, NewFeeAllowanceGrant(msg.Allowance, expiration))
+	k.InsertFeeAllowanceQueue(ctx, msg.Granter, msg.Grantee, expiration)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventGrantFeeAllowance,
+			sdk.NewAttribute(types.AttributeKeyGranterAddress, msg.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGranteeAddress, msg.Grantee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+func handleMsgRevokeFeeAllowance(ctx sdk.Context, msg MsgRevokeFeeAllowance, k Keeper) (*sdk.Result, error) {
+	grant, found := k.GetFeeAllowance(ctx, msg.Granter, msg.Grantee)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNoFeeAllowance, "granter %s, grantee %s", msg.Granter, msg.Grantee)
+	}
+
+	k.RevokeFeeAllowance(ctx, msg.Granter, msg.Grantee)
+	k.RevokeFromFeeAllowanceQueue(ctx, msg.Granter, msg.Grantee, grant.Expiration)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventRevokeFeeAllowance,
+			sdk.NewAttribute(types.AttributeKeyGranterAddress, msg.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGranteeAddress, msg.Grantee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		),
+	})
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
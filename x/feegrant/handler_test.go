@@ -0,0 +1,91 @@
+package feegrant
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/terra-project/core/x/feegrant/internal/keeper"
+	"github.com/terra-project/core/x/feegrant/internal/types"
+)
+
+var (
+	granteePub  = ed25519.GenPrivKey().PubKey()
+	granterPub  = ed25519.GenPrivKey().PubKey()
+	granteeAddr = sdk.AccAddress(granteePub.Address())
+	granterAddr = sdk.AccAddress(granterPub.Address())
+)
+
+type TestSuite struct {
+	suite.Suite
+	ctx           sdk.Context
+	accountKeeper auth.AccountKeeper
+	bankKeeper    bank.Keeper
+	keeper        Keeper
+	handler       sdk.Handler
+}
+
+func (s *TestSuite) SetupTest() {
+	s.ctx, s.accountKeeper, s.bankKeeper, s.keeper = keeper.SetupTestInput()
+	s.handler = NewHandler(s.keeper)
+}
+
+func (s *TestSuite) TestGrant() {
+	coins := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1_000_000_000)))
+
+	// basic fee allowance
+	allowance := types.NewBasicFeeAllowance(coins)
+	msg := types.NewMsgGrantFeeAllowance(granterAddr, granteeAddr, allowance, time.Hour)
+
+	_, err := s.handler(s.ctx, msg)
+	s.Require().NoError(err)
+
+	grant, found := s.keeper.GetFeeAllowance(s.ctx, granterAddr, granteeAddr)
+	s.Require().True(found)
+	s.Require().Equal(allowance, grant.Allowance)
+	s.Require().Equal(s.ctx.BlockTime().Add(time.Hour), grant.Expiration)
+
+	// overwrite with periodic fee allowance
+	periodicAllowance := types.NewPeriodicFeeAllowance(coins, time.Minute, coins)
+	msg = types.NewMsgGrantFeeAllowance(granterAddr, granteeAddr, periodicAllowance, 2*time.Hour)
+
+	_, err = s.handler(s.ctx, msg)
+	s.Require().NoError(err)
+
+	grant, found = s.keeper.GetFeeAllowance(s.ctx, granterAddr, granteeAddr)
+	s.Require().True(found)
+	s.Require().Equal(periodicAllowance, grant.Allowance)
+
+	// old expiration is removed from the queue
+	s.Require().Empty(s.keeper.GetFeeAllowanceQueueTimeSlice(s.ctx, s.ctx.BlockTime().Add(time.Hour)))
+	s.Require().Len(s.keeper.GetFeeAllowanceQueueTimeSlice(s.ctx, s.ctx.BlockTime().Add(2*time.Hour)), 1)
+}
+
+func (s *TestSuite) TestRevoke() {
+	coins := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1_000_000_000)))
+
+	revokeMsg := types.NewMsgRevokeFeeAllowance(granterAddr, granteeAddr)
+	_, err := s.handler(s.ctx, revokeMsg)
+	s.Require().Error(err)
+
+	msg := types.NewMsgGrantFeeAllowance(granterAddr, granteeAddr, types.NewBasicFeeAllowance(coins), time.Hour)
+	_, err = s.handler(s.ctx, msg)
+	s.Require().NoError(err)
+
+	_, err = s.handler(s.ctx, revokeMsg)
+	s.Require().NoError(err)
+
+	_, found := s.keeper.GetFeeAllowance(s.ctx, granterAddr, granteeAddr)
+	s.Require().False(found)
+	s.Require().Empty(s.keeper.GetFeeAllowanceQueueTimeSlice(s.ctx, s.ctx.BlockTime().Add(time.Hour)))
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
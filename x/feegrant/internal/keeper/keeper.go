@@ -0,0 +1,223 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/feegrant/internal/types"
+)
+
+// Keeper of the feegrant store
+type Keeper struct {
+	cdc        *codec.Codec
+	storeKey   sdk.StoreKey
+	bankKeeper types.BankKeeper
+}
+
+// NewKeeper constructs a fee grant Keeper
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, bankKeeper types.BankKeeper) Keeper {
+	return Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		bankKeeper: bankKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetFeeAllowance returns fee allowance between granter and grantee
+func (k Keeper) GetFeeAllowance(ctx sdk.Context, granterAddr sdk.AccAddress, granteeAddr sdk.AccAddress) (grant types.FeeAllowanceGrant, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFeeAllowanceKey(granteeAddr, granterAddr))
+	if bz == nil {
+		return grant, false
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &grant)
+	return grant, true
+}
+
+// SetFeeAllowance stores the fee allowance given to the grantee by the granter. If there is
+// an existing fee allowance between them, this grant overwrites that.
+func (k Keeper) SetFeeAllowance(ctx sdk.Context, granterAddr sdk.AccAddress, granteeAddr sdk.AccAddress, grant types.FeeAllowanceGrant) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(grant)
+	store.Set(types.GetFeeAllowanceKey(granteeAddr, granterAddr), bz)
+}
+
+// RevokeFeeAllowance removes the fee allowance given to the grantee by the granter
+func (k Keeper) RevokeFeeAllowance(ctx sdk.Context, granterAddr sdk.AccAddress, granteeAddr sdk.AccAddress) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetFeeAllowanceKey(granteeAddr, granterAddr))
+}
+
+// IterateFeeAllowances iterates over all fee allowances
+func (k Keeper) IterateFeeAllowances(ctx sdk.Context,
+	handler func(granterAddr sdk.AccAddress, granteeAddr sdk.AccAddress, grant types.FeeAllowanceGrant) bool) {
+	k.iterateFeeAllowances(ctx, types.FeeAllowanceKey, handler)
+}
+
+// IterateGranteeFeeAllowances iterates over all fee allowances given to the grantee
+func (k Keeper) IterateGranteeFeeAllowances(ctx sdk.Context, granteeAddr sdk.AccAddress,
+	handler func(granterAddr sdk.AccAddress, granteeAddr sdk.AccAddress, grant types.FeeAllowanceGrant) bool) {
+	k.iterateFeeAllowances(ctx, types.GetFeeAllowancesByGranteeKey(granteeAddr), handler)
+}
+
+func (k Keeper) iterateFeeAllowances(ctx sdk.Context, prefix []byte,
+	handler func(granterAddr sdk.AccAddress, granteeAddr sdk.AccAddress, grant types.FeeAllowanceGrant) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var grant types.FeeAllowanceGrant
+		granterAddr, granteeAddr := types.ExtractAddressesFromFeeAllowanceKey(iter.Key())
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &grant)
+		if handler(granterAddr, granteeAddr, grant) {
+			break
+		}
+	}
+}
+
+// UseGrantedFees tries to pay the given fee with one of the fee allowances given to the grantee.
+// The allowances are tried in granter address order, and the first one that accepts the fee
+// transfers the fee from its granter to the grantee. Returns the address of the granter
+// who covered the fee, or false when no allowance can cover it.
+func (k Keeper) UseGrantedFees(ctx sdk.Context, granteeAddr sdk.AccAddress, fee sdk.Coins) (sdk.AccAddress, bool) {
+	var (
+		payer   sdk.AccAddress
+		grant   types.FeeAllowanceGrant
+		updated types.FeeAllowance
+		del     bool
+	)
+
+	k.IterateGranteeFeeAllowances(ctx, granteeAddr, func(granterAddr, _ sdk.AccAddress, g types.FeeAllowanceGrant) bool {
+		// mature grants are removed at the end block, so skip them here
+		if !ctx.BlockTime().Before(g.Expiration) {
+			return false
+		}
+
+		allow, u, d := g.Allowance.Accept(fee, ctx.BlockHeader())
+		if !allow {
+			return false
+		}
+
+		if err := k.bankKeeper.SendCoins(ctx, granterAddr, granteeAddr, fee); err != nil {
+			return false
+		}
+
+		payer, grant, updated, del = granterAddr, g, u, d
+		return true
+	})
+
+	if payer.Empty() {
+		return nil, false
+	}
+
+	if del {
+		k.RevokeFeeAllowance(ctx, payer, granteeAddr)
+		k.RevokeFromFeeAllowanceQueue(ctx, payer, granteeAddr, grant.Expiration)
+	} else {
+		grant.Allowance = updated
+		k.SetFeeAllowance(ctx, payer, granteeAddr, grant)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventUseFeeAllowance,
+			sdk.NewAttribute(types.AttributeKeyGranterAddress, payer.String()),
+			sdk.NewAttribute(types.AttributeKeyGranteeAddress, granteeAddr.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, fee.String()),
+		),
+	)
+
+	return payer, true
+}
+
+// fee allowance queue timeslice operations
+
+// GetFeeAllowanceQueueTimeSlice gets a specific fee allowance queue timeslice. A timeslice is a slice of GGPair
+// corresponding to fee allowances that expire at a certain time.
+func (k Keeper) GetFeeAllowanceQueueTimeSlice(ctx sdk.Context, timestamp time.Time) (ggPairs []types.GGPair) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetFeeAllowanceTimeKey(timestamp))
+	if bz == nil {
+		return []types.GGPair{}
+	}
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &ggPairs)
+	return ggPairs
+}
+
+// SetFeeAllowanceQueueTimeSlice sets a specific fee allowance queue timeslice.
+func (k Keeper) SetFeeAllowanceQueueTimeSlice(ctx sdk.Context, timestamp time.Time, keys []types.GGPair) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(keys)
+	store.Set(types.GetFeeAllowanceTimeKey(timestamp), bz)
+}
+
+// InsertFeeAllowanceQueue inserts a fee allowance to the appropriate timeslice in the fee allowance queue
+func (k Keeper) InsertFeeAllowanceQueue(ctx sdk.Context, granterAddr,
+	granteeAddr sdk.AccAddress, completionTime time.Time) {
+
+	timeSlice := k.GetFeeAllowanceQueueTimeSlice(ctx, completionTime)
+	ggPair := types.GGPair{GranterAddress: granterAddr, GranteeAddress: granteeAddr}
+	if len(timeSlice) == 0 {
+		k.SetFeeAllowanceQueueTimeSlice(ctx, completionTime, []types.GGPair{ggPair})
+	} else {
+		timeSlice = append(timeSlice, ggPair)
+		k.SetFeeAllowanceQueueTimeSlice(ctx, completionTime, timeSlice)
+	}
+}
+
+// RevokeFromFeeAllowanceQueue removes fee allowance data from the timeslice queue
+func (k Keeper) RevokeFromFeeAllowanceQueue(ctx sdk.Context, granterAddr,
+	granteeAddr sdk.AccAddress, completionTime time.Time) {
+	timeSlice := k.GetFeeAllowanceQueueTimeSlice(ctx, completionTime)
+	for idx, ggPair := range timeSlice {
+		if ggPair.GranterAddress.Equals(granterAddr) &&
+			ggPair.GranteeAddress.Equals(granteeAddr) {
+
+			lastIdx := len(timeSlice) - 1
+			timeSlice[idx] = timeSlice[lastIdx]
+			timeSlice = timeSlice[:lastIdx]
+
+			if len(timeSlice) == 0 {
+				ctx.KVStore(k.storeKey).Delete(types.GetFeeAllowanceTimeKey(completionTime))
+			} else {
+				k.SetFeeAllowanceQueueTimeSlice(ctx, completionTime, timeSlice)
+			}
+
+			return
+		}
+	}
+}
+
+// FeeAllowanceQueueIterator returns all the fee allowance queue timeslices from time 0 until endTime
+func (k Keeper) FeeAllowanceQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := ctx.KVStore(k.storeKey)
+	return store.Iterator(types.FeeAllowanceQueueKey,
+		sdk.InclusiveEndBytes(types.GetFeeAllowanceTimeKey(endTime)))
+}
+
+// DequeueAllMatureFeeAllowanceQueue returns a concatenated list of all the timeslices inclusively previous to
+// current block time, and deletes the timeslices from the queue
+func (k Keeper) DequeueAllMatureFeeAllowanceQueue(ctx sdk.Context) (matureFeeAllowances []types.GGPair) {
+	store := ctx.KVStore(k.storeKey)
+	// gets an iterator for all timeslices from time 0 until the current Blockheader time
+	timesliceIterator := k.FeeAllowanceQueueIterator(ctx, ctx.BlockHeader().Time)
+	defer timesliceIterator.Close()
+	for ; timesliceIterator.Valid(); timesliceIterator.Next() {
+		timeslice := []types.GGPair{}
+		value := timesliceIterator.Value()
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(value, &timeslice)
+		matureFeeAllowances = append(matureFeeAllowances, timeslice...)
+		store.Delete(timesliceIterator.Key())
+	}
+	return matureFeeAllowances
+}
@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/terra-project/core/x/feegrant/internal/types"
+)
+
+type TestSuite struct {
+	suite.Suite
+	ctx           sdk.Context
+	accountKeeper auth.AccountKeeper
+	bankKeeper    bank.Keeper
+	keeper        Keeper
+}
+
+func (s *TestSuite) SetupTest() {
+	s.ctx, s.accountKeeper, s.bankKeeper, s.keeper = SetupTestInput()
+}
+
+func (s *TestSuite) TestKeeper() {
+	s.T().Log("verify that no fee allowance returns not found")
+	_, found := s.keeper.GetFeeAllowance(s.ctx, granterAddr, granteeAddr)
+	s.Require().False(found)
+
+	now := s.ctx.BlockHeader().Time
+	limit := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+
+	s.T().Log("verify fee allowance is stored")
+	grant := types.NewFeeAllowanceGrant(types.NewBasicFeeAllowance(limit), now.Add(time.Hour))
+	s.keeper.SetFeeAllowance(s.ctx, granterAddr, granteeAddr, grant)
+	stored, found := s.keeper.GetFeeAllowance(s.ctx, granterAddr, granteeAddr)
+	s.Require().True(found)
+	s.Require().Equal(grant, stored)
+
+	s.T().Log("verify fetching fee allowance with swapped addresses fails")
+	_, found = s.keeper.GetFeeAllowance(s.ctx, granteeAddr, granterAddr)
+	s.Require().False(found)
+
+	s.T().Log("verify iterating fee allowances of grantee")
+	s.keeper.SetFeeAllowance(s.ctx, granter2Addr, granteeAddr, grant)
+	s.keeper.SetFeeAllowance(s.ctx, granteeAddr, granterAddr, grant)
+
+	count := 0
+	s.keeper.IterateGranteeFeeAllowances(s.ctx, granteeAddr, func(granter, grantee sdk.AccAddress, _ types.FeeAllowanceGrant) bool {
+		s.Require().Equal(granteeAddr, grantee)
+		count++
+		return false
+	})
+	s.Require().Equal(2, count)
+
+	s.T().Log("verify revoke fee allowance")
+	s.keeper.RevokeFeeAllowance(s.ctx, granterAddr, granteeAddr)
+	_, found = s.keeper.GetFeeAllowance(s.ctx, granterAddr, granteeAddr)
+	s.Require().False(found)
+}
+
+func (s *TestSuite) TestUseGrantedFees() {
+	err := s.bankKeeper.SetCoins(s.ctx, granterAddr, sdk.NewCoins(sdk.NewInt64Coin("steak", 10000)))
+	s.Require().NoError(err)
+
+	now := s.ctx.BlockHeader().Time
+	limit := sdk.NewCoins(sdk.NewInt64Coin("steak", 150))
+	grant := types.NewFeeAllowanceGrant(types.NewBasicFeeAllowance(limit), now.Add(time.Hour))
+	s.keeper.SetFeeAllowance(s.ctx, granterAddr, granteeAddr, grant)
+	s.keeper.InsertFeeAllowanceQueue(s.ctx, granterAddr, granteeAddr, grant.Expiration)
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+
+	s.T().Log("verify fee is paid by granter")
+	payer, ok := s.keeper.UseGrantedFees(s.ctx, granteeAddr, fee)
+	s.Require().True(ok)
+	s.Require().Equal(granterAddr, payer)
+	s.Require().Equal(fee, s.bankKeeper.GetCoins(s.ctx, granteeAddr))
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("steak", 9900)), s.bankKeeper.GetCoins(s.ctx, granterAddr))
+
+	stored, found := s.keeper.GetFeeAllowance(s.ctx, granterAddr, granteeAddr)
+	s.Require().True(found)
+	s.Require().Equal(types.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("steak", 50))), stored.Allowance)
+
+	s.T().Log("verify fee exceeding the spend limit is not paid")
+	_, ok = s.keeper.UseGrantedFees(s.ctx, granteeAddr, fee)
+	s.Require().False(ok)
+
+	s.T().Log("verify exhausted allowance is removed")
+	_, ok = s.keeper.UseGrantedFees(s.ctx, granteeAddr, sdk.NewCoins(sdk.NewInt64Coin("steak", 50)))
+	s.Require().True(ok)
+	_, found = s.keeper.GetFeeAllowance(s.ctx, granterAddr, granteeAddr)
+	s.Require().False(found)
+	s.Require().Empty(s.keeper.GetFeeAllowanceQueueTimeSlice(s.ctx, grant.Expiration))
+}
+
+func (s *TestSuite) TestUseGrantedFeesSkipsUnusableAllowances() {
+	err := s.bankKeeper.SetCoins(s.ctx, granter2Addr, sdk.NewCoins(sdk.NewInt64Coin("steak", 10000)))
+	s.Require().NoError(err)
+
+	now := s.ctx.BlockHeader().Time
+	fee := sdk.NewCoins(sdk.NewInt64Coin("steak", 100))
+
+	// granter has no balance and granteeAddr allowance from granter2 is expired
+	s.keeper.SetFeeAllowance(s.ctx, granterAddr, granteeAddr, types.NewFeeAllowanceGrant(types.NewBasicFeeAllowance(nil), now.Add(time.Hour)))
+	s.keeper.SetFeeAllowance(s.ctx, granter2Addr, granteeAddr, types.NewFeeAllowanceGrant(types.NewBasicFeeAllowance(nil), now))
+
+	_, ok := s.keeper.UseGrantedFees(s.ctx, granteeAddr, fee)
+	s.Require().False(ok)
+
+	// renew the allowance from granter2
+	s.keeper.SetFeeAllowance(s.ctx, granter2Addr, granteeAddr, types.NewFeeAllowanceGrant(types.NewBasicFeeAllowance(nil), now.Add(time.Hour)))
+
+	payer, ok := s.keeper.UseGrantedFees(s.ctx, granteeAddr, fee)
+	s.Require().True(ok)
+	s.Require().Equal(granter2Addr, payer)
+}
+
+func (s *TestSuite) TestFeeAllowanceQueue() {
+	now := s.ctx.BlockHeader().Time
+
+	s.keeper.InsertFeeAllowanceQueue(s.ctx, granterAddr, granteeAddr, now.Add(time.Hour))
+	s.keeper.InsertFeeAllowanceQueue(s.ctx, granter2Addr, granteeAddr, now.Add(time.Hour))
+	s.keeper.InsertFeeAllowanceQueue(s.ctx, granterAddr, granter2Addr, now.Add(2*time.Hour))
+
+	s.keeper.RevokeFromFeeAllowanceQueue(s.ctx, granter2Addr, granteeAddr, now.Add(time.Hour))
+	s.Require().Equal([]types.GGPair{{GranterAddress: granterAddr, GranteeAddress: granteeAddr}},
+		s.keeper.GetFeeAllowanceQueueTimeSlice(s.ctx, now.Add(time.Hour)))
+
+	s.Require().Empty(s.keeper.DequeueAllMatureFeeAllowanceQueue(s.ctx))
+
+	matured := s.keeper.DequeueAllMatureFeeAllowanceQueue(s.ctx.WithBlockTime(now.Add(time.Hour)))
+	s.Require().Equal([]types.GGPair{{GranterAddress: granterAddr, GranteeAddress: granteeAddr}}, matured)
+
+	matured = s.keeper.DequeueAllMatureFeeAllowanceQueue(s.ctx.WithBlockTime(now.Add(3 * time.Hour)))
+	s.Require().Equal([]types.GGPair{{GranterAddress: granterAddr, GranteeAddress: granter2Addr}}, matured)
+}
+
+func TestTestSuite(t *testing.T) {
+	suite.Run(t, new(TestSuite))
+}
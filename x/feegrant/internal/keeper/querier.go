@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/terra-project/core/x/feegrant/internal/types"
+)
+
+// NewQuerier is the module level router for state queries
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) (res []byte, err error) {
+		switch path[0] {
+		case types.QueryFeeAllowance:
+			return queryFeeAllowance(ctx, req, keeper)
+		case types.QueryFeeAllowances:
+			return queryFeeAllowances(ctx, req, keeper)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
+		}
+	}
+}
+
+func queryFeeAllowance(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryFeeAllowanceParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	grant, found := keeper.GetFeeAllowance(ctx, params.Granter, params.Grantee)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrNoFeeAllowance, "granter %s, grantee %s", params.Granter, params.Grantee)
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, grant)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func queryFeeAllowances(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryFeeAllowancesParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	entries := []types.FeeAllowanceEntry{}
+	keeper.IterateGranteeFeeAllowances(ctx, params.Grantee, func(granterAddr, granteeAddr sdk.AccAddress, grant types.FeeAllowanceGrant) bool {
+		entries = append(entries, types.FeeAllowanceEntry{
+			Granter:    granterAddr,
+			Grantee:    granteeAddr,
+			Allowance:  grant.Allowance,
+			Expiration: grant.Expiration,
+		})
+		return false
+	})
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, entries)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/feegrant/internal/types"
+)
+
+func (s *TestSuite) TestQueryFeeAllowance() {
+	querier := NewQuerier(s.keeper)
+
+	now := s.ctx.BlockHeader().Time
+	grant := types.NewFeeAllowanceGrant(types.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("steak", 100))), now.Add(time.Hour))
+
+	bz, err := types.ModuleCdc.MarshalJSON(types.NewQueryFeeAllowanceParams(granterAddr, granteeAddr))
+	s.Require().NoError(err)
+
+	req := abci.RequestQuery{Path: "", Data: bz}
+	_, err = querier(s.ctx, []string{types.QueryFeeAllowance}, req)
+	s.Require().Error(err)
+
+	s.keeper.SetFeeAllowance(s.ctx, granterAddr, granteeAddr, grant)
+	res, err := querier(s.ctx, []string{types.QueryFeeAllowance}, req)
+	s.Require().NoError(err)
+
+	var resGrant types.FeeAllowanceGrant
+	s.Require().NoError(s.keeper.cdc.UnmarshalJSON(res, &resGrant))
+	s.Require().Equal(grant, resGrant)
+}
+
+func (s *TestSuite) TestQueryFeeAllowances() {
+	querier := NewQuerier(s.keeper)
+
+	now := s.ctx.BlockHeader().Time
+	grant := types.NewFeeAllowanceGrant(types.NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("steak", 100))), now.Add(time.Hour))
+	s.keeper.SetFeeAllowance(s.ctx, granterAddr, granteeAddr, grant)
+	s.keeper.SetFeeAllowance(s.ctx, granter2Addr, granteeAddr, grant)
+
+	bz, err := types.ModuleCdc.MarshalJSON(types.NewQueryFeeAllowancesParams(granteeAddr))
+	s.Require().NoError(err)
+
+	res, err := querier(s.ctx, []string{types.QueryFeeAllowances}, abci.RequestQuery{Path: "", Data: bz})
+	s.Require().NoError(err)
+
+	var entries []types.FeeAllowanceEntry
+	s.Require().NoError(s.keeper.cdc.UnmarshalJSON(res, &entries))
+	s.Require().Len(entries, 2)
+	for _, entry := range entries {
+		s.Require().Equal(granteeAddr, entry.Grantee)
+		s.Require().Equal(grant.Allowance, entry.Allowance)
+	}
+}
@@ -0,0 +1,75 @@
+// nolint:deadcode unused DONTCOVER
+package keeper
+
+import (
+	"time"
+
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+
+	"github.com/terra-project/core/x/feegrant/internal/types"
+)
+
+func makeTestCodec() *codec.Codec {
+	var cdc = codec.New()
+	auth.RegisterCodec(cdc)
+	bank.RegisterCodec(cdc)
+	types.RegisterCodec(cdc)
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+
+	return cdc
+}
+
+// SetupTestInput returns test context and keepers
+func SetupTestInput() (sdk.Context, auth.AccountKeeper, bank.BaseKeeper, Keeper) {
+	db := dbm.NewMemDB()
+
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	keyFeeGrant := sdk.NewKVStoreKey(types.StoreKey)
+	tkeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyFeeGrant, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkeyParams, sdk.StoreTypeTransient, db)
+
+	ms.LoadLatestVersion()
+
+	ctx := sdk.NewContext(ms, abci.Header{Time: time.Unix(0, 0)}, false, log.NewNopLogger())
+	cdc := makeTestCodec()
+
+	blacklistedAddrs := make(map[string]bool)
+
+	paramsKeeper := params.NewKeeper(params.ModuleCdc, keyParams, tkeyParams)
+	authKeeper := auth.NewAccountKeeper(cdc, keyAcc, paramsKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(authKeeper, paramsKeeper.Subspace(bank.DefaultParamspace), blacklistedAddrs)
+	bankKeeper.SetSendEnabled(ctx, true)
+	authKeeper.SetParams(ctx, auth.DefaultParams())
+
+	feeGrantKeeper := NewKeeper(cdc, keyFeeGrant, bankKeeper)
+
+	return ctx, authKeeper, bankKeeper, feeGrantKeeper
+}
+
+var (
+	granteePub  = ed25519.GenPrivKey().PubKey()
+	granterPub  = ed25519.GenPrivKey().PubKey()
+	granter2Pub = ed25519.GenPrivKey().PubKey()
+	granteeAddr = sdk.AccAddress(granteePub.Address())
+	granterAddr = sdk.AccAddress(granterPub.Address())
+
+	granter2Addr = sdk.AccAddress(granter2Pub.Address())
+)
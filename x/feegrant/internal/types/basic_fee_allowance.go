@@ -0,0 +1,48 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// BasicFeeAllowance lets the grantee pay fees with the granter's coins
+// until the spend limit is used up.
+type BasicFeeAllowance struct {
+	// SpendLimit specifies the maximum amount of tokens that can be spent
+	// by this allowance and will be updated as tokens are spent. If it is
+	// empty, there is no spend limit and any amount of coins can be spent.
+	SpendLimit sdk.Coins `json:"spend_limit"`
+}
+
+// NewBasicFeeAllowance returns new BasicFeeAllowance instance
+func NewBasicFeeAllowance(spendLimit sdk.Coins) BasicFeeAllowance {
+	return BasicFeeAllowance{SpendLimit: spendLimit}
+}
+
+// Accept implements FeeAllowance
+func (allowance BasicFeeAllowance) Accept(fee sdk.Coins, block abci.Header) (allow bool, updated FeeAllowance, delete bool) {
+	if allowance.SpendLimit.Empty() {
+		return true, allowance, false
+	}
+
+	limitLeft, isNegative := allowance.SpendLimit.SafeSub(fee)
+	if isNegative {
+		return false, nil, false
+	}
+
+	if limitLeft.IsZero() {
+		return true, nil, true
+	}
+
+	return true, BasicFeeAllowance{SpendLimit: limitLeft}, false
+}
+
+// ValidateBasic implements FeeAllowance
+func (allowance BasicFeeAllowance) ValidateBasic() error {
+	if !allowance.SpendLimit.IsValid() {
+		return sdkerrors.Wrapf(ErrInvalidAllowance, "invalid spend limit %s", allowance.SpendLimit)
+	}
+
+	return nil
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+)
+
+// ModuleCdc defines internal Module Codec
+var ModuleCdc = codec.New()
+
+// RegisterCodec concretes types on codec codec
+func RegisterCodec(cdc *codec.Codec) {
+	cdc.RegisterConcrete(MsgGrantFeeAllowance{}, "feegrant/MsgGrantFeeAllowance", nil)
+	cdc.RegisterConcrete(MsgRevokeFeeAllowance{}, "feegrant/MsgRevokeFeeAllowance", nil)
+	cdc.RegisterConcrete(BasicFeeAllowance{}, "feegrant/BasicFeeAllowance", nil)
+	cdc.RegisterConcrete(PeriodicFeeAllowance{}, "feegrant/PeriodicFeeAllowance", nil)
+
+	cdc.RegisterInterface((*FeeAllowance)(nil), nil)
+}
+
+func init() {
+	RegisterCodec(ModuleCdc)
+}
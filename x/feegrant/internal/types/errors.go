@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/feegrant module sentinel errors
+var (
+	ErrInvalidPeriod    = sdkerrors.Register(ModuleName, 2, "period of fee allowance should be positive time duration")
+	ErrInvalidAllowance = sdkerrors.Register(ModuleName, 3, "invalid fee allowance")
+	ErrNoFeeAllowance   = sdkerrors.Register(ModuleName, 4, "fee allowance not found")
+)
@@ -0,0 +1,13 @@
+package types
+
+// feegrant module events
+const (
+	EventGrantFeeAllowance  = "grant_fee_allowance"
+	EventRevokeFeeAllowance = "revoke_fee_allowance"
+	EventUseFeeAllowance    = "use_fee_allowance"
+
+	AttributeKeyGranteeAddress = "grantee"
+	AttributeKeyGranterAddress = "granter"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// FeeAllowance represents the interface of various fee allowance instances
+type FeeAllowance interface {
+	// Accept checks whether the given fee can be paid with the allowance at the given block.
+	// If allowed, updated is the allowance state after paying the fee, and delete
+	// indicates that the allowance is exhausted and must be removed.
+	Accept(fee sdk.Coins, block abci.Header) (allow bool, updated FeeAllowance, delete bool)
+
+	// ValidateBasic performs stateless validation of the allowance
+	ValidateBasic() error
+}
+
+// FeeAllowanceGrant represent the stored fee allowance instance in the keeper store
+type FeeAllowanceGrant struct {
+	Allowance FeeAllowance `json:"allowance"`
+
+	Expiration time.Time `json:"expiration"`
+}
+
+// NewFeeAllowanceGrant returns new FeeAllowanceGrant instance
+func NewFeeAllowanceGrant(allowance FeeAllowance, expiration time.Time) FeeAllowanceGrant {
+	return FeeAllowanceGrant{Allowance: allowance, Expiration: expiration}
+}
+
+// GGPair is struct that just has a granter-grantee pair with no other data.
+// It is intended to be used as a marshalable pointer. For example, a GGPair can be used to construct the
+// key to getting a FeeAllowanceGrant from state.
+type GGPair struct {
+	GranterAddress sdk.AccAddress
+	GranteeAddress sdk.AccAddress
+}
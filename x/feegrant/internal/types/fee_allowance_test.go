@@ -0,0 +1,93 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+func TestBasicFeeAllowance(t *testing.T) {
+	header := abci.Header{Time: time.Now()}
+	allowance := NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("foo", 100)))
+	require.NoError(t, allowance.ValidateBasic())
+
+	allow, updated, del := allowance.Accept(sdk.NewCoins(sdk.NewInt64Coin("foo", 30)), header)
+	require.True(t, allow)
+	require.False(t, del)
+	require.Equal(t, NewBasicFeeAllowance(sdk.NewCoins(sdk.NewInt64Coin("foo", 70))), updated)
+
+	allow, _, _ = allowance.Accept(sdk.NewCoins(sdk.NewInt64Coin("bar", 30)), header)
+	require.False(t, allow)
+
+	allow, _, _ = allowance.Accept(sdk.NewCoins(sdk.NewInt64Coin("foo", 101)), header)
+	require.False(t, allow)
+
+	allow, updated, del = allowance.Accept(sdk.NewCoins(sdk.NewInt64Coin("foo", 100)), header)
+	require.True(t, allow)
+	require.True(t, del)
+	require.Nil(t, updated)
+
+	// empty spend limit means no limit
+	unlimited := NewBasicFeeAllowance(nil)
+	allow, updated, del = unlimited.Accept(sdk.NewCoins(sdk.NewInt64Coin("foo", 1000000)), header)
+	require.True(t, allow)
+	require.False(t, del)
+	require.Equal(t, unlimited, updated)
+}
+
+func TestPeriodicFeeAllowance(t *testing.T) {
+	now := time.Now()
+	header := abci.Header{Time: now}
+
+	allowance := NewPeriodicFeeAllowance(
+		sdk.NewCoins(sdk.NewInt64Coin("foo", 250)),
+		time.Hour,
+		sdk.NewCoins(sdk.NewInt64Coin("foo", 100)),
+	)
+	require.NoError(t, allowance.ValidateBasic())
+
+	// first use starts the period
+	allow, updated, del := allowance.Accept(sdk.NewCoins(sdk.NewInt64Coin("foo", 60)), header)
+	require.True(t, allow)
+	require.False(t, del)
+
+	periodic := updated.(PeriodicFeeAllowance)
+	require.Equal(t, now.Add(time.Hour), periodic.PeriodReset)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foo", 40)), periodic.PeriodCanSpend)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foo", 190)), periodic.Basic.SpendLimit)
+
+	// exceeding the period limit
+	allow, _, _ = periodic.Accept(sdk.NewCoins(sdk.NewInt64Coin("foo", 60)), header)
+	require.False(t, allow)
+
+	// next period refills the period limit
+	header.Time = now.Add(time.Hour)
+	allow, updated, _ = periodic.Accept(sdk.NewCoins(sdk.NewInt64Coin("foo", 100)), header)
+	require.True(t, allow)
+
+	periodic = updated.(PeriodicFeeAllowance)
+	require.Equal(t, now.Add(2*time.Hour), periodic.PeriodReset)
+	require.True(t, periodic.PeriodCanSpend.IsZero())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foo", 90)), periodic.Basic.SpendLimit)
+
+	// long idle time starts the period from the block time,
+	// and the period limit is capped by the remaining total limit
+	header.Time = now.Add(10 * time.Hour)
+	allow, updated, del = periodic.Accept(sdk.NewCoins(sdk.NewInt64Coin("foo", 90)), header)
+	require.True(t, allow)
+	require.True(t, del)
+	require.Nil(t, updated)
+}
+
+func TestPeriodicFeeAllowanceValidateBasic(t *testing.T) {
+	limit := sdk.NewCoins(sdk.NewInt64Coin("foo", 100))
+
+	require.Error(t, NewPeriodicFeeAllowance(limit, 0, limit).ValidateBasic())
+	require.Error(t, NewPeriodicFeeAllowance(limit, time.Hour, nil).ValidateBasic())
+	require.Error(t, NewPeriodicFeeAllowance(limit, time.Hour, limit.Add(limit...)).ValidateBasic())
+	require.NoError(t, NewPeriodicFeeAllowance(nil, time.Hour, limit).ValidateBasic())
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeAllowanceEntry hold each fee allowance information
+type FeeAllowanceEntry struct {
+	Granter    sdk.AccAddress `json:"granter" yaml:"granter"`
+	Grantee    sdk.AccAddress `json:"grantee" yaml:"grantee"`
+	Allowance  FeeAllowance   `json:"allowance" yaml:"allowance"`
+	Expiration time.Time      `json:"expiration" yaml:"expiration"`
+}
+
+// GenesisState is the struct representation of the export genesis
+type GenesisState struct {
+	FeeAllowanceEntries []FeeAllowanceEntry `json:"fee_allowance_entries" yaml:"fee_allowance_entries"`
+}
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(entries []FeeAllowanceEntry) GenesisState {
+	return GenesisState{
+		FeeAllowanceEntries: entries,
+	}
+}
+
+// ValidateGenesis check the given genesis state has no integrity issues
+func ValidateGenesis(data GenesisState) error {
+	for _, entry := range data.FeeAllowanceEntries {
+		if entry.Granter.Empty() || entry.Grantee.Empty() {
+			return fmt.Errorf("fee allowance entry must have granter and grantee")
+		}
+
+		if entry.Allowance == nil {
+			return fmt.Errorf("fee allowance entry must have allowance")
+		}
+
+		if err := entry.Allowance.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DefaultGenesisState gets raw genesis raw message for testing
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		FeeAllowanceEntries: []FeeAllowanceEntry{},
+	}
+}
+
+// Equal checks whether 2 GenesisState structs are equivalent.
+func (data GenesisState) Equal(data2 GenesisState) bool {
+	b1 := ModuleCdc.MustMarshalBinaryBare(data)
+	b2 := ModuleCdc.MustMarshalBinaryBare(data2)
+	return bytes.Equal(b1, b2)
+}
+
+// IsEmpty returns if a GenesisState is empty or has data in it
+func (data GenesisState) IsEmpty() bool {
+	emptyGenState := GenesisState{}
+	return data.Equal(emptyGenState)
+}
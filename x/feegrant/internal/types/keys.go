@@ -0,0 +1,57 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName is the module name constant used in many places
+	ModuleName = "feegrant"
+
+	// StoreKey is the store key string for feegrant
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for feegrant
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for feegrant
+	QuerierRoute = ModuleName
+)
+
+// Keys for feegrant store
+// Items are stored with the following key: values
+//
+// - 0x01<granteeAddress_Bytes><granterAddress_Bytes>: FeeAllowanceGrant
+// - 0x02<timestamp_Bytes>: []GGPair
+var (
+	// Keys for store prefixes
+	FeeAllowanceKey      = []byte{0x01} // prefix for each key to a fee allowance
+	FeeAllowanceQueueKey = []byte{0x02} // prefix for the timestamps in fee allowance queue
+)
+
+// GetFeeAllowanceKey - return fee allowance store key
+// The grantee address comes first, so all the allowances given to
+// a grantee can be iterated with a prefix iterator
+func GetFeeAllowanceKey(granteeAddr sdk.AccAddress, granterAddr sdk.AccAddress) []byte {
+	return append(GetFeeAllowancesByGranteeKey(granteeAddr), granterAddr.Bytes()...)
+}
+
+// GetFeeAllowancesByGranteeKey - return the prefix key of all allowances given to the grantee
+func GetFeeAllowancesByGranteeKey(granteeAddr sdk.AccAddress) []byte {
+	return append(FeeAllowanceKey, granteeAddr.Bytes()...)
+}
+
+// GetFeeAllowanceTimeKey - return fee allowance queue store key
+func GetFeeAllowanceTimeKey(timestamp time.Time) []byte {
+	bz := sdk.FormatTimeBytes(timestamp)
+	return append(FeeAllowanceQueueKey, bz...)
+}
+
+// ExtractAddressesFromFeeAllowanceKey - split granter & grantee address from the fee allowance key
+func ExtractAddressesFromFeeAllowanceKey(key []byte) (granterAddr, granteeAddr sdk.AccAddress) {
+	granteeAddr = sdk.AccAddress(key[1 : sdk.AddrLen+1])
+	granterAddr = sdk.AccAddress(key[sdk.AddrLen+1 : sdk.AddrLen*2+1])
+	return
+}
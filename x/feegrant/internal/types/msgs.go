@@ -0,0 +1,98 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MsgGrantFeeAllowance grants the provided fee allowance to the grantee on the granter's
+// account during the provided period time.
+type MsgGrantFeeAllowance struct {
+	Granter   sdk.AccAddress `json:"granter"`
+	Grantee   sdk.AccAddress `json:"grantee"`
+	Allowance FeeAllowance   `json:"allowance"`
+	Period    time.Duration  `json:"period"`
+}
+
+// NewMsgGrantFeeAllowance returns new MsgGrantFeeAllowance instance
+func NewMsgGrantFeeAllowance(granter sdk.AccAddress, grantee sdk.AccAddress, allowance FeeAllowance, period time.Duration) MsgGrantFeeAllowance {
+	return MsgGrantFeeAllowance{
+		Granter:   granter,
+		Grantee:   grantee,
+		Allowance: allowance,
+		Period:    period,
+	}
+}
+
+func (msg MsgGrantFeeAllowance) Route() string { return RouterKey }
+func (msg MsgGrantFeeAllowance) Type() string  { return "grant_fee_allowance" }
+
+func (msg MsgGrantFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+func (msg MsgGrantFeeAllowance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgGrantFeeAllowance) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	if msg.Granter.Equals(msg.Grantee) {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "can not be grantee == granter")
+	}
+
+	if msg.Period <= 0 {
+		return ErrInvalidPeriod
+	}
+
+	if msg.Allowance == nil {
+		return sdkerrors.Wrap(ErrInvalidAllowance, "missing allowance")
+	}
+
+	return msg.Allowance.ValidateBasic()
+}
+
+// MsgRevokeFeeAllowance revokes the fee allowance on the granter's
+// account that has been granted to the grantee.
+type MsgRevokeFeeAllowance struct {
+	Granter sdk.AccAddress `json:"granter"`
+	Grantee sdk.AccAddress `json:"grantee"`
+}
+
+// NewMsgRevokeFeeAllowance returns new MsgRevokeFeeAllowance instance
+func NewMsgRevokeFeeAllowance(granter sdk.AccAddress, grantee sdk.AccAddress) MsgRevokeFeeAllowance {
+	return MsgRevokeFeeAllowance{
+		Granter: granter,
+		Grantee: grantee,
+	}
+}
+
+func (msg MsgRevokeFeeAllowance) Route() string { return RouterKey }
+func (msg MsgRevokeFeeAllowance) Type() string  { return "revoke_fee_allowance" }
+
+func (msg MsgRevokeFeeAllowance) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Granter}
+}
+
+func (msg MsgRevokeFeeAllowance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg MsgRevokeFeeAllowance) ValidateBasic() error {
+	if msg.Granter.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "missing grantee address")
+	}
+	return nil
+}
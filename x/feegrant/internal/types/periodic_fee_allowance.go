@@ -0,0 +1,110 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// PeriodicFeeAllowance extends BasicFeeAllowance with a per period spend limit,
+// which is refilled whenever the period is over.
+type PeriodicFeeAllowance struct {
+	// Basic specifies the total spend limit of the allowance
+	Basic BasicFeeAllowance `json:"basic"`
+
+	// Period specifies the time duration in which PeriodSpendLimit coins can be spent
+	Period time.Duration `json:"period"`
+
+	// PeriodSpendLimit specifies the maximum amount of tokens that can be spent in a period
+	PeriodSpendLimit sdk.Coins `json:"period_spend_limit"`
+
+	// PeriodCanSpend is the amount of tokens left to be spent before the period reset
+	PeriodCanSpend sdk.Coins `json:"period_can_spend"`
+
+	// PeriodReset is the time at which the current period resets and a new one starts.
+	// Zero time resets the period at the first use.
+	PeriodReset time.Time `json:"period_reset"`
+}
+
+// NewPeriodicFeeAllowance returns new PeriodicFeeAllowance instance
+func NewPeriodicFeeAllowance(spendLimit sdk.Coins, period time.Duration, periodSpendLimit sdk.Coins) PeriodicFeeAllowance {
+	return PeriodicFeeAllowance{
+		Basic:            NewBasicFeeAllowance(spendLimit),
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+	}
+}
+
+// Accept implements FeeAllowance
+func (allowance PeriodicFeeAllowance) Accept(fee sdk.Coins, block abci.Header) (allow bool, updated FeeAllowance, delete bool) {
+	allowance.tryResetPeriod(block.Time)
+
+	periodCanSpend, isNegative := allowance.PeriodCanSpend.SafeSub(fee)
+	if isNegative {
+		return false, nil, false
+	}
+
+	allow, basic, delete := allowance.Basic.Accept(fee, block)
+	if !allow || delete {
+		return allow, nil, delete
+	}
+
+	allowance.Basic = basic.(BasicFeeAllowance)
+	allowance.PeriodCanSpend = periodCanSpend
+
+	return true, allowance, false
+}
+
+// tryResetPeriod refills PeriodCanSpend when the block time reaches PeriodReset.
+// PeriodCanSpend can not exceed what is left of the total spend limit.
+func (allowance *PeriodicFeeAllowance) tryResetPeriod(blockTime time.Time) {
+	if blockTime.Before(allowance.PeriodReset) {
+		return
+	}
+
+	allowance.PeriodCanSpend = allowance.PeriodSpendLimit
+	if !allowance.Basic.SpendLimit.Empty() && !allowance.Basic.SpendLimit.IsAllGTE(allowance.PeriodSpendLimit) {
+		var canSpend sdk.Coins
+		for _, coin := range allowance.PeriodSpendLimit {
+			left := allowance.Basic.SpendLimit.AmountOf(coin.Denom)
+			canSpend = append(canSpend, sdk.NewCoin(coin.Denom, sdk.MinInt(coin.Amount, left)))
+		}
+
+		allowance.PeriodCanSpend = sdk.NewCoins(canSpend...)
+	}
+
+	// If we are within one period, just move to the next period.
+	// Otherwise, start a new period from the current block time.
+	allowance.PeriodReset = allowance.PeriodReset.Add(allowance.Period)
+	if blockTime.After(allowance.PeriodReset) {
+		allowance.PeriodReset = blockTime.Add(allowance.Period)
+	}
+}
+
+// ValidateBasic implements FeeAllowance
+func (allowance PeriodicFeeAllowance) ValidateBasic() error {
+	if err := allowance.Basic.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if allowance.Period <= 0 {
+		return sdkerrors.Wrap(ErrInvalidAllowance, "period must be positive")
+	}
+
+	if !allowance.PeriodSpendLimit.IsValid() || allowance.PeriodSpendLimit.Empty() {
+		return sdkerrors.Wrapf(ErrInvalidAllowance, "invalid period spend limit %s", allowance.PeriodSpendLimit)
+	}
+
+	if !allowance.PeriodCanSpend.IsValid() {
+		return sdkerrors.Wrapf(ErrInvalidAllowance, "invalid period can spend %s", allowance.PeriodCanSpend)
+	}
+
+	// check the period spend limit is not exceeding the total spend limit
+	if !allowance.Basic.SpendLimit.Empty() && !allowance.Basic.SpendLimit.IsAllGTE(allowance.PeriodSpendLimit) {
+		return sdkerrors.Wrap(ErrInvalidAllowance, "period spend limit exceeds total spend limit")
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Defines the prefix of each query path
+const (
+	QueryFeeAllowance  = "allowance"
+	QueryFeeAllowances = "allowances"
+)
+
+// QueryFeeAllowanceParams defines the params for the following queries:
+// - 'custom/feegrant/allowance'
+type QueryFeeAllowanceParams struct {
+	Granter sdk.AccAddress `json:"granter"`
+	Grantee sdk.AccAddress `json:"grantee"`
+}
+
+// NewQueryFeeAllowanceParams returns params for fee allowance query
+func NewQueryFeeAllowanceParams(granter sdk.AccAddress, grantee sdk.AccAddress) QueryFeeAllowanceParams {
+	return QueryFeeAllowanceParams{Granter: granter, Grantee: grantee}
+}
+
+// QueryFeeAllowancesParams defines the params for the following queries:
+// - 'custom/feegrant/allowances'
+type QueryFeeAllowancesParams struct {
+	Grantee sdk.AccAddress `json:"grantee"`
+}
+
+// NewQueryFeeAllowancesParams returns params for fee allowances query
+func NewQueryFeeAllowancesParams(grantee sdk.AccAddress) QueryFeeAllowancesParams {
+	return QueryFeeAllowancesParams{Grantee: grantee}
+}
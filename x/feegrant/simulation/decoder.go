@@ -0,0 +1,29 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	tmkv "github.com/tendermint/tendermint/libs/kv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/terra-project/core/x/feegrant/internal/types"
+)
+
+// DecodeStore unmarshals the KVPair's Value to the corresponding feegrant type
+func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
+	switch {
+	case bytes.Equal(kvA.Key[:1], types.FeeAllowanceKey):
+		var grantA, grantB types.FeeAllowanceGrant
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &grantA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &grantB)
+		return fmt.Sprintf("%v\n%v", grantA, grantB)
+	case bytes.Equal(kvA.Key[:1], types.FeeAllowanceQueueKey):
+		var pairsA, pairsB []types.GGPair
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &pairsA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &pairsB)
+		return fmt.Sprintf("%v\n%v", pairsA, pairsB)
+	default:
+		panic(fmt.Sprintf("invalid feegrant key prefix %X", kvA.Key[:1]))
+	}
+}
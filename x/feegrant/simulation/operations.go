@@ -0,0 +1,155 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/terra-project/core/x/feegrant/internal/keeper"
+	"github.com/terra-project/core/x/feegrant/internal/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgGrantFeeAllowance  = "op_weight_msg_grant_fee_allowance"
+	OpWeightMsgRevokeFeeAllowance = "op_weight_msg_revoke_fee_allowance"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simulation.AppParams, cdc *codec.Codec, ak authkeeper.AccountKeeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+
+	var (
+		weightMsgGrantFeeAllowance  int
+		weightMsgRevokeFeeAllowance int
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgGrantFeeAllowance, &weightMsgGrantFeeAllowance, nil,
+		func(_ *rand.Rand) {
+			weightMsgGrantFeeAllowance = simappparams.DefaultWeightMsgDelegate
+		},
+	)
+
+	appParams.GetOrGenerate(cdc, OpWeightMsgRevokeFeeAllowance, &weightMsgRevokeFeeAllowance, nil,
+		func(_ *rand.Rand) {
+			weightMsgRevokeFeeAllowance = simappparams.DefaultWeightMsgUndelegate
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgGrantFeeAllowance,
+			SimulateMsgGrantFeeAllowance(ak, k),
+		),
+		simulation.NewWeightedOperation(
+			weightMsgRevokeFeeAllowance,
+			SimulateMsgRevokeFeeAllowance(ak, k),
+		),
+	}
+}
+
+// SimulateMsgGrantFeeAllowance generates a MsgGrantFeeAllowance with random values.
+// nolint: funlen
+func SimulateMsgGrantFeeAllowance(ak authkeeper.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		granter, _ := simulation.RandomAcc(r, accs)
+		grantee, _ := simulation.RandomAcc(r, accs)
+		if granter.Address.Equals(grantee.Address) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, granter.Address)
+
+		spendableCoins := account.SpendableCoins(ctx.BlockTime())
+		fees, err := simulation.RandomFees(r, ctx, spendableCoins)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		spendLimit := simulation.RandSubsetCoins(r, spendableCoins.Sub(fees))
+
+		var allowance types.FeeAllowance = types.NewBasicFeeAllowance(spendLimit)
+		if !spendLimit.Empty() && r.Intn(2) == 0 {
+			allowance = types.NewPeriodicFeeAllowance(spendLimit, time.Minute*time.Duration(simulation.RandIntBetween(r, 1, 60)), spendLimit)
+		}
+
+		msg := types.NewMsgGrantFeeAllowance(granter.Address, grantee.Address, allowance, time.Hour)
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			granter.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		return simulation.NewOperationMsg(msg, true, ""), nil, err
+	}
+}
+
+// SimulateMsgRevokeFeeAllowance generates a MsgRevokeFeeAllowance with random values.
+// nolint: funlen
+func SimulateMsgRevokeFeeAllowance(ak authkeeper.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		hasGrant := false
+		var granterAddr sdk.AccAddress
+		var granteeAddr sdk.AccAddress
+		k.IterateFeeAllowances(ctx, func(granter, grantee sdk.AccAddress, _ types.FeeAllowanceGrant) bool {
+			granterAddr = granter
+			granteeAddr = grantee
+			hasGrant = true
+			return true
+		})
+
+		if !hasGrant {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		granter, found := simulation.FindAccount(accs, granterAddr)
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, granter.Address)
+
+		spendableCoins := account.SpendableCoins(ctx.BlockTime())
+		fees, err := simulation.RandomFees(r, ctx, spendableCoins)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		msg := types.NewMsgRevokeFeeAllowance(granterAddr, granteeAddr)
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			granter.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		return simulation.NewOperationMsg(msg, true, ""), nil, err
+	}
+}
@@ -0,0 +1,268 @@
+package wasm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+
+	wasmTypes "github.com/CosmWasm/go-cosmwasm/types"
+
+	wasm "github.com/terra-project/core/x/wasm/exported"
+)
+
+var _ wasm.WasmQuerierInterface = WasmQuerier{}
+var _ wasm.WasmMsgParserInterface = WasmMsgParser{}
+
+// Vote options of the wasm gov msgs and queries
+const (
+	VoteOptionYes        = "yes"
+	VoteOptionAbstain    = "abstain"
+	VoteOptionNo         = "no"
+	VoteOptionNoWithVeto = "no_with_veto"
+)
+
+// WasmMsgParser - wasm msg parser for gov msgs
+type WasmMsgParser struct{}
+
+// NewWasmMsgParser returns gov wasm msg parser
+func NewWasmMsgParser() WasmMsgParser {
+	return WasmMsgParser{}
+}
+
+// Parse implements wasm gov msg parser
+func (WasmMsgParser) Parse(_ sdk.AccAddress, _ wasmTypes.CosmosMsg) ([]sdk.Msg, error) {
+	return nil, nil
+}
+
+// CosmosMsg contains gov msgs of the contract as a voter or a depositor
+type CosmosMsg struct {
+	Vote    *VoteMsg    `json:"vote,omitempty"`
+	Deposit *DepositMsg `json:"deposit,omitempty"`
+}
+
+// VoteMsg votes on the proposal with one of yes, abstain, no and no_with_veto
+type VoteMsg struct {
+	ProposalID uint64 `json:"proposal_id"`
+	Option     string `json:"option"`
+}
+
+// DepositMsg deposits the amount to the proposal
+type DepositMsg struct {
+	ProposalID uint64          `json:"proposal_id"`
+	Amount     wasmTypes.Coins `json:"amount"`
+}
+
+// ParseCustom implements custom parser
+func (WasmMsgParser) ParseCustom(contractAddr sdk.AccAddress, data json.RawMessage) ([]sdk.Msg, error) {
+	var msg CosmosMsg
+	err := json.Unmarshal(data, &msg)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to parse gov custom msg")
+	}
+
+	var sdkMsg sdk.Msg
+	if msg.Vote != nil {
+		option, err := parseVoteOption(msg.Vote.Option)
+		if err != nil {
+			return nil, err
+		}
+
+		sdkMsg = gov.NewMsgVote(contractAddr, msg.Vote.ProposalID, option)
+	} else if msg.Deposit != nil {
+		amount, err := wasm.ParseToCoins(msg.Deposit.Amount)
+		if err != nil {
+			return nil, err
+		}
+
+		sdkMsg = gov.NewMsgDeposit(contractAddr, msg.Deposit.ProposalID, amount)
+	} else {
+		return nil, sdkerrors.Wrap(wasm.ErrInvalidMsg, "Unknown variant of Gov")
+	}
+
+	return []sdk.Msg{sdkMsg}, sdkMsg.ValidateBasic()
+}
+
+// WasmQuerier - gov query interface for wasm contract
+type WasmQuerier struct {
+	keeper gov.Keeper
+}
+
+// NewWasmQuerier returns gov wasm querier
+func NewWasmQuerier(keeper gov.Keeper) WasmQuerier {
+	return WasmQuerier{keeper}
+}
+
+// Query - implement query function
+func (WasmQuerier) Query(_ sdk.Context, _ wasmTypes.QueryRequest) ([]byte, error) { return nil, nil }
+
+// CosmosQuery contains various gov queries
+type CosmosQuery struct {
+	Proposal *ProposalQuery `json:"proposal,omitempty"`
+	Tally    *ProposalQuery `json:"tally,omitempty"`
+	Vote     *VoteQuery     `json:"vote,omitempty"`
+}
+
+// ProposalQuery queries the proposal
+type ProposalQuery struct {
+	ProposalID uint64 `json:"proposal_id"`
+}
+
+// VoteQuery queries the vote of the voter on the proposal
+type VoteQuery struct {
+	ProposalID uint64 `json:"proposal_id"`
+	Voter      string `json:"voter"`
+}
+
+// ProposalQueryResponse - proposal query response for wasm module;
+// the times are unix timestamps in seconds
+type ProposalQueryResponse struct {
+	ProposalID      uint64          `json:"proposal_id"`
+	Title           string          `json:"title"`
+	ProposalType    string          `json:"proposal_type"`
+	Status          string          `json:"status"`
+	SubmitTime      int64           `json:"submit_time"`
+	DepositEndTime  int64           `json:"deposit_end_time"`
+	TotalDeposit    wasmTypes.Coins `json:"total_deposit"`
+	VotingStartTime int64           `json:"voting_start_time"`
+	VotingEndTime   int64           `json:"voting_end_time"`
+}
+
+// TallyQueryResponse - tally query response for wasm module; the tally of the
+// proposal in the voting period is computed up to the current block
+type TallyQueryResponse struct {
+	// uint128 strings, eg "1000000"
+	Yes        string `json:"yes"`
+	Abstain    string `json:"abstain"`
+	No         string `json:"no"`
+	NoWithVeto string `json:"no_with_veto"`
+}
+
+// VoteQueryResponse - vote query response for wasm module; empty option if the voter has not voted
+type VoteQueryResponse struct {
+	Option string `json:"option"`
+}
+
+// QueryCustom implements custom query interface
+func (querier WasmQuerier) QueryCustom(ctx sdk.Context, data json.RawMessage) ([]byte, error) {
+	var query CosmosQuery
+	err := json.Unmarshal(data, &query)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	var res interface{}
+	switch {
+	case query.Proposal != nil:
+		proposal, ok := querier.keeper.GetProposal(ctx, query.Proposal.ProposalID)
+		if !ok {
+			return nil, sdkerrors.Wrapf(gov.ErrUnknownProposal, "%d", query.Proposal.ProposalID)
+		}
+
+		res = ProposalQueryResponse{
+			ProposalID:      proposal.ProposalID,
+			Title:           proposal.GetTitle(),
+			ProposalType:    proposal.ProposalType(),
+			Status:          encodeProposalStatus(proposal.Status),
+			SubmitTime:      proposal.SubmitTime.Unix(),
+			DepositEndTime:  proposal.DepositEndTime.Unix(),
+			TotalDeposit:    wasm.EncodeSdkCoins(proposal.TotalDeposit),
+			VotingStartTime: proposal.VotingStartTime.Unix(),
+			VotingEndTime:   proposal.VotingEndTime.Unix(),
+		}
+	case query.Tally != nil:
+		proposal, ok := querier.keeper.GetProposal(ctx, query.Tally.ProposalID)
+		if !ok {
+			return nil, sdkerrors.Wrapf(gov.ErrUnknownProposal, "%d", query.Tally.ProposalID)
+		}
+
+		var tally gov.TallyResult
+		switch proposal.Status {
+		case gov.StatusDepositPeriod:
+			tally = gov.EmptyTallyResult()
+		case gov.StatusPassed, gov.StatusRejected:
+			tally = proposal.FinalTallyResult
+		default:
+			// tallying deletes the votes, so it runs in a cache-wrapped context
+			cacheCtx, _ := ctx.CacheContext()
+			_, _, tally = querier.keeper.Tally(cacheCtx, proposal)
+		}
+
+		res = TallyQueryResponse{
+			Yes:        tally.Yes.String(),
+			Abstain:    tally.Abstain.String(),
+			No:         tally.No.String(),
+			NoWithVeto: tally.NoWithVeto.String(),
+		}
+	case query.Vote != nil:
+		voter, err := sdk.AccAddressFromBech32(query.Vote.Voter)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, query.Vote.Voter)
+		}
+
+		var option string
+		if vote, found := querier.keeper.GetVote(ctx, query.Vote.ProposalID, voter); found {
+			option = encodeVoteOption(vote.Option)
+		}
+
+		res = VoteQueryResponse{Option: option}
+	default:
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+func parseVoteOption(option string) (gov.VoteOption, error) {
+	switch option {
+	case VoteOptionYes:
+		return gov.OptionYes, nil
+	case VoteOptionAbstain:
+		return gov.OptionAbstain, nil
+	case VoteOptionNo:
+		return gov.OptionNo, nil
+	case VoteOptionNoWithVeto:
+		return gov.OptionNoWithVeto, nil
+	}
+
+	return gov.OptionEmpty, sdkerrors.Wrap(gov.ErrInvalidVote, fmt.Sprintf("invalid vote option: %s", option))
+}
+
+func encodeVoteOption(option gov.VoteOption) string {
+	switch option {
+	case gov.OptionYes:
+		return VoteOptionYes
+	case gov.OptionAbstain:
+		return VoteOptionAbstain
+	case gov.OptionNo:
+		return VoteOptionNo
+	case gov.OptionNoWithVeto:
+		return VoteOptionNoWithVeto
+	}
+
+	return ""
+}
+
+func encodeProposalStatus(status gov.ProposalStatus) string {
+	switch status {
+	case gov.StatusDepositPeriod:
+		return "deposit_period"
+	case gov.StatusVotingPeriod:
+		return "voting_period"
+	case gov.StatusPassed:
+		return "passed"
+	case gov.StatusRejected:
+		return "rejected"
+	case gov.StatusFailed:
+		return "failed"
+	}
+
+	return ""
+}
@@ -0,0 +1,106 @@
+package wasm
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+	"github.com/cosmos/cosmos-sdk/x/mock"
+
+	wasmTypes "github.com/CosmWasm/go-cosmwasm/types"
+)
+
+func TestEncoding(t *testing.T) {
+	_, addrs := mock.GeneratePrivKeyAddressPairs(1)
+
+	cases := map[string]struct {
+		sender sdk.AccAddress
+		input  CosmosMsg
+		// set if valid
+		output []sdk.Msg
+		// set if invalid
+		isError bool
+	}{
+		"vote": {
+			sender: addrs[0],
+			input: CosmosMsg{
+				Vote: &VoteMsg{
+					ProposalID: 1,
+					Option:     VoteOptionNoWithVeto,
+				},
+			},
+			output: []sdk.Msg{
+				gov.NewMsgVote(addrs[0], 1, gov.OptionNoWithVeto),
+			},
+		},
+		"vote with invalid option": {
+			sender: addrs[0],
+			input: CosmosMsg{
+				Vote: &VoteMsg{
+					ProposalID: 1,
+					Option:     "Yes",
+				},
+			},
+			isError: true,
+		},
+		"deposit": {
+			sender: addrs[0],
+			input: CosmosMsg{
+				Deposit: &DepositMsg{
+					ProposalID: 2,
+					Amount:     wasmTypes.Coins{wasmTypes.NewCoin(1000, "uluna")},
+				},
+			},
+			output: []sdk.Msg{
+				gov.NewMsgDeposit(addrs[0], 2, sdk.NewCoins(sdk.NewInt64Coin("uluna", 1000))),
+			},
+		},
+		"deposit with invalid amount": {
+			sender: addrs[0],
+			input: CosmosMsg{
+				Deposit: &DepositMsg{
+					ProposalID: 2,
+					Amount:     wasmTypes.Coins{{Denom: "uluna", Amount: "-1"}},
+				},
+			},
+			isError: true,
+		},
+		"empty msg": {
+			sender:  addrs[0],
+			input:   CosmosMsg{},
+			isError: true,
+		},
+	}
+
+	parser := NewWasmMsgParser()
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			bz, err := json.Marshal(tc.input)
+			require.NoError(t, err)
+
+			res, err := parser.ParseCustom(tc.sender, bz)
+			if tc.isError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.output, res)
+			}
+		})
+	}
+}
+
+func TestVoteOption(t *testing.T) {
+	for _, option := range []gov.VoteOption{gov.OptionYes, gov.OptionAbstain, gov.OptionNo, gov.OptionNoWithVeto} {
+		parsed, err := parseVoteOption(encodeVoteOption(option))
+		require.NoError(t, err)
+		require.Equal(t, option, parsed)
+	}
+
+	_, err := parseVoteOption("")
+	require.Error(t, err)
+}
@@ -23,20 +23,23 @@ const (
 
 var (
 	// functions aliases
-	RegisterCodec       = types.RegisterCodec
-	ErrNoEffectivePrice = types.ErrNoEffectivePrice
-	ErrInvalidOfferCoin = types.ErrInvalidOfferCoin
-	ErrRecursiveSwap    = types.ErrRecursiveSwap
-	NewGenesisState     = types.NewGenesisState
-	DefaultGenesisState = types.DefaultGenesisState
-	ValidateGenesis     = types.ValidateGenesis
-	NewMsgSwap          = types.NewMsgSwap
-	NewMsgSwapSend      = types.NewMsgSwapSend
-	DefaultParams       = types.DefaultParams
-	NewQuerySwapParams  = types.NewQuerySwapParams
-	ParamKeyTable       = types.ParamKeyTable
-	NewKeeper           = keeper.NewKeeper
-	NewQuerier          = keeper.NewQuerier
+	RegisterCodec           = types.RegisterCodec
+	ErrNoEffectivePrice     = types.ErrNoEffectivePrice
+	ErrInvalidOfferCoin     = types.ErrInvalidOfferCoin
+	ErrRecursiveSwap        = types.ErrRecursiveSwap
+	NewGenesisState         = types.NewGenesisState
+	DefaultGenesisState     = types.DefaultGenesisState
+	ValidateGenesis         = types.ValidateGenesis
+	NewMsgSwap              = types.NewMsgSwap
+	NewMsgSwapSend          = types.NewMsgSwapSend
+	NewSwapAuthorization    = types.NewSwapAuthorization
+	DefaultParams           = types.DefaultParams
+	NewQuerySwapParams      = types.NewQuerySwapParams
+	ParamKeyTable           = types.ParamKeyTable
+	NewKeeper               = keeper.NewKeeper
+	NewQuerier              = keeper.NewQuerier
+	RegisterInvariants      = keeper.RegisterInvariants
+	TerraPoolDeltaInvariant = keeper.TerraPoolDeltaInvariant
 
 	// variable aliases
 	ModuleCdc                       = types.ModuleCdc
@@ -50,12 +53,13 @@ var (
 )
 
 type (
-	SupplyKeeper    = types.SupplyKeeper
-	OracleKeeper    = types.OracleKeeper
-	GenesisState    = types.GenesisState
-	MsgSwap         = types.MsgSwap
-	MsgSwapSend     = types.MsgSwapSend
-	Params          = types.Params
-	QuerySwapParams = types.QuerySwapParams
-	Keeper          = keeper.Keeper
+	SupplyKeeper      = types.SupplyKeeper
+	OracleKeeper      = types.OracleKeeper
+	GenesisState      = types.GenesisState
+	MsgSwap           = types.MsgSwap
+	MsgSwapSend       = types.MsgSwapSend
+	SwapAuthorization = types.SwapAuthorization
+	Params            = types.Params
+	QuerySwapParams   = types.QuerySwapParams
+	Keeper            = keeper.Keeper
 )
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/market/internal/types"
+)
+
+// RegisterInvariants registers all market invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "terra-pool-delta", TerraPoolDeltaInvariant(k))
+}
+
+// TerraPoolDeltaInvariant checks that the terra pool delta keeps the terra pool,
+// BasePool + delta, positive; the constant product swap divides by the terra pool,
+// and the luna pool is BasePool^2 / terra pool
+func TerraPoolDeltaInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		basePool := k.BasePool(ctx)
+		delta := k.GetTerraPoolDelta(ctx)
+
+		var msg string
+		broken := !basePool.Add(delta).IsPositive()
+		if broken {
+			msg = fmt.Sprintf("\tterra pool delta %s is not greater than -%s of the base pool\n", delta, basePool)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "terra pool delta", msg), broken
+	}
+}
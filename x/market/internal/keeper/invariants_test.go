@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTerraPoolDeltaInvariant(t *testing.T) {
+	input := CreateTestInput(t)
+	invariant := TerraPoolDeltaInvariant(input.MarketKeeper)
+	basePool := input.MarketKeeper.BasePool(input.Ctx)
+
+	_, broken := invariant(input.Ctx)
+	require.False(t, broken)
+
+	input.MarketKeeper.SetTerraPoolDelta(input.Ctx, basePool.MulInt64(2))
+	_, broken = invariant(input.Ctx)
+	require.False(t, broken)
+
+	input.MarketKeeper.SetTerraPoolDelta(input.Ctx, basePool.QuoInt64(2).Neg())
+	_, broken = invariant(input.Ctx)
+	require.False(t, broken)
+
+	// an empty terra pool
+	input.MarketKeeper.SetTerraPoolDelta(input.Ctx, basePool.Neg())
+	_, broken = invariant(input.Ctx)
+	require.True(t, broken)
+}
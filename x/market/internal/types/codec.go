@@ -13,10 +13,12 @@ var ModuleCdc = codec.New()
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSwap{}, "market/MsgSwap", nil)
 	cdc.RegisterConcrete(MsgSwapSend{}, "market/MsgSwapSend", nil)
+	cdc.RegisterConcrete(SwapAuthorization{}, "market/SwapAuthorization", nil)
 }
 
 func init() {
 	RegisterCodec(ModuleCdc)
 
 	msgauthexported.RegisterMsgAuthTypeCodec(MsgSwap{}, "market/MsgSwap")
+	msgauthexported.RegisterMsgAuthTypeCodec(SwapAuthorization{}, "market/SwapAuthorization")
 }
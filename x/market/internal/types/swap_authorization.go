@@ -0,0 +1,85 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	msgauthexported "github.com/terra-project/core/x/msgauth/exported"
+)
+
+var _ msgauthexported.Authorization = SwapAuthorization{}
+
+// SwapAuthorization grants the permission to swap the granter's coins
+// between the allowed denoms up to the offer limit
+type SwapAuthorization struct {
+	// AllowedOfferDenoms specifies the denoms which can be offered.
+	// If it is empty, any denom can be offered.
+	AllowedOfferDenoms []string `json:"allowed_offer_denoms"`
+
+	// AllowedAskDenoms specifies the denoms which can be asked.
+	// If it is empty, any denom can be asked.
+	AllowedAskDenoms []string `json:"allowed_ask_denoms"`
+
+	// OfferLimit specifies the maximum amount of coins that can be offered
+	// by this authorization and will be updated as coins are swapped. If it is
+	// empty, there is no offer limit and any amount of coins can be offered.
+	OfferLimit sdk.Coins `json:"offer_limit"`
+}
+
+// NewSwapAuthorization returns new SwapAuthorization instance
+func NewSwapAuthorization(allowedOfferDenoms []string, allowedAskDenoms []string, offerLimit sdk.Coins) SwapAuthorization {
+	return SwapAuthorization{
+		AllowedOfferDenoms: allowedOfferDenoms,
+		AllowedAskDenoms:   allowedAskDenoms,
+		OfferLimit:         offerLimit,
+	}
+}
+
+// MsgType implement msgauth Authorization
+func (authorization SwapAuthorization) MsgType() string {
+	return MsgSwap{}.Type()
+}
+
+// Accept implement msgauth Authorization
+func (authorization SwapAuthorization) Accept(msg sdk.Msg, block abci.Header) (allow bool, updated msgauthexported.Authorization, delete bool) {
+	switch msg := msg.(type) {
+	case MsgSwap:
+		if !isAllowedDenom(authorization.AllowedOfferDenoms, msg.OfferCoin.Denom) ||
+			!isAllowedDenom(authorization.AllowedAskDenoms, msg.AskDenom) {
+			return false, nil, false
+		}
+
+		if authorization.OfferLimit.Empty() {
+			return true, authorization, false
+		}
+
+		limitLeft, isNegative := authorization.OfferLimit.SafeSub(sdk.NewCoins(msg.OfferCoin))
+		if isNegative {
+			return false, nil, false
+		}
+		if limitLeft.IsZero() {
+			return true, nil, true
+		}
+
+		return true, SwapAuthorization{
+			AllowedOfferDenoms: authorization.AllowedOfferDenoms,
+			AllowedAskDenoms:   authorization.AllowedAskDenoms,
+			OfferLimit:         limitLeft,
+		}, false
+	}
+	return false, nil, false
+}
+
+func isAllowedDenom(allowedDenoms []string, denom string) bool {
+	if len(allowedDenoms) == 0 {
+		return true
+	}
+
+	for _, allowed := range allowedDenoms {
+		if allowed == denom {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	core "github.com/terra-project/core/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mock"
+)
+
+func TestSwapAuthorization(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+
+	authorization := NewSwapAuthorization(
+		[]string{core.MicroSDRDenom},
+		[]string{core.MicroLunaDenom},
+		sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 100)),
+	)
+	require.Equal(t, "swap", authorization.MsgType())
+
+	// not allowed offer denom
+	allow, _, _ := authorization.Accept(NewMsgSwap(addrs[0], sdk.NewInt64Coin(core.MicroKRWDenom, 10), core.MicroLunaDenom), abci.Header{})
+	require.False(t, allow)
+
+	// not allowed ask denom
+	allow, _, _ = authorization.Accept(NewMsgSwap(addrs[0], sdk.NewInt64Coin(core.MicroSDRDenom, 10), core.MicroKRWDenom), abci.Header{})
+	require.False(t, allow)
+
+	// exceeds the offer limit
+	allow, _, _ = authorization.Accept(NewMsgSwap(addrs[0], sdk.NewInt64Coin(core.MicroSDRDenom, 101), core.MicroLunaDenom), abci.Header{})
+	require.False(t, allow)
+
+	// offer limit is updated
+	allow, updated, del := authorization.Accept(NewMsgSwap(addrs[0], sdk.NewInt64Coin(core.MicroSDRDenom, 30), core.MicroLunaDenom), abci.Header{})
+	require.True(t, allow)
+	require.False(t, del)
+	require.Equal(t, NewSwapAuthorization(
+		[]string{core.MicroSDRDenom},
+		[]string{core.MicroLunaDenom},
+		sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 70)),
+	), updated)
+
+	// offer limit is used up
+	allow, _, del = updated.Accept(NewMsgSwap(addrs[0], sdk.NewInt64Coin(core.MicroSDRDenom, 70), core.MicroLunaDenom), abci.Header{})
+	require.True(t, allow)
+	require.True(t, del)
+
+	// no denom restriction and no offer limit
+	authorization = NewSwapAuthorization(nil, nil, nil)
+	allow, updated, del = authorization.Accept(NewMsgSwap(addrs[0], sdk.NewInt64Coin(core.MicroKRWDenom, 1000), core.MicroSDRDenom), abci.Header{})
+	require.True(t, allow)
+	require.False(t, del)
+	require.Equal(t, authorization, updated)
+
+	// other msg type
+	allow, _, _ = authorization.Accept(NewMsgSwapSend(addrs[0], addrs[0], sdk.NewInt64Coin(core.MicroKRWDenom, 1000), core.MicroSDRDenom), abci.Header{})
+	require.False(t, allow)
+}
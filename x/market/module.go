@@ -96,8 +96,10 @@ func NewAppModule(keeper Keeper, accountKeeper auth.AccountKeeper, oracleKeeper
 // Name returns the market module's name.
 func (AppModule) Name() string { return ModuleName }
 
-// RegisterInvariants performs a no-op.
-func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+// RegisterInvariants registers the market module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, am.keeper)
+}
 
 // Route returns the message routing key for the market module.
 func (AppModule) Route() string { return RouterKey }
@@ -14,6 +14,7 @@ const (
 	EventGrantAuthorization    = types.EventGrantAuthorization
 	EventRevokeAuthorization   = types.EventRevokeAuthorization
 	EventExecuteAuthorization  = types.EventExecuteAuthorization
+	EventExecuteAuthorizedMsg  = types.EventExecuteAuthorizedMsg
 	AttributeKeyGrantType      = types.AttributeKeyGrantType
 	AttributeKeyGranteeAddress = types.AttributeKeyGranteeAddress
 	AttributeKeyGranterAddress = types.AttributeKeyGranterAddress
@@ -24,15 +25,23 @@ const (
 	QuerierRoute               = types.QuerierRoute
 	QueryGrant                 = types.QueryGrant
 	QueryGrants                = types.QueryGrants
+	QueryParameters            = types.QueryParameters
+	DefaultParamspace          = types.DefaultParamspace
+	DefaultMaxExecDepth        = types.DefaultMaxExecDepth
+	NestedExecGasCost          = types.NestedExecGasCost
 )
 
 var (
 	// functions aliases
 	NewKeeper                    = keeper.NewKeeper
+	NewMigrator                  = keeper.NewMigrator
 	NewQuerier                   = keeper.NewQuerier
+	RegisterInvariants           = keeper.RegisterInvariants
+	GrantQueueInvariant          = keeper.GrantQueueInvariant
 	SetupTestInput               = keeper.SetupTestInput
 	NewAuthorizationGrant        = types.NewAuthorizationGrant
 	RegisterCodec                = types.RegisterCodec
+	NewDelegateAuthorization     = types.NewDelegateAuthorization
 	NewGenericAuthorization      = types.NewGenericAuthorization
 	NewGenesisState              = types.NewGenesisState
 	DefaultGenesisState          = types.DefaultGenesisState
@@ -46,26 +55,35 @@ var (
 	NewQueryGrantParams          = types.NewQueryGrantParams
 	NewQueryGrantsParams         = types.NewQueryGrantsParams
 	NewSendAuthorization         = types.NewSendAuthorization
+	NewPeriodicSendAuthorization = types.NewPeriodicSendAuthorization
+	DefaultParams                = types.DefaultParams
+	ParamKeyTable                = types.ParamKeyTable
 
 	// variable aliases
-	ModuleCdc        = types.ModuleCdc
-	ErrInvalidPeriod = types.ErrInvalidPeriod
-	GrantKey         = types.GrantKey
-	GrantQueueKey    = types.GrantQueueKey
+	ModuleCdc                 = types.ModuleCdc
+	ErrInvalidPeriod          = types.ErrInvalidPeriod
+	ErrTooDeepExecution       = types.ErrTooDeepExecution
+	GrantKey                  = types.GrantKey
+	GrantQueueKey             = types.GrantQueueKey
+	ParamStoreKeyMaxExecDepth = types.ParamStoreKeyMaxExecDepth
 )
 
 type (
-	Keeper                 = keeper.Keeper
-	Authorization          = types.Authorization
-	AuthorizationGrant     = types.AuthorizationGrant
-	GGMPair                = types.GGMPair
-	GenericAuthorization   = types.GenericAuthorization
-	AuthorizationEntry     = types.AuthorizationEntry
-	GenesisState           = types.GenesisState
-	MsgGrantAuthorization  = types.MsgGrantAuthorization
-	MsgRevokeAuthorization = types.MsgRevokeAuthorization
-	MsgExecAuthorized      = types.MsgExecAuthorized
-	QueryGrantParams       = types.QueryGrantParams
-	QueryGrantsParams      = types.QueryGrantsParams
-	SendAuthorization      = types.SendAuthorization
+	Keeper                    = keeper.Keeper
+	Migrator                  = keeper.Migrator
+	Authorization             = types.Authorization
+	AuthorizationGrant        = types.AuthorizationGrant
+	GGMPair                   = types.GGMPair
+	DelegateAuthorization     = types.DelegateAuthorization
+	GenericAuthorization      = types.GenericAuthorization
+	AuthorizationEntry        = types.AuthorizationEntry
+	GenesisState              = types.GenesisState
+	MsgGrantAuthorization     = types.MsgGrantAuthorization
+	MsgRevokeAuthorization    = types.MsgRevokeAuthorization
+	MsgExecAuthorized         = types.MsgExecAuthorized
+	QueryGrantParams          = types.QueryGrantParams
+	QueryGrantsParams         = types.QueryGrantsParams
+	Params                    = types.Params
+	SendAuthorization         = types.SendAuthorization
+	PeriodicSendAuthorization = types.PeriodicSendAuthorization
 )
@@ -28,6 +28,7 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	authorizationQueryCmd.AddCommand(flags.GetCommands(
 		GetCmdQueryGrant(queryRoute, cdc),
 		GetCmdQueryGrants(queryRoute, cdc),
+		GetCmdQueryParams(queryRoute, cdc),
 	)...)
 
 	return authorizationQueryCmd
@@ -126,3 +127,26 @@ $ terracli query msgauth grants terra... terra...
 		},
 	}
 }
+
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the current msgauth params",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryParameters), nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			cdc.MustUnmarshalJSON(res, &params)
+			return cliCtx.PrintOutput(params)
+		},
+	}
+
+	return cmd
+}
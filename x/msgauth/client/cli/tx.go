@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"io/ioutil"
+	"strconv"
 	"strings"
 	"time"
 
@@ -19,11 +20,21 @@ import (
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 
 	feeutils "github.com/terra-project/core/x/auth/client/utils"
+	"github.com/terra-project/core/x/market"
 	"github.com/terra-project/core/x/msgauth/internal/types"
+	"github.com/terra-project/core/x/wasm"
 )
 
-// FlagPeriod is flag to specify grant period
-const FlagPeriod = "period"
+// nolint
+const (
+	FlagPeriod             = "period"
+	FlagAllowedValidators  = "allowed-validators"
+	FlagAllowedOfferDenoms = "allowed-offer-denoms"
+	FlagAllowedAskDenoms   = "allowed-ask-denoms"
+	FlagContract           = "contract"
+	FlagSpendPeriod        = "spend-period"
+	FlagCarryOver          = "carry-over"
+)
 
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd(storeKey string, cdc *codec.Codec) *cobra.Command {
@@ -55,9 +66,24 @@ to let the address execute a transaction on your behalf,
 
 $ terracli tx msgauth grant terra... send 1000000uluna,10000000ukrw --from [granter]
 
+The send limit can be refilled every spend period, optionally carrying over the unspent coins
+up to the send limit,
+
+$ terracli tx msgauth grant terra... send 1000000uluna --spend-period=86400 --carry-over --from [granter]
+
+Swap, delegate and execute_contract authorizations can be restricted further,
+
+$ terracli tx msgauth grant terra... swap 1000000ukrw --allowed-offer-denoms=ukrw --allowed-ask-denoms=uluna --from [granter]
+$ terracli tx msgauth grant terra... delegate 1000000uluna --allowed-validators=terravaloper... --from [granter]
+$ terracli tx msgauth grant terra... execute_contract --contract=terra...:1000000uluna:10 --contract=terra... --from [granter]
+
+The --contract flag has the form [contract-address]:[spend-limit]:[calls-limit];
+the spend limit and the calls limit can be omitted to disallow sending coins
+and to allow unlimited calls.
+
 Or, you can just give authorization of other msg types
 
-$ terracli tx msgauth grant terra... swap --from [granter]
+$ terracli tx msgauth grant terra... vote --from [granter]
 				`),
 		Args: cobra.RangeArgs(2, 3),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -71,18 +97,17 @@ $ terracli tx msgauth grant terra... swap --from [granter]
 				return err
 			}
 
-			msgType := args[1]
-
-			var authorization types.Authorization
-			if msgType == (types.SendAuthorization{}.MsgType()) {
-				limit, err := sdk.ParseCoins(args[2])
+			var limit sdk.Coins
+			if len(args) == 3 {
+				limit, err = sdk.ParseCoins(args[2])
 				if err != nil {
 					return err
 				}
+			}
 
-				authorization = types.NewSendAuthorization(limit)
-			} else {
-				authorization = types.NewGenericAuthorization(msgType)
+			authorization, err := buildAuthorization(cmd, args[1], limit)
+			if err != nil {
+				return err
 			}
 
 			period := time.Duration(viper.GetInt64(FlagPeriod)) * time.Second
@@ -98,10 +123,101 @@ $ terracli tx msgauth grant terra... swap --from [granter]
 	}
 
 	cmd.Flags().Int64(FlagPeriod, int64(3600*24*365), "The second unit of time duration which the authorization is active for the user; Default is a year")
+	cmd.Flags().Int64(FlagSpendPeriod, 0, "The second unit of time duration after which the send limit is refilled; Default is no refill")
+	cmd.Flags().Bool(FlagCarryOver, false, "Carry over the unspent coins of a spend period to the next period")
+	cmd.Flags().StringSlice(FlagAllowedValidators, []string{}, "Validators allowed for delegate authorization; Default is any validator")
+	cmd.Flags().StringSlice(FlagAllowedOfferDenoms, []string{}, "Offer denoms allowed for swap authorization; Default is any denom")
+	cmd.Flags().StringSlice(FlagAllowedAskDenoms, []string{}, "Ask denoms allowed for swap authorization; Default is any denom")
+	cmd.Flags().StringArray(FlagContract, []string{}, "Contract allowed for execute_contract authorization in [contract-address]:[spend-limit]:[calls-limit] form")
 
 	return cmd
 }
 
+func buildAuthorization(cmd *cobra.Command, msgType string, limit sdk.Coins) (types.Authorization, error) {
+	switch msgType {
+	case types.SendAuthorization{}.MsgType():
+		if spendPeriod := viper.GetInt64(FlagSpendPeriod); spendPeriod > 0 {
+			return types.NewPeriodicSendAuthorization(
+				time.Duration(spendPeriod)*time.Second, limit, viper.GetBool(FlagCarryOver)), nil
+		}
+
+		return types.NewSendAuthorization(limit), nil
+
+	case types.DelegateAuthorization{}.MsgType():
+		var validators []sdk.ValAddress
+		for _, val := range viper.GetStringSlice(FlagAllowedValidators) {
+			valAddr, err := sdk.ValAddressFromBech32(val)
+			if err != nil {
+				return nil, err
+			}
+
+			validators = append(validators, valAddr)
+		}
+
+		return types.NewDelegateAuthorization(validators, limit), nil
+
+	case market.SwapAuthorization{}.MsgType():
+		return market.NewSwapAuthorization(
+			viper.GetStringSlice(FlagAllowedOfferDenoms),
+			viper.GetStringSlice(FlagAllowedAskDenoms),
+			limit,
+		), nil
+
+	case wasm.ExecuteContractAuthorization{}.MsgType():
+		contractStrs, err := cmd.Flags().GetStringArray(FlagContract)
+		if err != nil {
+			return nil, err
+		}
+
+		var contracts []wasm.ContractAuthorization
+		for _, contractStr := range contractStrs {
+			contractAuth, err := parseContractAuthorization(contractStr)
+			if err != nil {
+				return nil, err
+			}
+
+			contracts = append(contracts, contractAuth)
+		}
+
+		if len(contracts) == 0 {
+			return nil, fmt.Errorf("at least one --%s must be given", FlagContract)
+		}
+
+		return wasm.NewExecuteContractAuthorization(contracts), nil
+	}
+
+	return types.NewGenericAuthorization(msgType), nil
+}
+
+// parseContractAuthorization parses [contract-address]:[spend-limit]:[calls-limit] form string
+func parseContractAuthorization(contractStr string) (contractAuth wasm.ContractAuthorization, err error) {
+	parts := strings.Split(contractStr, ":")
+	if len(parts) > 3 {
+		return contractAuth, fmt.Errorf("invalid contract authorization: %s", contractStr)
+	}
+
+	contractAuth.Contract, err = sdk.AccAddressFromBech32(parts[0])
+	if err != nil {
+		return contractAuth, err
+	}
+
+	if len(parts) > 1 && len(parts[1]) != 0 {
+		contractAuth.SpendLimit, err = sdk.ParseCoins(parts[1])
+		if err != nil {
+			return contractAuth, err
+		}
+	}
+
+	if len(parts) > 2 && len(parts[2]) != 0 {
+		contractAuth.CallsLimit, err = strconv.ParseUint(parts[2], 10, 64)
+		if err != nil {
+			return contractAuth, err
+		}
+	}
+
+	return contractAuth, nil
+}
+
 func GetCmdRevokeAuthorization(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "revoke [grantee_address] [msg_type]",
@@ -7,6 +7,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/rest"
 	"github.com/gorilla/mux"
+
+	"github.com/terra-project/core/x/wasm"
 )
 
 //nolint
@@ -27,6 +29,20 @@ type GrantRequest struct {
 	BaseReq rest.BaseReq  `json:"base_req" yaml:"base_req"`
 	Period  time.Duration `json:"period"`
 	Limit   sdk.Coins     `json:"limit,omitempty"`
+
+	// SpendPeriod and CarryOver are used for periodic send authorization
+	SpendPeriod time.Duration `json:"spend_period,omitempty"`
+	CarryOver   bool          `json:"carry_over,omitempty"`
+
+	// AllowedValidators is used for delegate authorization
+	AllowedValidators []sdk.ValAddress `json:"allowed_validators,omitempty"`
+
+	// AllowedOfferDenoms and AllowedAskDenoms are used for swap authorization
+	AllowedOfferDenoms []string `json:"allowed_offer_denoms,omitempty"`
+	AllowedAskDenoms   []string `json:"allowed_ask_denoms,omitempty"`
+
+	// Contracts is used for execute_contract authorization
+	Contracts []wasm.ContractAuthorization `json:"contracts,omitempty"`
 }
 
 // RevokeRequest defines the properties of a revoke request's body.
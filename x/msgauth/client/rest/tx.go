@@ -14,7 +14,9 @@ import (
 	authclient "github.com/cosmos/cosmos-sdk/x/auth/client/utils"
 
 	feeutils "github.com/terra-project/core/x/auth/client/utils"
+	"github.com/terra-project/core/x/market"
 	"github.com/terra-project/core/x/msgauth/internal/types"
+	"github.com/terra-project/core/x/wasm"
 )
 
 func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
@@ -54,9 +56,25 @@ func grantHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		var authorization types.Authorization
-		if msgType == (types.SendAuthorization{}.MsgType()) {
-			authorization = types.NewSendAuthorization(req.Limit)
-		} else {
+		switch msgType {
+		case types.SendAuthorization{}.MsgType():
+			if req.SpendPeriod > 0 {
+				authorization = types.NewPeriodicSendAuthorization(req.SpendPeriod, req.Limit, req.CarryOver)
+			} else {
+				authorization = types.NewSendAuthorization(req.Limit)
+			}
+		case types.DelegateAuthorization{}.MsgType():
+			authorization = types.NewDelegateAuthorization(req.AllowedValidators, req.Limit)
+		case market.SwapAuthorization{}.MsgType():
+			authorization = market.NewSwapAuthorization(req.AllowedOfferDenoms, req.AllowedAskDenoms, req.Limit)
+		case wasm.ExecuteContractAuthorization{}.MsgType():
+			if len(req.Contracts) == 0 {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, "at least one contract must be given")
+				return
+			}
+
+			authorization = wasm.NewExecuteContractAuthorization(req.Contracts)
+		default:
 			authorization = types.NewGenericAuthorization(msgType)
 		}
 
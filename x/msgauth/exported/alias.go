@@ -28,6 +28,7 @@ type Keeper interface {
 var RegisterMsgAuthTypeCodec = types.RegisterMsgAuthTypeCodec
 
 type (
+	Authorization          = types.Authorization
 	MsgGrantAuthorization  = types.MsgGrantAuthorization
 	MsgRevokeAuthorization = types.MsgRevokeAuthorization
 	MsgExecAuthorized      = types.MsgExecAuthorized
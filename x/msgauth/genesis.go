@@ -6,6 +6,14 @@ import (
 
 // InitGenesis register all exported authorization entries
 func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
+	// the genesis exported before the params has none
+	params := data.Params
+	if params == (Params{}) {
+		params = DefaultParams()
+	}
+
+	keeper.SetParams(ctx, params)
+
 	for _, entry := range data.AuthorizationEntries {
 		keeper.SetGrant(ctx, entry.Granter, entry.Grantee, AuthorizationGrant{
 			Authorization: entry.Authorization,
@@ -32,5 +40,5 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) (data GenesisState) {
 		return false
 	})
 
-	return NewGenesisState(entries)
+	return NewGenesisState(keeper.GetParams(ctx), entries)
 }
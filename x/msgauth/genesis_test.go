@@ -28,3 +28,12 @@ func (s *TestSuite) TestGenesisExportImport() {
 
 	s.Require().Equal(genesis, newGenesis)
 }
+
+func (s *TestSuite) TestInitGenesisWithoutParams() {
+	var genesis GenesisState
+	s.Require().NoError(ModuleCdc.UnmarshalJSON([]byte(`{"authorization_entries":[]}`), &genesis))
+	s.Require().NoError(ValidateGenesis(genesis))
+
+	InitGenesis(s.ctx, s.keeper, genesis)
+	s.Require().Equal(DefaultParams(), s.keeper.GetParams(s.ctx))
+}
@@ -29,6 +29,11 @@ func handleMsgGrantAuthorization(ctx sdk.Context, msg MsgGrantAuthorization, k K
 		return nil, sdkerrors.Wrapf(types.ErrInvalidMsgType, "Msg %s is not allowed to grant", msg.Authorization.MsgType())
 	}
 
+	// the existing grant of the msg type is replaced, so it must not expire the new one
+	if grant, found := k.GetGrant(ctx, msg.Granter, msg.Grantee, msg.Authorization.MsgType()); found {
+		k.RevokeFromGrantQueue(ctx, msg.Granter, msg.Grantee, msg.Authorization.MsgType(), grant.Expiration)
+	}
+
 	k.SetGrant(ctx, msg.Granter, msg.Grantee, NewAuthorizationGrant(msg.Authorization, expiration))
 	k.InsertGrantQueue(ctx, msg.Granter, msg.Grantee, msg.Authorization.MsgType(), expiration)
 
@@ -76,6 +76,25 @@ func (s *TestSuite) TestGrant() {
 	s.Require().Error(err)
 }
 
+func (s *TestSuite) TestRegrant() {
+	coins := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1_000_000_000)))
+	sendAuth := types.SendAuthorization{SpendLimit: coins}
+
+	_, err := s.handler(s.ctx, types.NewMsgGrantAuthorization(granterAddr, granteeAddr, sendAuth, time.Hour))
+	s.Require().NoError(err)
+
+	// the new grant replaces the queued expiration of the previous one
+	_, err = s.handler(s.ctx, types.NewMsgGrantAuthorization(granterAddr, granteeAddr, sendAuth, 2*time.Hour))
+	s.Require().NoError(err)
+
+	s.Require().Empty(s.keeper.GetGrantQueueTimeSlice(s.ctx, s.ctx.BlockTime().Add(time.Hour)))
+	s.Require().Len(s.keeper.GetGrantQueueTimeSlice(s.ctx, s.ctx.BlockTime().Add(2*time.Hour)), 1)
+
+	EndBlocker(s.ctx.WithBlockTime(s.ctx.BlockTime().Add(time.Hour)), s.keeper)
+	_, found := s.keeper.GetGrant(s.ctx, granterAddr, granteeAddr, sendAuth.MsgType())
+	s.Require().True(found)
+}
+
 func (s *TestSuite) TestRevoke() {
 	coins := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(1_000_000_000)))
 	grantMsg := types.NewMsgGrantAuthorization(granterAddr, granteeAddr, types.SendAuthorization{
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/msgauth/internal/types"
+)
+
+// RegisterInvariants registers all msgauth invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "grant-queue", GrantQueueInvariant(k))
+}
+
+// GrantQueueInvariant checks that every queued grant has a stored grant expiring
+// at the time of its timeslice, and every stored grant is queued exactly once
+func GrantQueueInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var broken int
+
+		queued := make(map[string]bool)
+		k.IterateGrantQueue(ctx, func(expiration time.Time, ggmPairs []types.GGMPair) bool {
+			for _, pair := range ggmPairs {
+				key := string(types.GetGrantKey(pair.GranterAddress, pair.GranteeAddress, pair.MsgType))
+				grant, found := k.GetGrant(ctx, pair.GranterAddress, pair.GranteeAddress, pair.MsgType)
+
+				switch {
+				case queued[key]:
+					msg += fmt.Sprintf("\t%s grant from %s to %s is queued more than once\n",
+						pair.MsgType, pair.GranterAddress, pair.GranteeAddress)
+					broken++
+				case !found:
+					msg += fmt.Sprintf("\t%s grant from %s to %s is queued at %s but not found\n",
+						pair.MsgType, pair.GranterAddress, pair.GranteeAddress, expiration)
+					broken++
+				case !grant.Expiration.Equal(expiration):
+					msg += fmt.Sprintf("\t%s grant from %s to %s is queued at %s but expires at %s\n",
+						pair.MsgType, pair.GranterAddress, pair.GranteeAddress, expiration, grant.Expiration)
+					broken++
+				}
+
+				queued[key] = true
+			}
+
+			return false
+		})
+
+		k.IterateGrants(ctx, func(granterAddr, granteeAddr sdk.AccAddress, grant types.AuthorizationGrant) bool {
+			msgType := grant.Authorization.MsgType()
+			if !queued[string(types.GetGrantKey(granterAddr, granteeAddr, msgType))] {
+				msg += fmt.Sprintf("\t%s grant from %s to %s expiring at %s is not queued\n",
+					msgType, granterAddr, granteeAddr, grant.Expiration)
+				broken++
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "grant queue",
+			fmt.Sprintf("found %d grants not matching the grant queue\n%s", broken, msg)), broken != 0
+	}
+}
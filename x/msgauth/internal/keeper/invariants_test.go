@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/x/bank"
+
+	"github.com/terra-project/core/x/msgauth/internal/types"
+)
+
+func (s *TestSuite) TestGrantQueueInvariant() {
+	now := s.ctx.BlockTime()
+	invariant := GrantQueueInvariant(s.keeper)
+
+	_, broken := invariant(s.ctx)
+	s.Require().False(broken)
+
+	grant := types.NewAuthorizationGrant(types.NewGenericAuthorization(bank.MsgSend{}.Type()), now.Add(time.Hour))
+	s.keeper.SetGrant(s.ctx, granterAddr, granteeAddr, grant)
+
+	s.T().Log("verify a grant not queued breaks the invariant")
+	_, broken = invariant(s.ctx)
+	s.Require().True(broken)
+
+	s.keeper.InsertGrantQueue(s.ctx, granterAddr, granteeAddr, bank.MsgSend{}.Type(), now.Add(time.Hour))
+	_, broken = invariant(s.ctx)
+	s.Require().False(broken)
+
+	s.T().Log("verify a grant queued at another time breaks the invariant")
+	s.keeper.InsertGrantQueue(s.ctx, granterAddr, granteeAddr, bank.MsgSend{}.Type(), now.Add(time.Minute))
+	_, broken = invariant(s.ctx)
+	s.Require().True(broken)
+
+	s.keeper.RevokeFromGrantQueue(s.ctx, granterAddr, granteeAddr, bank.MsgSend{}.Type(), now.Add(time.Minute))
+	_, broken = invariant(s.ctx)
+	s.Require().False(broken)
+
+	s.T().Log("verify a queued grant not found breaks the invariant")
+	s.keeper.RevokeGrant(s.ctx, granterAddr, granteeAddr, bank.MsgSend{}.Type())
+	_, broken = invariant(s.ctx)
+	s.Require().True(broken)
+}
+
+func (s *TestSuite) TestMigrate1to2GrantQueue() {
+	now := s.ctx.BlockTime()
+	invariant := GrantQueueInvariant(s.keeper)
+
+	// a regrant of version 1 leaves the entry of the previous grant in the queue
+	grant := types.NewAuthorizationGrant(types.NewGenericAuthorization(bank.MsgSend{}.Type()), now.Add(time.Hour))
+	s.keeper.SetGrant(s.ctx, granterAddr, granteeAddr, grant)
+	s.keeper.InsertGrantQueue(s.ctx, granterAddr, granteeAddr, bank.MsgSend{}.Type(), now.Add(time.Minute))
+	s.keeper.InsertGrantQueue(s.ctx, granterAddr, granteeAddr, bank.MsgSend{}.Type(), now.Add(time.Hour))
+	s.keeper.InsertGrantQueue(s.ctx, granterAddr, granteeAddr, bank.MsgSend{}.Type(), now.Add(time.Hour))
+
+	// a revoked grant of version 1 leaves its entry too
+	s.keeper.InsertGrantQueue(s.ctx, granterAddr, recipientAddr, bank.MsgSend{}.Type(), now.Add(time.Hour))
+
+	_, broken := invariant(s.ctx)
+	s.Require().True(broken)
+
+	s.Require().NoError(NewMigrator(s.keeper).Migrate1to2(s.ctx))
+	_, broken = invariant(s.ctx)
+	s.Require().False(broken)
+
+	s.Require().Empty(s.keeper.GetGrantQueueTimeSlice(s.ctx, now.Add(time.Minute)))
+	s.Require().Equal([]types.GGMPair{{GranterAddress: granterAddr, GranteeAddress: granteeAddr, MsgType: bank.MsgSend{}.Type()}},
+		s.keeper.GetGrantQueueTimeSlice(s.ctx, now.Add(time.Hour)))
+}
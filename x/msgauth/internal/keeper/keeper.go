@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
 
 	"github.com/terra-project/core/x/msgauth/internal/types"
 )
@@ -15,15 +16,22 @@ import (
 type Keeper struct {
 	cdc             *codec.Codec
 	storeKey        sdk.StoreKey
+	paramSpace      params.Subspace
 	router          sdk.Router
 	allowedMsgTypes []string
 }
 
 // NewKeeper constructs a message authorisation Keeper
-func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, router sdk.Router, allowedMsgTypes ...string) Keeper {
+func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramspace params.Subspace, router sdk.Router, allowedMsgTypes ...string) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramspace.HasKeyTable() {
+		paramspace = paramspace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
 		cdc:             cdc,
 		storeKey:        storeKey,
+		paramSpace:      paramspace,
 		router:          router,
 		allowedMsgTypes: allowedMsgTypes,
 	}
@@ -67,45 +75,88 @@ func (k Keeper) GetGrants(ctx sdk.Context, granterAddr sdk.AccAddress, granteeAd
 }
 
 // DispatchActions attempts to execute the provided messages via authorization
-// grants from the message signer to the grantee.
+// grants from the message signers to the grantee.
 func (k Keeper) DispatchActions(ctx sdk.Context, granteeAddr sdk.AccAddress, msgs []sdk.Msg) error {
+	return k.dispatchActions(ctx, granteeAddr, msgs, 0)
+}
+
+func (k Keeper) dispatchActions(ctx sdk.Context, granteeAddr sdk.AccAddress, msgs []sdk.Msg, depth uint64) error {
 	for _, msg := range msgs {
+		// every signer except the grantee itself must have granted the grantee
 		signers := msg.GetSigners()
-		if len(signers) != 1 {
-			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "authorization can be given to msg with only one signer")
+		for _, granterAddr := range signers {
+			if bytes.Equal(granterAddr, granteeAddr) {
+				continue
+			}
+
+			if err := k.acceptGrant(ctx, granterAddr, granteeAddr, msg); err != nil {
+				return err
+			}
 		}
-		granterAddr := signers[0]
-		if !bytes.Equal(granterAddr, granteeAddr) {
-			grant, found := k.GetGrant(ctx, granterAddr, granteeAddr, msg.Type())
-			if !found {
-				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "authorization not found")
+
+		if execMsg, ok := msg.(types.MsgExecAuthorized); ok {
+			if maxExecDepth := k.MaxExecDepth(ctx); depth >= maxExecDepth {
+				return sdkerrors.Wrapf(types.ErrTooDeepExecution, "max depth %d", maxExecDepth)
 			}
 
-			allow, updated, del := grant.Authorization.Accept(msg, ctx.BlockHeader())
-			if !allow {
-				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "authorization not found")
+			// charge gas for each nested hop
+			ctx.GasMeter().ConsumeGas(types.NestedExecGasCost, "nested exec authorized")
+
+			if err := k.dispatchActions(ctx, execMsg.Grantee, execMsg.Msgs, depth+1); err != nil {
+				return err
+			}
+		} else {
+			handler := k.router.Route(ctx, msg.Route())
+			if handler == nil {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", msg.Route())
 			}
 
-			if del {
-				k.RevokeGrant(ctx, granterAddr, granteeAddr, msg.Type())
-				k.RevokeFromGrantQueue(ctx, granterAddr, granteeAddr, msg.Type(), grant.Expiration)
-			} else if updated != nil {
-				grant.Authorization = updated
-				k.SetGrant(ctx, granterAddr, granteeAddr, grant)
+			res, err := handler(ctx, msg)
+			if err != nil {
+				return sdkerrors.Wrapf(err, "failed to execute message; message %s", msg.Type())
 			}
-		}
 
-		handler := k.router.Route(ctx, msg.Route())
-		if handler == nil {
-			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized message route: %s", msg.Route())
+			ctx.EventManager().EmitEvents(res.Events)
 		}
 
-		res, err := handler(ctx, msg)
-		if err != nil {
-			return sdkerrors.Wrapf(err, "failed to execute message; message %s", msg.Type())
+		event := sdk.NewEvent(
+			types.EventExecuteAuthorizedMsg,
+			sdk.NewAttribute(types.AttributeKeyGrantType, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyGranteeAddress, granteeAddr.String()),
+		)
+		for _, granterAddr := range signers {
+			if bytes.Equal(granterAddr, granteeAddr) {
+				continue
+			}
+
+			event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyGranterAddress, granterAddr.String()))
 		}
 
-		ctx.EventManager().EmitEvents(res.Events)
+		ctx.EventManager().EmitEvent(event)
+	}
+
+	return nil
+}
+
+// acceptGrant checks the grant from the granter to the grantee accepts the msg
+// and updates or revokes the grant as the authorization requires
+func (k Keeper) acceptGrant(ctx sdk.Context, granterAddr sdk.AccAddress, granteeAddr sdk.AccAddress, msg sdk.Msg) error {
+	grant, found := k.GetGrant(ctx, granterAddr, granteeAddr, msg.Type())
+	if !found {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "authorization not found")
+	}
+
+	allow, updated, del := grant.Authorization.Accept(msg, ctx.BlockHeader())
+	if !allow {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "authorization not found")
+	}
+
+	if del {
+		k.RevokeGrant(ctx, granterAddr, granteeAddr, msg.Type())
+		k.RevokeFromGrantQueue(ctx, granterAddr, granteeAddr, msg.Type(), grant.Expiration)
+	} else if updated != nil {
+		grant.Authorization = updated
+		k.SetGrant(ctx, granterAddr, granteeAddr, grant)
 	}
 
 	return nil
@@ -203,6 +254,26 @@ func (k Keeper) GrantQueueIterator(ctx sdk.Context, endTime time.Time) sdk.Itera
 		sdk.InclusiveEndBytes(types.GetGrantTimeKey(endTime)))
 }
 
+// IterateGrantQueue iterates over all the grant queue timeslices
+func (k Keeper) IterateGrantQueue(ctx sdk.Context,
+	handler func(expiration time.Time, ggmPairs []types.GGMPair) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GrantQueueKey)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		expiration, err := sdk.ParseTimeBytes(iter.Key()[len(types.GrantQueueKey):])
+		if err != nil {
+			panic(err)
+		}
+
+		var ggmPairs []types.GGMPair
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &ggmPairs)
+		if handler(expiration, ggmPairs) {
+			break
+		}
+	}
+}
+
 // DequeueAllMatureGrantQueue returns a concatenated list of all the timeslices inclusively previous to
 // current block time, and deletes the timeslices from the queue
 func (k Keeper) DequeueAllMatureGrantQueue(ctx sdk.Context) (matureGrants []types.GGMPair) {
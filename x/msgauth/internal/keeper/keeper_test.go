@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+	"github.com/tendermint/tendermint/libs/kv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -162,6 +164,41 @@ func (s *TestSuite) TestKeeperFees() {
 	s.Require().False(found)
 }
 
+func (s *TestSuite) TestKeeperPeriodicFees() {
+	err := s.bankKeeper.SetCoins(s.ctx, granterAddr, sdk.NewCoins(sdk.NewInt64Coin("steak", 10000)))
+	s.Require().Nil(err)
+
+	now := s.ctx.BlockHeader().Time
+	periodLimit := sdk.NewCoins(sdk.NewInt64Coin("steak", 20))
+	authorization := types.NewPeriodicSendAuthorization(time.Hour, periodLimit, false)
+	s.keeper.SetGrant(s.ctx, granterAddr, granteeAddr, types.NewAuthorizationGrant(authorization, now.Add(time.Hour*24)))
+
+	msgs := []sdk.Msg{
+		bank.MsgSend{
+			Amount:      periodLimit,
+			FromAddress: granterAddr,
+			ToAddress:   recipientAddr,
+		},
+	}
+
+	s.T().Log("verify dispatch executes within the period limit")
+	err = s.keeper.DispatchActions(s.ctx, granteeAddr, msgs)
+	s.Require().NoError(err)
+
+	grant, found := s.keeper.GetGrant(s.ctx, granterAddr, granteeAddr, bank.MsgSend{}.Type())
+	s.Require().True(found)
+	s.Require().True(grant.Authorization.(types.PeriodicSendAuthorization).PeriodCanSpend.IsZero())
+
+	s.T().Log("verify dispatch fails in the same period")
+	err = s.keeper.DispatchActions(s.ctx.WithBlockTime(now.Add(time.Minute)), granteeAddr, msgs)
+	s.Require().Error(err)
+
+	s.T().Log("verify dispatch executes after the period reset")
+	err = s.keeper.DispatchActions(s.ctx.WithBlockTime(now.Add(time.Hour)), granteeAddr, msgs)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("steak", 40)), s.bankKeeper.GetCoins(s.ctx, recipientAddr))
+}
+
 func (s *TestSuite) TestGrantQueue() {
 	now := s.ctx.BlockTime()
 	s.keeper.InsertGrantQueue(s.ctx, granterAddr, granteeAddr, bank.MsgSend{}.Type(), now.Add(time.Hour))
@@ -201,3 +238,91 @@ func (s *TestSuite) TestGrantQueue() {
 func TestTestSuite(t *testing.T) {
 	suite.Run(t, new(TestSuite))
 }
+
+func (s *TestSuite) TestDispatchMultiSignerMsg() {
+	granter2Addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	coins := sdk.NewCoins(sdk.NewInt64Coin("steak", 10))
+	s.Require().NoError(s.bankKeeper.SetCoins(s.ctx, granterAddr, coins))
+	s.Require().NoError(s.bankKeeper.SetCoins(s.ctx, granter2Addr, coins))
+	s.Require().NoError(s.bankKeeper.SetCoins(s.ctx, granteeAddr, coins))
+
+	now := s.ctx.BlockHeader().Time
+	msg := bank.NewMsgMultiSend(
+		[]bank.Input{
+			bank.NewInput(granterAddr, coins),
+			bank.NewInput(granter2Addr, coins),
+			bank.NewInput(granteeAddr, coins),
+		},
+		[]bank.Output{bank.NewOutput(recipientAddr, coins.Add(coins...).Add(coins...))},
+	)
+
+	s.keeper.SetGrant(s.ctx, granterAddr, granteeAddr, types.NewAuthorizationGrant(types.NewGenericAuthorization(msg.Type()), now.Add(time.Hour)))
+
+	s.T().Log("verify dispatch fails without grants from every other signer")
+	err := s.keeper.DispatchActions(s.ctx, granteeAddr, []sdk.Msg{msg})
+	s.Require().Error(err)
+
+	s.keeper.SetGrant(s.ctx, granter2Addr, granteeAddr, types.NewAuthorizationGrant(types.NewGenericAuthorization(msg.Type()), now.Add(time.Hour)))
+
+	s.T().Log("verify dispatch executes with grants from every other signer")
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+	err = s.keeper.DispatchActions(ctx, granteeAddr, []sdk.Msg{msg})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("steak", 30)), s.bankKeeper.GetCoins(s.ctx, recipientAddr))
+
+	events := ctx.EventManager().Events()
+	event := events[len(events)-1]
+	s.Require().Equal(types.EventExecuteAuthorizedMsg, event.Type)
+	s.Require().Equal([]kv.Pair{
+		{Key: []byte(types.AttributeKeyGrantType), Value: []byte(msg.Type())},
+		{Key: []byte(types.AttributeKeyGranteeAddress), Value: []byte(granteeAddr.String())},
+		{Key: []byte(types.AttributeKeyGranterAddress), Value: []byte(granterAddr.String())},
+		{Key: []byte(types.AttributeKeyGranterAddress), Value: []byte(granter2Addr.String())},
+	}, event.Attributes)
+}
+
+func (s *TestSuite) TestDispatchNestedExec() {
+	coins := sdk.NewCoins(sdk.NewInt64Coin("steak", 10))
+	s.Require().NoError(s.bankKeeper.SetCoins(s.ctx, granterAddr, coins))
+
+	// granter -> grantee -> grantee2 -> ... delegation chain
+	now := s.ctx.BlockHeader().Time
+	chain := []sdk.AccAddress{granteeAddr}
+	for i := 0; i < types.DefaultMaxExecDepth+1; i++ {
+		chain = append(chain, sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()))
+	}
+
+	s.keeper.SetGrant(s.ctx, granterAddr, granteeAddr, types.NewAuthorizationGrant(types.NewSendAuthorization(coins), now.Add(time.Hour)))
+	for i := 1; i < len(chain); i++ {
+		s.keeper.SetGrant(s.ctx, chain[i-1], chain[i], types.NewAuthorizationGrant(types.NewGenericAuthorization(types.MsgExecAuthorized{}.Type()), now.Add(time.Hour)))
+	}
+
+	buildMsgs := func(depth int) []sdk.Msg {
+		msgs := []sdk.Msg{bank.NewMsgSend(granterAddr, recipientAddr, sdk.NewCoins(sdk.NewInt64Coin("steak", 1)))}
+		for i := 0; i < depth; i++ {
+			msgs = []sdk.Msg{types.NewMsgExecAuthorized(chain[i], msgs)}
+		}
+		return msgs
+	}
+
+	s.T().Log("verify nested exec within the max depth charges gas per hop")
+	ctx := s.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	err := s.keeper.DispatchActions(ctx, chain[types.DefaultMaxExecDepth], buildMsgs(types.DefaultMaxExecDepth))
+	s.Require().NoError(err)
+	s.Require().True(ctx.GasMeter().GasConsumed() >= types.NestedExecGasCost*types.DefaultMaxExecDepth)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("steak", 1)), s.bankKeeper.GetCoins(s.ctx, recipientAddr))
+
+	s.T().Log("verify nested exec fails over the max depth")
+	err = s.keeper.DispatchActions(s.ctx, chain[types.DefaultMaxExecDepth+1], buildMsgs(types.DefaultMaxExecDepth+1))
+	s.Require().Error(err)
+	s.Require().True(types.ErrTooDeepExecution.Is(err))
+
+	s.T().Log("verify the max depth is read from the params")
+	s.keeper.SetParams(s.ctx, types.Params{MaxExecDepth: types.DefaultMaxExecDepth + 1})
+	err = s.keeper.DispatchActions(s.ctx, chain[types.DefaultMaxExecDepth+1], buildMsgs(types.DefaultMaxExecDepth+1))
+	s.Require().NoError(err)
+
+	s.keeper.SetParams(s.ctx, types.Params{MaxExecDepth: 1})
+	err = s.keeper.DispatchActions(s.ctx, chain[2], buildMsgs(2))
+	s.Require().True(types.ErrTooDeepExecution.Is(err))
+}
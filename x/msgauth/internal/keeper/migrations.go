@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/msgauth/internal/types"
+)
+
+// Migrator migrates the msgauth store in place between consensus versions
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the params, added in version 2, to their defaults and rebuilds
+// the grant queue, which holds the stale entries left by the regrants of version 1
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if !m.keeper.paramSpace.Has(ctx, types.ParamStoreKeyMaxExecDepth) {
+		m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyMaxExecDepth, uint64(types.DefaultMaxExecDepth))
+	}
+
+	m.rebuildGrantQueue(ctx)
+	return nil
+}
+
+// rebuildGrantQueue replaces the grant queue with a single entry
+// of every stored grant at its expiration
+func (m Migrator) rebuildGrantQueue(ctx sdk.Context) {
+	store := ctx.KVStore(m.keeper.storeKey)
+	iter := sdk.KVStorePrefixIterator(store, types.GrantQueueKey)
+	var queueKeys [][]byte
+	for ; iter.Valid(); iter.Next() {
+		queueKeys = append(queueKeys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range queueKeys {
+		store.Delete(key)
+	}
+
+	var grants []types.GGMPair
+	var expirations []time.Time
+	m.keeper.IterateGrants(ctx, func(granterAddr, granteeAddr sdk.AccAddress, grant types.AuthorizationGrant) bool {
+		grants = append(grants, types.GGMPair{
+			GranterAddress: granterAddr,
+			GranteeAddress: granteeAddr,
+			MsgType:        grant.Authorization.MsgType(),
+		})
+		expirations = append(expirations, grant.Expiration)
+		return false
+	})
+
+	for i, pair := range grants {
+		m.keeper.InsertGrantQueue(ctx, pair.GranterAddress, pair.GranteeAddress, pair.MsgType, expirations[i])
+	}
+}
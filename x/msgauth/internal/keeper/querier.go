@@ -21,6 +21,8 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryGrant(ctx, req, keeper)
 		case types.QueryGrants:
 			return queryGrants(ctx, req, keeper)
+		case types.QueryParameters:
+			return queryParameters(ctx, keeper)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
 		}
@@ -39,6 +41,8 @@ func queryGrant(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte,
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "authorization not found")
 	}
 
+	grant = updateGrantPeriod(ctx, grant)
+
 	bz, err := codec.MarshalJSONIndent(keeper.cdc, grant)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
@@ -56,6 +60,10 @@ func queryGrants(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte,
 
 	fmt.Println(params.Granter.String(), params.Grantee.String())
 	grants := keeper.GetGrants(ctx, params.Granter, params.Grantee)
+	for i, grant := range grants {
+		grants[i] = updateGrantPeriod(ctx, grant)
+	}
+
 	bz, err := codec.MarshalJSONIndent(keeper.cdc, grants)
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
@@ -63,3 +71,22 @@ func queryGrants(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte,
 
 	return bz, nil
 }
+
+func queryParameters(ctx sdk.Context, keeper Keeper) ([]byte, error) {
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetParams(ctx))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
+
+// updateGrantPeriod resets the periodic authorization as of the current block time,
+// so the queried grant shows the remaining allowance and the next reset time
+func updateGrantPeriod(ctx sdk.Context, grant types.AuthorizationGrant) types.AuthorizationGrant {
+	if authorization, ok := grant.Authorization.(types.PeriodicSendAuthorization); ok && !authorization.PeriodReset.IsZero() {
+		grant.Authorization = authorization.UpdatePeriod(ctx.BlockTime())
+	}
+
+	return grant
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	"github.com/stretchr/testify/suite"
 	abci "github.com/tendermint/tendermint/abci/types"
 
@@ -76,3 +78,30 @@ func (s *TestSuite) TestQueryGrants() {
 	s.keeper.cdc.MustUnmarshalJSON(res, &resGrants)
 	s.Require().Equal([]types.AuthorizationGrant{grant, grant2}, resGrants)
 }
+
+func (s *TestSuite) TestQueryPeriodicSendGrant() {
+	querier := NewQuerier(s.keeper)
+	now := s.ctx.BlockHeader().Time
+
+	// register grant whose period is already elapsed
+	periodLimit := sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(100)))
+	authorization := types.NewPeriodicSendAuthorization(time.Hour, periodLimit, false)
+	authorization.PeriodCanSpend = sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(10)))
+	authorization.PeriodReset = now.Add(-time.Minute)
+	grant := types.NewAuthorizationGrant(authorization, now.Add(time.Hour*24))
+	s.keeper.SetGrant(s.ctx, granterAddr, granteeAddr, grant)
+
+	params := types.NewQueryGrantParams(granterAddr, granteeAddr, types.PeriodicSendAuthorization{}.MsgType())
+	bz, err := s.keeper.cdc.MarshalJSON(params)
+	s.Require().NoError(err)
+
+	res, err := querier(s.ctx, []string{types.QueryGrant}, abci.RequestQuery{Data: bz})
+	s.Require().NoError(err)
+
+	// the remaining allowance and the next reset time are shown as of the current block
+	var resGrant types.AuthorizationGrant
+	s.keeper.cdc.MustUnmarshalJSON(res, &resGrant)
+	resAuthorization := resGrant.Authorization.(types.PeriodicSendAuthorization)
+	s.Require().Equal(periodLimit, resAuthorization.PeriodCanSpend)
+	s.Require().Equal(now.Add(time.Hour-time.Minute), resAuthorization.PeriodReset)
+}
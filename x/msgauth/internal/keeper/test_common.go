@@ -69,7 +69,9 @@ func SetupTestInput() (sdk.Context, auth.AccountKeeper, params.Keeper, bank.Base
 	router := baseapp.NewRouter()
 	router.AddRoute("bank", bank.NewHandler(bankKeeper))
 
-	authorizationKeeper := NewKeeper(cdc, keyAuthorization, router, bank.MsgSend{}.Type(), "swap")
+	authorizationKeeper := NewKeeper(cdc, keyAuthorization, paramsKeeper.Subspace(types.DefaultParamspace), router,
+		bank.MsgSend{}.Type(), types.MsgExecAuthorized{}.Type(), "swap")
+	authorizationKeeper.SetParams(ctx, types.DefaultParams())
 	authKeeper.SetParams(ctx, auth.DefaultParams())
 
 	return ctx, authKeeper, paramsKeeper, bankKeeper, authorizationKeeper, router
@@ -15,6 +15,8 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgExecAuthorized{}, "msgauth/MsgExecAuthorized", nil)
 	cdc.RegisterConcrete(SendAuthorization{}, "msgauth/SendAuthorization", nil)
 	cdc.RegisterConcrete(GenericAuthorization{}, "msgauth/GenericAuthorization", nil)
+	cdc.RegisterConcrete(DelegateAuthorization{}, "msgauth/DelegateAuthorization", nil)
+	cdc.RegisterConcrete(PeriodicSendAuthorization{}, "msgauth/PeriodicSendAuthorization", nil)
 
 	cdc.RegisterInterface((*Authorization)(nil), nil)
 }
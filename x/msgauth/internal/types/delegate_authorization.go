@@ -0,0 +1,75 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// DelegateAuthorization grants the permission to delegate the granter's tokens
+// to the allowed validators up to the stake limit
+type DelegateAuthorization struct {
+	// AllowedValidators specifies the validators the grantee can delegate to.
+	// If it is empty, any validator can be a delegation target.
+	AllowedValidators []sdk.ValAddress `json:"allowed_validators"`
+
+	// StakeLimit specifies the maximum amount of tokens that can be delegated
+	// by this authorization and will be updated as tokens are delegated. If it is
+	// empty, there is no stake limit and any amount of tokens can be delegated.
+	StakeLimit sdk.Coins `json:"stake_limit"`
+}
+
+// NewDelegateAuthorization returns new DelegateAuthorization instance
+func NewDelegateAuthorization(allowedValidators []sdk.ValAddress, stakeLimit sdk.Coins) DelegateAuthorization {
+	return DelegateAuthorization{
+		AllowedValidators: allowedValidators,
+		StakeLimit:        stakeLimit,
+	}
+}
+
+// MsgType implement Authorization
+func (authorization DelegateAuthorization) MsgType() string {
+	return staking.MsgDelegate{}.Type()
+}
+
+// Accept implement Authorization
+func (authorization DelegateAuthorization) Accept(msg sdk.Msg, block abci.Header) (allow bool, updated Authorization, delete bool) {
+	switch msg := msg.(type) {
+	case staking.MsgDelegate:
+		if !authorization.isAllowedValidator(msg.ValidatorAddress) {
+			return false, nil, false
+		}
+
+		if authorization.StakeLimit.Empty() {
+			return true, authorization, false
+		}
+
+		limitLeft, isNegative := authorization.StakeLimit.SafeSub(sdk.NewCoins(msg.Amount))
+		if isNegative {
+			return false, nil, false
+		}
+		if limitLeft.IsZero() {
+			return true, nil, true
+		}
+
+		return true, DelegateAuthorization{
+			AllowedValidators: authorization.AllowedValidators,
+			StakeLimit:        limitLeft,
+		}, false
+	}
+	return false, nil, false
+}
+
+func (authorization DelegateAuthorization) isAllowedValidator(valAddr sdk.ValAddress) bool {
+	if len(authorization.AllowedValidators) == 0 {
+		return true
+	}
+
+	for _, allowed := range authorization.AllowedValidators {
+		if allowed.Equals(valAddr) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+)
+
+func TestDelegateAuthorization(t *testing.T) {
+	delAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	valAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+	otherValAddr := sdk.ValAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	authorization := NewDelegateAuthorization([]sdk.ValAddress{valAddr}, sdk.NewCoins(sdk.NewInt64Coin("foo", 100)))
+	require.Equal(t, "delegate", authorization.MsgType())
+
+	// not allowed validator
+	allow, _, _ := authorization.Accept(staking.NewMsgDelegate(delAddr, otherValAddr, sdk.NewInt64Coin("foo", 10)), abci.Header{})
+	require.False(t, allow)
+
+	// exceeds the stake limit
+	allow, _, _ = authorization.Accept(staking.NewMsgDelegate(delAddr, valAddr, sdk.NewInt64Coin("foo", 101)), abci.Header{})
+	require.False(t, allow)
+
+	// other msg type
+	allow, _, _ = authorization.Accept(bank.NewMsgSend(delAddr, delAddr, sdk.NewCoins(sdk.NewInt64Coin("foo", 10))), abci.Header{})
+	require.False(t, allow)
+
+	// stake limit is updated
+	allow, updated, del := authorization.Accept(staking.NewMsgDelegate(delAddr, valAddr, sdk.NewInt64Coin("foo", 40)), abci.Header{})
+	require.True(t, allow)
+	require.False(t, del)
+	require.Equal(t, NewDelegateAuthorization([]sdk.ValAddress{valAddr}, sdk.NewCoins(sdk.NewInt64Coin("foo", 60))), updated)
+
+	// stake limit is used up
+	allow, _, del = updated.Accept(staking.NewMsgDelegate(delAddr, valAddr, sdk.NewInt64Coin("foo", 60)), abci.Header{})
+	require.True(t, allow)
+	require.True(t, del)
+
+	// no validator restriction and no stake limit
+	authorization = NewDelegateAuthorization(nil, nil)
+	allow, updated, del = authorization.Accept(staking.NewMsgDelegate(delAddr, otherValAddr, sdk.NewInt64Coin("foo", 1000)), abci.Header{})
+	require.True(t, allow)
+	require.False(t, del)
+	require.Equal(t, authorization, updated)
+}
@@ -6,6 +6,7 @@ import (
 
 // x/gov module sentinel errors
 var (
-	ErrInvalidPeriod  = sdkerrors.Register(ModuleName, 3, "period of authorization should be positive time duration")
-	ErrInvalidMsgType = sdkerrors.Register(ModuleName, 4, "given msg type is not grantable")
+	ErrInvalidPeriod    = sdkerrors.Register(ModuleName, 3, "period of authorization should be positive time duration")
+	ErrInvalidMsgType   = sdkerrors.Register(ModuleName, 4, "given msg type is not grantable")
+	ErrTooDeepExecution = sdkerrors.Register(ModuleName, 5, "nested msg execution exceeds max depth")
 )
@@ -5,6 +5,7 @@ const (
 	EventGrantAuthorization   = "grant_authorization"
 	EventRevokeAuthorization  = "revoke_authorization"
 	EventExecuteAuthorization = "execute_authorization"
+	EventExecuteAuthorizedMsg = "execute_authorized_msg"
 
 	AttributeKeyGrantType      = "grant_type"
 	AttributeKeyGranteeAddress = "grantee"
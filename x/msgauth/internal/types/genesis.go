@@ -17,24 +17,32 @@ type AuthorizationEntry struct {
 
 // GenesisState is the struct representation of the export genesis
 type GenesisState struct {
+	Params               Params               `json:"params" yaml:"params"`
 	AuthorizationEntries []AuthorizationEntry `json:"authorization_entries" yaml:"authorization_entries"`
 }
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState(entries []AuthorizationEntry) GenesisState {
+func NewGenesisState(params Params, entries []AuthorizationEntry) GenesisState {
 	return GenesisState{
+		Params:               params,
 		AuthorizationEntries: entries,
 	}
 }
 
 // ValidateGenesis check the given genesis state has no integrity issues
 func ValidateGenesis(data GenesisState) error {
-	return nil
+	// the genesis exported before the params has none, and gets the default params
+	if data.Params == (Params{}) {
+		return nil
+	}
+
+	return data.Params.ValidateBasic()
 }
 
 // DefaultGenesisState gets raw genesis raw message for testing
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
+		Params:               DefaultParams(),
 		AuthorizationEntries: []AuthorizationEntry{},
 	}
 }
@@ -2,8 +2,12 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func TestGenesisEqual(t *testing.T) {
@@ -13,7 +17,36 @@ func TestGenesisEqual(t *testing.T) {
 	require.True(t, genState1.Equal(genState2))
 }
 
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, ValidateGenesis(DefaultGenesisState()))
+
+	// the genesis exported before the params has none
+	require.NoError(t, ValidateGenesis(GenesisState{}))
+}
+
 func TestGenesisEmpty(t *testing.T) {
 	genState := GenesisState{}
 	require.True(t, genState.IsEmpty())
 }
+
+func TestGenesisPeriodicSendAuthorization(t *testing.T) {
+	authorization := NewPeriodicSendAuthorization(time.Hour, sdk.NewCoins(sdk.NewInt64Coin("foo", 100)), true)
+	authorization.PeriodReset = time.Now().UTC()
+
+	genState := NewGenesisState(DefaultParams(), []AuthorizationEntry{
+		{
+			Granter:       sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+			Grantee:       sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+			Authorization: authorization,
+			Expiration:    time.Now().UTC(),
+		},
+	})
+
+	bz, err := ModuleCdc.MarshalJSON(genState)
+	require.NoError(t, err)
+
+	var decoded GenesisState
+	require.NoError(t, ModuleCdc.UnmarshalJSON(bz, &decoded))
+	require.True(t, genState.Equal(decoded))
+	require.Equal(t, authorization, decoded.AuthorizationEntries[0].Authorization)
+}
@@ -18,6 +18,9 @@ const (
 
 	// QuerierRoute is the querier route for msgauth
 	QuerierRoute = ModuleName
+
+	// NestedExecGasCost is the gas charged for each nested MsgExecAuthorized
+	NestedExecGasCost = 10000
 )
 
 // Keys for msgauth store
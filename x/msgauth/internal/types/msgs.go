@@ -53,6 +53,16 @@ func (msg MsgGrantAuthorization) ValidateBasic() error {
 		return ErrInvalidPeriod
 	}
 
+	if authorization, ok := msg.Authorization.(PeriodicSendAuthorization); ok {
+		if authorization.Period <= 0 {
+			return ErrInvalidPeriod
+		}
+
+		if authorization.PeriodSpendLimit.Empty() || !authorization.PeriodSpendLimit.IsValid() {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, "invalid period spend limit")
+		}
+	}
+
 	return nil
 }
 
@@ -97,8 +107,9 @@ func (msg MsgRevokeAuthorization) ValidateBasic() error {
 }
 
 // MsgExecAuthorized attempts to execute the provided messages using
-// authorizations granted to the grantee. Each message should have only
-// one signer corresponding to the granter of the authorization.
+// authorizations granted to the grantee. Every signer of each message except
+// the grantee should have granted the message type to the grantee. A nested
+// MsgExecAuthorized executes its messages on behalf of its own grantee.
 type MsgExecAuthorized struct {
 	Grantee sdk.AccAddress `json:"grantee"`
 	Msgs    []sdk.Msg      `json:"msgs"`
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+// DefaultParamspace nolint
+const DefaultParamspace = ModuleName
+
+// Parameter keys
+var (
+	// The number of MsgExecAuthorized which can be nested inside another
+	ParamStoreKeyMaxExecDepth = []byte("maxexecdepth")
+)
+
+// Default parameter values
+const (
+	DefaultMaxExecDepth = 3
+)
+
+var _ params.ParamSet = &Params{}
+
+// Params msgauth parameters
+type Params struct {
+	MaxExecDepth uint64 `json:"max_exec_depth" yaml:"max_exec_depth"`
+}
+
+// DefaultParams creates default msgauth module parameters
+func DefaultParams() Params {
+	return Params{
+		MaxExecDepth: DefaultMaxExecDepth,
+	}
+}
+
+// ParamKeyTable returns the parameter key table.
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// String implements fmt.Stringer interface
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of msgauth module's parameters.
+// nolint
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(ParamStoreKeyMaxExecDepth, &p.MaxExecDepth, validateMaxExecDepth),
+	}
+}
+
+// ValidateBasic a set of params
+func (p Params) ValidateBasic() error {
+	if p.MaxExecDepth == 0 {
+		return fmt.Errorf("max exec depth should be positive, is %d", p.MaxExecDepth)
+	}
+
+	return nil
+}
+
+func validateMaxExecDepth(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max exec depth must be positive: %d", v)
+	}
+
+	return nil
+}
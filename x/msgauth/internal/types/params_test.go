@@ -0,0 +1,27 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsEqual(t *testing.T) {
+	p1 := DefaultParams()
+	err := p1.ValidateBasic()
+	require.NoError(t, err)
+
+	// invalid max exec depth
+	p1.MaxExecDepth = 0
+	err = p1.ValidateBasic()
+	require.Error(t, err)
+
+	// the zero params in the genesis get the default params
+	genState := DefaultGenesisState()
+	genState.Params = p1
+	require.NoError(t, ValidateGenesis(genState))
+
+	p2 := DefaultParams()
+	require.NotNil(t, p2.ParamSetPairs())
+	require.NotNil(t, p2.String())
+}
@@ -0,0 +1,98 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// PeriodicSendAuthorization grants the permission to send the granter's coins
+// up to the period spend limit, which is refilled every period
+type PeriodicSendAuthorization struct {
+	// Period specifies the time duration in which PeriodSpendLimit coins can be spent
+	// before the spend limit is reset
+	Period time.Duration `json:"period"`
+
+	// PeriodSpendLimit specifies the maximum amount of tokens that can be spent
+	// in each period
+	PeriodSpendLimit sdk.Coins `json:"period_spend_limit"`
+
+	// CarryOver specifies whether the unspent tokens of a period, up to
+	// PeriodSpendLimit, are carried over to the next period or discarded on reset
+	CarryOver bool `json:"carry_over"`
+
+	// PeriodCanSpend is the remaining amount of tokens that can be spent
+	// before the next reset
+	PeriodCanSpend sdk.Coins `json:"period_can_spend"`
+
+	// PeriodReset is the time at which the current period ends and the
+	// spend limit is reset. It is zero until the first spend.
+	PeriodReset time.Time `json:"period_reset"`
+}
+
+// NewPeriodicSendAuthorization returns new PeriodicSendAuthorization instance
+func NewPeriodicSendAuthorization(period time.Duration, periodSpendLimit sdk.Coins, carryOver bool) PeriodicSendAuthorization {
+	return PeriodicSendAuthorization{
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+		CarryOver:        carryOver,
+		PeriodCanSpend:   periodSpendLimit,
+	}
+}
+
+// MsgType implement Authorization
+func (authorization PeriodicSendAuthorization) MsgType() string {
+	return bank.MsgSend{}.Type()
+}
+
+// Accept implement Authorization
+func (authorization PeriodicSendAuthorization) Accept(msg sdk.Msg, block abci.Header) (allow bool, updated Authorization, delete bool) {
+	switch msg := msg.(type) {
+	case bank.MsgSend:
+		authorization = authorization.UpdatePeriod(block.Time)
+
+		canSpendLeft, isNegative := authorization.PeriodCanSpend.SafeSub(msg.Amount)
+		if isNegative {
+			return false, nil, false
+		}
+
+		authorization.PeriodCanSpend = canSpendLeft
+		return true, authorization, false
+	}
+	return false, nil, false
+}
+
+// UpdatePeriod returns the authorization with the period spend limit reset
+// as of the given block time. The first period starts at the first spend, and
+// every elapsed period refills the spend limit; the unspent tokens are kept
+// only when CarryOver is set, up to one period spend limit.
+func (authorization PeriodicSendAuthorization) UpdatePeriod(blockTime time.Time) PeriodicSendAuthorization {
+	if authorization.PeriodReset.IsZero() {
+		authorization.PeriodReset = blockTime.Add(authorization.Period)
+		return authorization
+	}
+
+	if blockTime.Before(authorization.PeriodReset) || authorization.Period <= 0 {
+		return authorization
+	}
+
+	elapsedPeriods := int64(blockTime.Sub(authorization.PeriodReset)/authorization.Period) + 1
+	if authorization.CarryOver {
+		// the carried over tokens are capped at one period spend limit, so the grantee
+		// can't pile up the spend limits of many periods
+		canSpend := make(sdk.Coins, len(authorization.PeriodSpendLimit))
+		for i, coin := range authorization.PeriodSpendLimit {
+			amount := authorization.PeriodCanSpend.AmountOf(coin.Denom).Add(coin.Amount.MulRaw(elapsedPeriods))
+			canSpend[i] = sdk.NewCoin(coin.Denom, sdk.MinInt(amount, coin.Amount.MulRaw(2)))
+		}
+
+		authorization.PeriodCanSpend = canSpend
+	} else {
+		authorization.PeriodCanSpend = authorization.PeriodSpendLimit
+	}
+
+	authorization.PeriodReset = authorization.PeriodReset.Add(time.Duration(elapsedPeriods) * authorization.Period)
+	return authorization
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+)
+
+func TestPeriodicSendAuthorization(t *testing.T) {
+	fromAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	toAddr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	now := time.Now().UTC()
+
+	periodLimit := sdk.NewCoins(sdk.NewInt64Coin("foo", 100))
+	authorization := NewPeriodicSendAuthorization(time.Hour, periodLimit, false)
+	require.Equal(t, "send", authorization.MsgType())
+
+	sendMsg := func(amount int64) bank.MsgSend {
+		return bank.NewMsgSend(fromAddr, toAddr, sdk.NewCoins(sdk.NewInt64Coin("foo", amount)))
+	}
+
+	// exceeds the period spend limit
+	allow, _, _ := authorization.Accept(sendMsg(101), abci.Header{Time: now})
+	require.False(t, allow)
+
+	// first spend starts the period
+	allow, updated, del := authorization.Accept(sendMsg(70), abci.Header{Time: now})
+	require.True(t, allow)
+	require.False(t, del)
+	periodic := updated.(PeriodicSendAuthorization)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foo", 30)), periodic.PeriodCanSpend)
+	require.Equal(t, now.Add(time.Hour), periodic.PeriodReset)
+
+	// exceeds the remaining limit in the same period
+	allow, _, _ = periodic.Accept(sendMsg(31), abci.Header{Time: now.Add(time.Minute)})
+	require.False(t, allow)
+
+	// the limit is reset after the period without carry-over
+	allow, updated, del = periodic.Accept(sendMsg(100), abci.Header{Time: now.Add(time.Hour)})
+	require.True(t, allow)
+	require.False(t, del)
+	periodic = updated.(PeriodicSendAuthorization)
+	require.True(t, periodic.PeriodCanSpend.IsZero())
+	require.Equal(t, now.Add(2*time.Hour), periodic.PeriodReset)
+
+	// the reset time keeps aligned to the period after several periods
+	periodic = periodic.UpdatePeriod(now.Add(4*time.Hour + time.Minute))
+	require.Equal(t, periodLimit, periodic.PeriodCanSpend)
+	require.Equal(t, now.Add(5*time.Hour), periodic.PeriodReset)
+}
+
+func TestPeriodicSendAuthorizationCarryOver(t *testing.T) {
+	now := time.Now().UTC()
+
+	periodLimit := sdk.NewCoins(sdk.NewInt64Coin("foo", 100))
+	authorization := NewPeriodicSendAuthorization(time.Hour, periodLimit, true)
+	authorization = authorization.UpdatePeriod(now)
+	require.Equal(t, periodLimit, authorization.PeriodCanSpend)
+
+	// unspent tokens of a period are carried over to the next period
+	authorization.PeriodCanSpend = sdk.NewCoins(sdk.NewInt64Coin("foo", 30))
+	authorization = authorization.UpdatePeriod(now.Add(time.Hour + time.Minute))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foo", 130)), authorization.PeriodCanSpend)
+	require.Equal(t, now.Add(2*time.Hour), authorization.PeriodReset)
+
+	// the carried over tokens are capped at one period spend limit
+	authorization = authorization.UpdatePeriod(now.Add(2*time.Hour + time.Minute))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foo", 200)), authorization.PeriodCanSpend)
+	require.Equal(t, now.Add(3*time.Hour), authorization.PeriodReset)
+
+	// for every elapsed period
+	authorization = authorization.UpdatePeriod(now.Add(100*time.Hour + time.Minute))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foo", 200)), authorization.PeriodCanSpend)
+	require.Equal(t, now.Add(101*time.Hour), authorization.PeriodReset)
+
+	// a spend of more than two period spend limits is never accepted
+	allow, _, _ := authorization.Accept(bank.NewMsgSend(nil, nil, sdk.NewCoins(sdk.NewInt64Coin("foo", 201))), abci.Header{Time: now.Add(200 * time.Hour)})
+	require.False(t, allow)
+}
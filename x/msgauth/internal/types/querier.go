@@ -6,8 +6,9 @@ import (
 
 // Defines the prefix of each query path
 const (
-	QueryGrant  = "grant"
-	QueryGrants = "grants"
+	QueryGrant      = "grant"
+	QueryGrants     = "grants"
+	QueryParameters = "parameters"
 )
 
 // QueryGrantParams defines the params for the following queries:
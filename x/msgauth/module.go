@@ -21,6 +21,7 @@ import (
 	"github.com/terra-project/core/x/msgauth/client/cli"
 	"github.com/terra-project/core/x/msgauth/client/rest"
 	"github.com/terra-project/core/x/msgauth/simulation"
+	"github.com/terra-project/core/x/upgrade"
 )
 
 // module codec
@@ -31,8 +32,10 @@ func init() {
 }
 
 var (
-	_ module.AppModule      = AppModule{}
-	_ module.AppModuleBasic = AppModuleBasic{}
+	_ module.AppModule            = AppModule{}
+	_ module.AppModuleBasic       = AppModuleBasic{}
+	_ upgrade.HasConsensusVersion = AppModule{}
+	_ upgrade.HasMigrations       = AppModule{}
 )
 
 type AppModuleBasic struct{}
@@ -80,8 +83,10 @@ func NewAppModule(keeper Keeper, accountKeeper auth.AccountKeeper, bankKeeper ba
 	}
 }
 
-// RegisterInvariants does nothing, there are no invariants to enforce
-func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+// RegisterInvariants registers the msgauth module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, am.keeper)
+}
 
 // Route is empty, as we do not handle Messages (just proposals)
 func (AppModule) Route() string { return RouterKey }
@@ -137,12 +142,22 @@ func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.Val
 	return nil
 }
 
+// ConsensusVersion returns the consensus version of the msgauth module's store.
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+
+// RegisterMigrations registers the in-place store migrations of the msgauth module.
+func (am AppModule) RegisterMigrations(registry upgrade.MigrationRegistry) error {
+	return registry.RegisterMigration(ModuleName, 1, NewMigrator(am.keeper).Migrate1to2)
+}
+
 //____________________________________________________________________________
 
 // AppModuleSimulation functions
 
-// GenerateGenesisState creates a randomized GenState of the distribution module.
-func (AppModule) GenerateGenesisState(simState *module.SimulationState) {}
+// GenerateGenesisState creates a randomized GenState of the msgauth module.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
 
 // ProposalContents returns all the distribution content functions used to
 // simulate governance proposals.
@@ -150,9 +165,9 @@ func (am AppModule) ProposalContents(_ module.SimulationState) []sim.WeightedPro
 	return nil
 }
 
-// RandomizedParams creates randomized distribution param changes for the simulator.
+// RandomizedParams creates randomized msgauth param changes for the simulator.
 func (AppModule) RandomizedParams(r *rand.Rand) []sim.ParamChange {
-	return nil
+	return simulation.ParamChanges(r)
 }
 
 // RegisterStoreDecoder registers a decoder for distribution module's types
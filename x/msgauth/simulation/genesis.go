@@ -0,0 +1,43 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/terra-project/core/x/msgauth/internal/types"
+)
+
+// Simulation parameter constants
+const (
+	maxExecDepthKey = "max_exec_depth"
+)
+
+// GenMaxExecDepth randomized MaxExecDepth
+func GenMaxExecDepth(r *rand.Rand) uint64 {
+	return uint64(1 + r.Intn(5))
+}
+
+// RandomizedGenState generates a random GenesisState for msgauth
+func RandomizedGenState(simState *module.SimulationState) {
+
+	var maxExecDepth uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxExecDepthKey, &maxExecDepth, simState.Rand,
+		func(r *rand.Rand) { maxExecDepth = GenMaxExecDepth(r) },
+	)
+
+	msgauthGenesis := types.NewGenesisState(
+		types.Params{
+			MaxExecDepth: maxExecDepth,
+		},
+		[]types.AuthorizationEntry{},
+	)
+
+	fmt.Printf("Selected randomly generated msgauth parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, msgauthGenesis))
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(msgauthGenesis)
+}
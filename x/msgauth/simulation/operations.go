@@ -25,6 +25,7 @@ const (
 	OpWeightMsgGrantAuthorization = "op_weight_msg_grant_authorization"
 	OpWeightRevokeAuthorization   = "op_weight_msg_revoke_authorization"
 	OpWeightExecAuthorized        = "op_weight_msg_execute_authorized"
+	OpWeightRegrantAuthorization  = "op_weight_msg_regrant_authorization"
 )
 
 // WeightedOperations returns all the operations from the module with their respective weights
@@ -36,6 +37,7 @@ func WeightedOperations(
 		weightMsgGrantAuthorization int
 		weightRevokeAuthorization   int
 		weightExecAuthorized        int
+		weightRegrantAuthorization  int
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgGrantAuthorization, &weightMsgGrantAuthorization, nil,
@@ -56,6 +58,12 @@ func WeightedOperations(
 		},
 	)
 
+	appParams.GetOrGenerate(cdc, OpWeightRegrantAuthorization, &weightRegrantAuthorization, nil,
+		func(_ *rand.Rand) {
+			weightRegrantAuthorization = simappparams.DefaultWeightMsgUndelegate
+		},
+	)
+
 	return simulation.WeightedOperations{
 		simulation.NewWeightedOperation(
 			weightMsgGrantAuthorization,
@@ -69,9 +77,38 @@ func WeightedOperations(
 			weightExecAuthorized,
 			SimulateMsgExecuteAuthorized(ak, bk, k),
 		),
+		simulation.NewWeightedOperation(
+			weightRegrantAuthorization,
+			SimulateMsgRegrantAuthorization(ak, k),
+		),
 	}
 }
 
+// randomGrantPeriod returns a grant period of up to a few blocks, so the
+// grants expire through the grant queue during the simulation
+func randomGrantPeriod(r *rand.Rand) time.Duration {
+	return time.Duration(simulation.RandIntBetween(r, 1, 10)) * time.Hour
+}
+
+// randomGrant returns a random grant of the store
+func randomGrant(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) (granterAddr, granteeAddr sdk.AccAddress, grant types.AuthorizationGrant, found bool) {
+	var granters, grantees []sdk.AccAddress
+	var grants []types.AuthorizationGrant
+	k.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant types.AuthorizationGrant) bool {
+		granters = append(granters, granter)
+		grantees = append(grantees, grantee)
+		grants = append(grants, grant)
+		return false
+	})
+
+	if len(grants) == 0 {
+		return nil, nil, grant, false
+	}
+
+	i := r.Intn(len(grants))
+	return granters[i], grantees[i], grants[i], true
+}
+
 // SimulateMsgGrantAuthorization generates a MsgGrantAuthorization with random values.
 // nolint: funlen
 func SimulateMsgGrantAuthorization(ak authkeeper.AccountKeeper, k keeper.Keeper) simulation.Operation {
@@ -94,7 +131,7 @@ func SimulateMsgGrantAuthorization(ak authkeeper.AccountKeeper, k keeper.Keeper)
 		}
 
 		msg := types.NewMsgGrantAuthorization(granter.Address, grantee.Address,
-			types.NewSendAuthorization(spendableCoins.Sub(fees)), time.Hour)
+			types.NewSendAuthorization(spendableCoins.Sub(fees)), randomGrantPeriod(r))
 
 		tx := helpers.GenTx(
 			[]sdk.Msg{msg},
@@ -118,19 +155,8 @@ func SimulateMsgRevokeAuthorization(ak authkeeper.AccountKeeper, k keeper.Keeper
 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
 	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
 
-		hasGrant := false
-		var targetGrant types.AuthorizationGrant
-		var granterAddr sdk.AccAddress
-		var granteeAddr sdk.AccAddress
-		k.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant types.AuthorizationGrant) bool {
-			targetGrant = grant
-			granterAddr = granter
-			granteeAddr = grantee
-			hasGrant = true
-			return true
-		})
-
-		if !hasGrant {
+		granterAddr, granteeAddr, targetGrant, found := randomGrant(r, ctx, k)
+		if !found {
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
 
@@ -170,19 +196,8 @@ func SimulateMsgExecuteAuthorized(ak authkeeper.AccountKeeper, bk bank.Keeper, k
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
 
-		hasGrant := false
-		var targetGrant types.AuthorizationGrant
-		var granterAddr sdk.AccAddress
-		var granteeAddr sdk.AccAddress
-		k.IterateGrants(ctx, func(granter, grantee sdk.AccAddress, grant types.AuthorizationGrant) bool {
-			targetGrant = grant
-			granterAddr = granter
-			granteeAddr = grantee
-			hasGrant = true
-			return true
-		})
-
-		if !hasGrant {
+		granterAddr, granteeAddr, targetGrant, found := randomGrant(r, ctx, k)
+		if !found {
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
 
@@ -236,3 +251,46 @@ func SimulateMsgExecuteAuthorized(ak authkeeper.AccountKeeper, bk bank.Keeper, k
 		return simulation.NewOperationMsg(msg, true, ""), nil, nil
 	}
 }
+
+// SimulateMsgRegrantAuthorization generates a MsgGrantAuthorization replacing a random
+// grant with a new period, which must replace the expiration of the grant in the queue.
+// nolint: funlen
+func SimulateMsgRegrantAuthorization(ak authkeeper.AccountKeeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+
+		granterAddr, granteeAddr, targetGrant, found := randomGrant(r, ctx, k)
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		granter, found := simulation.FindAccount(accs, granterAddr)
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, granter.Address)
+
+		spendableCoins := account.SpendableCoins(ctx.BlockTime())
+		fees, err := simulation.RandomFees(r, ctx, spendableCoins)
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		msg := types.NewMsgGrantAuthorization(granterAddr, granteeAddr, targetGrant.Authorization, randomGrantPeriod(r))
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			fees,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			granter.PrivKey,
+		)
+
+		_, _, err = app.Deliver(tx)
+		return simulation.NewOperationMsg(msg, true, ""), nil, err
+	}
+}
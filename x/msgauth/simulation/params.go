@@ -0,0 +1,24 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/terra-project/core/x/msgauth/internal/types"
+)
+
+// ParamChanges defines the parameters that can be modified by param change proposals
+// on the simulation
+func ParamChanges(r *rand.Rand) []simulation.ParamChange {
+	return []simulation.ParamChange{
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreKeyMaxExecDepth),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenMaxExecDepth(r))
+			},
+		),
+	}
+}
@@ -77,6 +77,8 @@ var (
 	NewAggregateExchangeRateVote       = types.NewAggregateExchangeRateVote
 	NewKeeper                          = keeper.NewKeeper
 	NewQuerier                         = keeper.NewQuerier
+	RegisterInvariants                 = keeper.RegisterInvariants
+	RewardPoolInvariant                = keeper.RewardPoolInvariant
 
 	// variable aliases
 	ModuleCdc                             = types.ModuleCdc
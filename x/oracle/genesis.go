@@ -75,6 +75,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 		}
 	}
 
+	keeper.SetUndistributedRewards(ctx, data.UndistributedRewards)
 	keeper.SetParams(ctx, data.Params)
 
 	// check if the module account exists
@@ -138,5 +139,5 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) (data GenesisState) {
 		return false
 	})
 
-	return NewGenesisState(params, exchangeRatePrevotes, exchangeRateVotes, rates, feederDelegations, missCounters, aggregateExchangeRatePrevotes, aggregateExchangeRateVotes, tobinTaxes)
+	return NewGenesisState(params, exchangeRatePrevotes, exchangeRateVotes, rates, feederDelegations, missCounters, aggregateExchangeRatePrevotes, aggregateExchangeRateVotes, tobinTaxes, keeper.GetUndistributedRewards(ctx))
 }
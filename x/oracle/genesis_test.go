@@ -21,6 +21,7 @@ func TestExportInitGenesis(t *testing.T) {
 	input.OracleKeeper.AddAggregateExchangeRateVote(input.Ctx, NewAggregateExchangeRateVote(types.ExchangeRateTuples{{Denom: "foo", ExchangeRate: sdk.NewDec(123)}}, sdk.ValAddress{}))
 	input.OracleKeeper.SetTobinTax(input.Ctx, "denom", sdk.NewDecWithPrec(123, 3))
 	input.OracleKeeper.SetTobinTax(input.Ctx, "denom2", sdk.NewDecWithPrec(123, 3))
+	input.OracleKeeper.SetUndistributedRewards(input.Ctx, sdk.NewCoins(sdk.NewInt64Coin("denom", 123)))
 	genesis := ExportGenesis(input.Ctx, input.OracleKeeper)
 
 	newInput := keeper.CreateTestInput(t)
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/oracle/internal/types"
+)
+
+// RegisterInvariants registers all oracle invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "reward-pool", RewardPoolInvariant(k))
+}
+
+// RewardPoolInvariant checks that the reward pool, the balance of the oracle module account,
+// covers the seigniorage rewards sent to it and not yet given out to the ballot winners
+func RewardPoolInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		rewardPool := k.GetRewardPool(ctx)
+		undistributedRewards := k.GetUndistributedRewards(ctx)
+
+		var msg string
+		broken := !rewardPool.IsAllGTE(undistributedRewards)
+		if broken {
+			msg = fmt.Sprintf("\treward pool %s does not cover the undistributed rewards %s\n", rewardPool, undistributedRewards)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "reward pool", msg), broken
+	}
+}
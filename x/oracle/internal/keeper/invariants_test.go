@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/oracle/internal/types"
+)
+
+func TestRewardPoolInvariant(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := input.Ctx
+	invariant := RewardPoolInvariant(input.OracleKeeper)
+
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	acc := input.SupplyKeeper.GetModuleAccount(ctx, types.ModuleName)
+	require.NoError(t, acc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 30000000))))
+	input.SupplyKeeper.SetModuleAccount(ctx, acc)
+
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// the pool covers the undistributed rewards
+	input.OracleKeeper.SetUndistributedRewards(ctx, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 30000000)))
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// the undistributed rewards exceed the pool
+	input.OracleKeeper.SetUndistributedRewards(ctx, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 30000001)))
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+
+	// the undistributed rewards of a denom missing from the pool
+	input.OracleKeeper.SetUndistributedRewards(ctx, sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 1)))
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+}
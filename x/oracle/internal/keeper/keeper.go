@@ -452,6 +452,33 @@ func (k Keeper) ClearTobinTaxes(ctx sdk.Context) {
 	}
 }
 
+//-----------------------------------
+// Undistributed rewards logic
+
+// GetUndistributedRewards returns the rewards sent to the reward pool and not yet given out
+func (k Keeper) GetUndistributedRewards(ctx sdk.Context) (rewards sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.UndistributedRewardsKey)
+	if bz == nil {
+		return sdk.Coins{}
+	}
+
+	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &rewards)
+	return
+}
+
+// SetUndistributedRewards sets the rewards sent to the reward pool and not yet given out
+func (k Keeper) SetUndistributedRewards(ctx sdk.Context, rewards sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	if rewards.Empty() {
+		store.Delete(types.UndistributedRewardsKey)
+		return
+	}
+
+	bz := k.cdc.MustMarshalBinaryLengthPrefixed(rewards)
+	store.Set(types.UndistributedRewardsKey, bz)
+}
+
 // ValidateFeeder return the given feeder is allowed to feed the message or not
 func (k Keeper) ValidateFeeder(ctx sdk.Context, feederAddr sdk.AccAddress, validatorAddr sdk.ValAddress, checkBonded bool) error {
 	if !feederAddr.Equals(validatorAddr) {
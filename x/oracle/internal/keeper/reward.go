@@ -58,4 +58,20 @@ func (k Keeper) RewardBallotWinners(ctx sdk.Context, ballotWinners map[string]ty
 		panic(fmt.Sprintf("[oracle] Failed to send coins to distribution module %s", err.Error()))
 	}
 
+	// Deduct distributed reward from the undistributed rewards
+	if core.IsFeatureActive(ctx, core.FeatureOracleRewardTracking) {
+		k.SetUndistributedRewards(ctx, deductRewards(k.GetUndistributedRewards(ctx), distributedReward))
+	}
+}
+
+// deductRewards deducts the distributed reward from the undistributed rewards; the reward
+// pool can hold more than the undistributed rewards, so each denom is floored at zero
+func deductRewards(undistributed sdk.Coins, distributed sdk.Coins) sdk.Coins {
+	rewards := sdk.NewCoins()
+	for _, coin := range undistributed {
+		amt := coin.Amount.Sub(sdk.MinInt(coin.Amount, distributed.AmountOf(coin.Denom)))
+		rewards = rewards.Add(sdk.NewCoin(coin.Denom, amt))
+	}
+
+	return rewards
 }
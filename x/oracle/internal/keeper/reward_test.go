@@ -54,6 +54,7 @@ func TestRewardBallotWinners(t *testing.T) {
 	err = acc.SetCoins(givingAmt)
 	require.NoError(t, err)
 	input.SupplyKeeper.SetModuleAccount(ctx, acc)
+	input.OracleKeeper.SetUndistributedRewards(ctx, givingAmt)
 
 	votePeriodsPerWindow := sdk.NewDec(input.OracleKeeper.RewardDistributionWindow(input.Ctx)).QuoInt64(input.OracleKeeper.VotePeriod(input.Ctx)).TruncateInt64()
 	input.OracleKeeper.RewardBallotWinners(ctx, claims)
@@ -66,4 +67,23 @@ func TestRewardBallotWinners(t *testing.T) {
 	outstandingRewards1, _ := outstandingRewardsDec1.TruncateDecimal()
 	require.Equal(t, sdk.NewDecFromInt(givingAmt.AmountOf(core.MicroLunaDenom)).QuoInt64(votePeriodsPerWindow).QuoInt64(3).MulInt64(2).TruncateInt(),
 		outstandingRewards1.AmountOf(core.MicroLunaDenom))
+
+	// the distributed rewards are deducted from the undistributed rewards
+	distributedRewards := outstandingRewards.Add(outstandingRewards1...)
+	require.Equal(t, givingAmt.Sub(distributedRewards), input.OracleKeeper.GetUndistributedRewards(ctx))
+	require.Equal(t, givingAmt.Sub(distributedRewards), input.OracleKeeper.GetRewardPool(ctx))
+}
+
+func TestDeductRewards(t *testing.T) {
+	undistributed := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100), sdk.NewInt64Coin(core.MicroSDRDenom, 10))
+
+	require.Equal(t, undistributed, deductRewards(undistributed, sdk.Coins{}))
+	require.Equal(t,
+		sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 40), sdk.NewInt64Coin(core.MicroSDRDenom, 10)),
+		deductRewards(undistributed, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 60))))
+
+	// the rewards given out from the donations to the pool floor the undistributed rewards at zero
+	require.Equal(t,
+		sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 10)),
+		deductRewards(undistributed, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 150))))
 }
@@ -2,6 +2,7 @@ package types
 
 import (
 	"bytes"
+	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -17,6 +18,7 @@ type GenesisState struct {
 	AggregateExchangeRatePrevotes []AggregateExchangeRatePrevote `json:"aggregate_exchange_rate_prevotes" yaml:"aggregate_exchange_rate_prevotes"`
 	AggregateExchangeRateVotes    []AggregateExchangeRateVote    `json:"aggregate_exchange_rate_votes" yaml:"aggregate_exchange_rate_votes"`
 	TobinTaxes                    map[string]sdk.Dec             `json:"tobin_taxes" yaml:"tobin_taxes"`
+	UndistributedRewards          sdk.Coins                      `json:"undistributed_rewards" yaml:"undistributed_rewards"`
 }
 
 // NewGenesisState creates a new GenesisState object
@@ -27,6 +29,7 @@ func NewGenesisState(
 	aggregateExchangeRatePrevotes []AggregateExchangeRatePrevote,
 	aggregateExchangeRateVotes []AggregateExchangeRateVote,
 	TobinTaxes map[string]sdk.Dec,
+	undistributedRewards sdk.Coins,
 ) GenesisState {
 
 	return GenesisState{
@@ -39,6 +42,7 @@ func NewGenesisState(
 		AggregateExchangeRatePrevotes: aggregateExchangeRatePrevotes,
 		AggregateExchangeRateVotes:    aggregateExchangeRateVotes,
 		TobinTaxes:                    TobinTaxes,
+		UndistributedRewards:          undistributedRewards,
 	}
 }
 
@@ -54,11 +58,16 @@ func DefaultGenesisState() GenesisState {
 		AggregateExchangeRatePrevotes: []AggregateExchangeRatePrevote{},
 		AggregateExchangeRateVotes:    []AggregateExchangeRateVote{},
 		TobinTaxes:                    make(map[string]sdk.Dec),
+		UndistributedRewards:          sdk.Coins{},
 	}
 }
 
 // ValidateGenesis validates the oracle genesis parameters
 func ValidateGenesis(data GenesisState) error {
+	if !data.UndistributedRewards.IsValid() {
+		return fmt.Errorf("invalid undistributed rewards %s", data.UndistributedRewards)
+	}
+
 	return data.Params.ValidateBasic()
 }
 
@@ -36,6 +36,8 @@ const (
 // - 0x07<valAddress_Bytes>: AggregateExchangeRateVote
 //
 // - 0x08<denom_Bytes>: sdk.Dec
+//
+// - 0x09: sdk.Coins
 var (
 	// Keys for store prefixes
 	PrevoteKey                      = []byte{0x01} // prefix for each key to a prevote
@@ -46,6 +48,7 @@ var (
 	AggregateExchangeRatePrevoteKey = []byte{0x06} // prefix for each key to a aggregate prevote
 	AggregateExchangeRateVoteKey    = []byte{0x07} // prefix for each key to a aggregate vote
 	TobinTaxKey                     = []byte{0x08} // prefix for each key to a tobin tax
+	UndistributedRewardsKey         = []byte{0x09} // key for the undistributed rewards
 )
 
 // GetExchangeRatePrevoteKey - stored by *Validator* address and denom
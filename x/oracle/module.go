@@ -92,8 +92,10 @@ func NewAppModule(keeper Keeper, accountKeeper auth.AccountKeeper) AppModule {
 // Name returns the oracle module's name.
 func (AppModule) Name() string { return ModuleName }
 
-// RegisterInvariants performs a no-op.
-func (AppModule) RegisterInvariants(_ sdk.InvariantRegistry) {}
+// RegisterInvariants registers the oracle module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, am.keeper)
+}
 
 // Route returns the message routing key for the oracle module.
 func (AppModule) Route() string { return RouterKey }
@@ -0,0 +1,118 @@
+package wasm
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	wasmTypes "github.com/CosmWasm/go-cosmwasm/types"
+
+	wasm "github.com/terra-project/core/x/wasm/exported"
+)
+
+var _ wasm.WasmQuerierInterface = WasmQuerier{}
+
+// WasmQuerier - slashing query interface for wasm contract
+type WasmQuerier struct {
+	keeper        slashing.Keeper
+	stakingKeeper staking.Keeper
+}
+
+// NewWasmQuerier returns slashing wasm querier
+func NewWasmQuerier(keeper slashing.Keeper, stakingKeeper staking.Keeper) WasmQuerier {
+	return WasmQuerier{keeper, stakingKeeper}
+}
+
+// Query - implement query function
+func (WasmQuerier) Query(_ sdk.Context, _ wasmTypes.QueryRequest) ([]byte, error) { return nil, nil }
+
+// CosmosQuery contains various slashing queries
+type CosmosQuery struct {
+	SigningInfo *SigningInfoQuery `json:"signing_info,omitempty"`
+	Params      *struct{}         `json:"params,omitempty"`
+}
+
+// SigningInfoQuery queries the signing info of the validator
+type SigningInfoQuery struct {
+	Validator string `json:"validator"`
+}
+
+// SigningInfoQueryResponse - signing info query response for wasm module;
+// jailed until is a unix timestamp in seconds
+type SigningInfoQueryResponse struct {
+	StartHeight         int64 `json:"start_height"`
+	IndexOffset         int64 `json:"index_offset"`
+	JailedUntil         int64 `json:"jailed_until"`
+	Jailed              bool  `json:"jailed"`
+	Tombstoned          bool  `json:"tombstoned"`
+	MissedBlocksCounter int64 `json:"missed_blocks_counter"`
+}
+
+// ParamsQueryResponse - params query response for wasm module
+type ParamsQueryResponse struct {
+	SignedBlocksWindow int64 `json:"signed_blocks_window"`
+	MinSignedPerWindow int64 `json:"min_signed_per_window"`
+	// seconds
+	DowntimeJailDuration int64 `json:"downtime_jail_duration"`
+	// decimal strings, eg "0.05"
+	SlashFractionDoubleSign string `json:"slash_fraction_double_sign"`
+	SlashFractionDowntime   string `json:"slash_fraction_downtime"`
+}
+
+// QueryCustom implements custom query interface
+func (querier WasmQuerier) QueryCustom(ctx sdk.Context, data json.RawMessage) ([]byte, error) {
+	var query CosmosQuery
+	err := json.Unmarshal(data, &query)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	var res interface{}
+	switch {
+	case query.SigningInfo != nil:
+		valAddr, err := sdk.ValAddressFromBech32(query.SigningInfo.Validator)
+		if err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, query.SigningInfo.Validator)
+		}
+
+		validator, found := querier.stakingKeeper.GetValidator(ctx, valAddr)
+		if !found {
+			return nil, sdkerrors.Wrap(staking.ErrNoValidatorFound, query.SigningInfo.Validator)
+		}
+
+		info, found := querier.keeper.GetValidatorSigningInfo(ctx, validator.GetConsAddr())
+		if !found {
+			return nil, sdkerrors.Wrap(slashing.ErrNoSigningInfoFound, query.SigningInfo.Validator)
+		}
+
+		res = SigningInfoQueryResponse{
+			StartHeight:         info.StartHeight,
+			IndexOffset:         info.IndexOffset,
+			JailedUntil:         info.JailedUntil.Unix(),
+			Jailed:              validator.IsJailed(),
+			Tombstoned:          info.Tombstoned,
+			MissedBlocksCounter: info.MissedBlocksCounter,
+		}
+	case query.Params != nil:
+		params := querier.keeper.GetParams(ctx)
+		res = ParamsQueryResponse{
+			SignedBlocksWindow:      params.SignedBlocksWindow,
+			MinSignedPerWindow:      querier.keeper.MinSignedPerWindow(ctx),
+			DowntimeJailDuration:    int64(params.DowntimeJailDuration.Seconds()),
+			SlashFractionDoubleSign: params.SlashFractionDoubleSign.String(),
+			SlashFractionDowntime:   params.SlashFractionDowntime.String(),
+		}
+	default:
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	bz, err := json.Marshal(res)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+
+	return bz, nil
+}
@@ -0,0 +1,184 @@
+package wasm
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/crypto/secp256k1"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/cosmos/cosmos-sdk/x/slashing"
+	"github.com/cosmos/cosmos-sdk/x/staking"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	core "github.com/terra-project/core/types"
+)
+
+func createTestInput(t *testing.T) (sdk.Context, slashing.Keeper, staking.Keeper) {
+	keyAcc := sdk.NewKVStoreKey(auth.StoreKey)
+	keyParams := sdk.NewKVStoreKey(params.StoreKey)
+	tKeyParams := sdk.NewTransientStoreKey(params.TStoreKey)
+	keyStaking := sdk.NewKVStoreKey(staking.StoreKey)
+	keySupply := sdk.NewKVStoreKey(supply.StoreKey)
+	keySlashing := sdk.NewKVStoreKey(slashing.StoreKey)
+
+	cdc := codec.New()
+	auth.RegisterCodec(cdc)
+	sdk.RegisterCodec(cdc)
+	codec.RegisterCrypto(cdc)
+	supply.RegisterCodec(cdc)
+	staking.RegisterCodec(cdc)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ctx := sdk.NewContext(ms, abci.Header{Time: time.Now().UTC()}, false, log.NewNopLogger())
+
+	ms.MountStoreWithDB(keyAcc, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tKeyParams, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySupply, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySlashing, sdk.StoreTypeIAVL, db)
+
+	require.NoError(t, ms.LoadLatestVersion())
+
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tKeyParams)
+	accountKeeper := auth.NewAccountKeeper(cdc, keyAcc, paramsKeeper.Subspace(auth.DefaultParamspace), auth.ProtoBaseAccount)
+	bankKeeper := bank.NewBaseKeeper(accountKeeper, paramsKeeper.Subspace(bank.DefaultParamspace), map[string]bool{})
+
+	maccPerms := map[string][]string{
+		staking.NotBondedPoolName: {supply.Burner, supply.Staking},
+		staking.BondedPoolName:    {supply.Burner, supply.Staking},
+	}
+
+	supplyKeeper := supply.NewKeeper(cdc, keySupply, accountKeeper, bankKeeper, maccPerms)
+	stakingKeeper := staking.NewKeeper(cdc, keyStaking, supplyKeeper, paramsKeeper.Subspace(staking.DefaultParamspace))
+
+	stakingParams := staking.DefaultParams()
+	stakingParams.BondDenom = core.MicroLunaDenom
+	stakingKeeper.SetParams(ctx, stakingParams)
+
+	slashingKeeper := slashing.NewKeeper(cdc, keySlashing, stakingKeeper, paramsKeeper.Subspace(slashing.DefaultParamspace))
+	slashingKeeper.SetParams(ctx, slashing.DefaultParams())
+
+	return ctx, slashingKeeper, stakingKeeper
+}
+
+func TestQuerySigningInfo(t *testing.T) {
+	ctx, slashingKeeper, stakingKeeper := createTestInput(t)
+
+	pubKey := secp256k1.GenPrivKey().PubKey()
+	valAddr := sdk.ValAddress(pubKey.Address())
+	consAddr := sdk.ConsAddress(pubKey.Address())
+
+	validator := staking.NewValidator(valAddr, pubKey, staking.Description{})
+	validator.Jailed = true
+	stakingKeeper.SetValidator(ctx, validator)
+
+	jailedUntil := time.Unix(1600000000, 0).UTC()
+	slashingKeeper.SetValidatorSigningInfo(ctx, consAddr, slashing.NewValidatorSigningInfo(consAddr, 10, 3, jailedUntil, false, 2))
+
+	querier := NewWasmQuerier(slashingKeeper, stakingKeeper)
+	var err error
+
+	// empty data will occur error
+	_, err = querier.QueryCustom(ctx, []byte{})
+	require.True(t, sdkerrors.ErrJSONUnmarshal.Is(err))
+
+	// empty query will occur error
+	_, err = querier.QueryCustom(ctx, []byte("{}"))
+	require.True(t, sdkerrors.ErrInvalidRequest.Is(err))
+
+	// invalid validator address
+	bz, err := json.Marshal(CosmosQuery{
+		SigningInfo: &SigningInfoQuery{Validator: "invalid"},
+	})
+	require.NoError(t, err)
+
+	_, err = querier.QueryCustom(ctx, bz)
+	require.True(t, sdkerrors.ErrInvalidAddress.Is(err))
+
+	// not existing validator
+	bz, err = json.Marshal(CosmosQuery{
+		SigningInfo: &SigningInfoQuery{Validator: sdk.ValAddress(secp256k1.GenPrivKey().PubKey().Address()).String()},
+	})
+	require.NoError(t, err)
+
+	_, err = querier.QueryCustom(ctx, bz)
+	require.True(t, staking.ErrNoValidatorFound.Is(err))
+
+	// validator without signing info
+	otherPubKey := secp256k1.GenPrivKey().PubKey()
+	otherValAddr := sdk.ValAddress(otherPubKey.Address())
+	stakingKeeper.SetValidator(ctx, staking.NewValidator(otherValAddr, otherPubKey, staking.Description{}))
+
+	bz, err = json.Marshal(CosmosQuery{
+		SigningInfo: &SigningInfoQuery{Validator: otherValAddr.String()},
+	})
+	require.NoError(t, err)
+
+	_, err = querier.QueryCustom(ctx, bz)
+	require.True(t, slashing.ErrNoSigningInfoFound.Is(err))
+
+	// valid signing info query
+	bz, err = json.Marshal(CosmosQuery{
+		SigningInfo: &SigningInfoQuery{Validator: valAddr.String()},
+	})
+	require.NoError(t, err)
+
+	res, err := querier.QueryCustom(ctx, bz)
+	require.NoError(t, err)
+
+	var signingInfoResponse SigningInfoQueryResponse
+	require.NoError(t, json.Unmarshal(res, &signingInfoResponse))
+	require.Equal(t, SigningInfoQueryResponse{
+		StartHeight:         10,
+		IndexOffset:         3,
+		JailedUntil:         jailedUntil.Unix(),
+		Jailed:              true,
+		Tombstoned:          false,
+		MissedBlocksCounter: 2,
+	}, signingInfoResponse)
+}
+
+func TestQueryParams(t *testing.T) {
+	ctx, slashingKeeper, stakingKeeper := createTestInput(t)
+
+	params := slashing.DefaultParams()
+	params.SignedBlocksWindow = 200
+	params.MinSignedPerWindow = sdk.NewDecWithPrec(5, 2)
+	params.DowntimeJailDuration = time.Hour
+	slashingKeeper.SetParams(ctx, params)
+
+	querier := NewWasmQuerier(slashingKeeper, stakingKeeper)
+
+	bz, err := json.Marshal(CosmosQuery{
+		Params: &struct{}{},
+	})
+	require.NoError(t, err)
+
+	res, err := querier.QueryCustom(ctx, bz)
+	require.NoError(t, err)
+
+	var paramsResponse ParamsQueryResponse
+	require.NoError(t, json.Unmarshal(res, &paramsResponse))
+	require.Equal(t, ParamsQueryResponse{
+		SignedBlocksWindow:      200,
+		MinSignedPerWindow:      10,
+		DowntimeJailDuration:    3600,
+		SlashFractionDoubleSign: params.SlashFractionDoubleSign.String(),
+		SlashFractionDowntime:   params.SlashFractionDowntime.String(),
+	}, paramsResponse)
+}
@@ -3,6 +3,8 @@ package types
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/x/staking"
+
+	msgauthexported "github.com/terra-project/core/x/msgauth/exported"
 )
 
 // RegisterCodec registers concrete types on codec codec
@@ -22,4 +24,6 @@ func init() {
 	RegisterCodec(ModuleCdc)
 	codec.RegisterCrypto(ModuleCdc)
 	ModuleCdc.Seal()
+
+	msgauthexported.RegisterMsgAuthTypeCodec(staking.MsgDelegate{}, "staking/MsgDelegate")
 }
@@ -18,7 +18,12 @@ func RandomizedGenState(simState *module.SimulationState) {
 	numAccs := int64(len(simState.Accounts))
 	totalSupply := sdk.NewInt(simState.InitialStake * (numAccs + simState.NumBonded))
 	totalLunaSupply := sdk.NewInt(simState.InitialStake * numAccs)
-	supplyGenesis := supply.NewGenesisState(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, totalSupply), sdk.NewCoin(core.MicroLunaDenom, totalLunaSupply)))
+	totalSDRSupply := sdk.NewInt(simState.InitialStake * numAccs)
+	supplyGenesis := supply.NewGenesisState(sdk.NewCoins(
+		sdk.NewCoin(sdk.DefaultBondDenom, totalSupply),
+		sdk.NewCoin(core.MicroLunaDenom, totalLunaSupply),
+		sdk.NewCoin(core.MicroSDRDenom, totalSDRSupply),
+	))
 
 	fmt.Printf("Generated supply parameters:\n%s\n", codec.MustMarshalJSONIndent(simState.Cdc, supplyGenesis))
 	simState.GenState[supply.ModuleName] = simState.Cdc.MustMarshalJSON(supplyGenesis)
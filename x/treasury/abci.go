@@ -11,11 +11,22 @@ import (
 // EndBlocker is called at the end of every block
 func EndBlocker(ctx sdk.Context, k Keeper) {
 
+	// Store the missing tax caps of the current epoch at the activation
+	if core.IsFeatureActivationHeight(ctx, core.FeatureTreasuryTaxCaps) {
+		k.SetMissingTaxCaps(ctx, k.GetEpochInitialIssuance(ctx))
+	}
+
 	// Check epoch last block
 	if !core.IsPeriodLastBlock(ctx, core.BlocksPerWeek) {
 		return
 	}
 
+	// Store the missing tax caps of the next epoch after its issuance is recorded,
+	// including the denoms the tax caps were not updated for
+	if core.IsFeatureActive(ctx, core.FeatureTreasuryTaxCaps) {
+		defer func() { k.SetMissingTaxCaps(ctx, k.GetEpochInitialIssuance(ctx)) }()
+	}
+
 	// Update luna issuance after finish all works
 	defer k.RecordEpochInitialIssuance(ctx)
 
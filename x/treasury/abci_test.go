@@ -32,6 +32,54 @@ func TestEndBlockerIssuanceUpdate(t *testing.T) {
 	require.Equal(t, targetIssuance, issuance)
 }
 
+func TestEndBlockerMissingTaxCaps(t *testing.T) {
+	input := keeper.CreateTestInput(t)
+
+	// Set total staked luna to prevent divide by zero error when computing TRL
+	bondedModuleAcc := input.SupplyKeeper.GetModuleAccount(input.Ctx, stakingtypes.BondedPoolName)
+	err := bondedModuleAcc.SetCoins(sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000000000)))
+	require.NoError(t, err)
+	input.SupplyKeeper.SetModuleAccount(input.Ctx, bondedModuleAcc)
+
+	taxCaps := func() map[string]sdk.Int {
+		caps := make(map[string]sdk.Int)
+		input.TreasuryKeeper.IterateTaxCap(input.Ctx, func(denom string, taxCap sdk.Int) bool {
+			caps[denom] = taxCap
+			return false
+		})
+		return caps
+	}
+
+	// the missing tax caps are stored from the block 10
+	const chainID = "treasury-tax-caps-abci-test"
+	require.NoError(t, core.DefaultFeatureRegistry.SetActivationHeight(chainID, core.FeatureTreasuryTaxCaps, 10))
+	input.Ctx = input.Ctx.WithChainID(chainID).WithBlockHeight(9)
+
+	input.TreasuryKeeper.SetEpochInitialIssuance(input.Ctx, sdk.NewCoins(
+		sdk.NewInt64Coin(core.MicroLunaDenom, 1000),
+		sdk.NewInt64Coin(core.MicroKRWDenom, 1000),
+	))
+	EndBlocker(input.Ctx, input.TreasuryKeeper)
+	require.Len(t, taxCaps(), 0)
+
+	// the tax caps of the epoch initial issuance are stored at the activation
+	input.Ctx = input.Ctx.WithBlockHeight(10)
+	EndBlocker(input.Ctx, input.TreasuryKeeper)
+	taxCap := input.TreasuryKeeper.TaxPolicy(input.Ctx).Cap.Amount
+	require.Equal(t, map[string]sdk.Int{core.MicroKRWDenom: taxCap}, taxCaps())
+
+	// the tax caps of the next epoch are stored at the epoch end
+	supply := input.SupplyKeeper.GetSupply(input.Ctx)
+	supply = supply.SetTotal(sdk.NewCoins(
+		sdk.NewInt64Coin(core.MicroLunaDenom, 1000),
+		sdk.NewInt64Coin(core.MicroUSDDenom, 1000),
+	))
+	input.SupplyKeeper.SetSupply(input.Ctx, supply)
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerWeek - 1)
+	EndBlocker(input.Ctx, input.TreasuryKeeper)
+	require.Equal(t, map[string]sdk.Int{core.MicroKRWDenom: taxCap, core.MicroUSDDenom: taxCap}, taxCaps())
+}
+
 func TestUpdate(t *testing.T) {
 	input := keeper.CreateTestInput(t)
 
@@ -44,6 +44,9 @@ var (
 	ParamKeyTable                 = types.ParamKeyTable
 	NewKeeper                     = keeper.NewKeeper
 	NewQuerier                    = keeper.NewQuerier
+	RegisterInvariants            = keeper.RegisterInvariants
+	TaxProceedsInvariant          = keeper.TaxProceedsInvariant
+	TaxCapsInvariant              = keeper.TaxCapsInvariant
 
 	// variable aliases
 	ModuleCdc                            = types.ModuleCdc
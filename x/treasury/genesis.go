@@ -28,6 +28,10 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data GenesisState) {
 		keeper.SetTaxCap(ctx, denom, taxCap)
 	}
 
+	if core.IsFeatureActive(ctx, core.FeatureTreasuryTaxCaps) {
+		keeper.SetMissingTaxCaps(ctx, keeper.GetEpochInitialIssuance(ctx))
+	}
+
 	// store cumulated block height of past chains
 	keeper.SetCumulativeHeight(ctx, data.CumulativeHeight)
 
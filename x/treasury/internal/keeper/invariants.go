@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/treasury/internal/types"
+)
+
+// RegisterInvariants registers all treasury invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "tax-proceeds", TaxProceedsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "tax-caps", TaxCapsInvariant(k))
+}
+
+// TaxProceedsInvariant checks that the tax proceeds of the epoch are valid
+// coins, without luna which is never taxed
+func TaxProceedsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		taxProceeds := k.PeekEpochTaxProceeds(ctx)
+
+		var msg string
+		broken := !taxProceeds.IsValid()
+		if broken {
+			msg = fmt.Sprintf("\tinvalid tax proceeds %s\n", taxProceeds)
+		} else if amount := taxProceeds.AmountOf(core.MicroLunaDenom); !amount.IsZero() {
+			msg = fmt.Sprintf("\ttax proceeds hold %s%s\n", amount, core.MicroLunaDenom)
+			broken = true
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "tax proceeds", msg), broken
+	}
+}
+
+// TaxCapsInvariant checks that a tax cap is stored for every denom of the epoch initial
+// issuance, except luna which is never taxed and sdr of which the tax cap is the TaxPolicy
+// cap, and that the tax caps of the supply are positive; the denoms which came into the supply during the
+// epoch are capped by the TaxPolicy cap until the epoch end stores their tax caps
+func TaxCapsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var broken int
+
+		// the missing tax caps are stored at the end of the activation block
+		if core.IsFeatureActive(ctx.WithBlockHeight(ctx.BlockHeight()-1), core.FeatureTreasuryTaxCaps) {
+			store := ctx.KVStore(k.storeKey)
+			for _, coin := range k.GetEpochInitialIssuance(ctx) {
+				if coin.Denom == core.MicroLunaDenom || coin.Denom == core.MicroSDRDenom {
+					continue
+				}
+
+				if !store.Has(types.GetTaxCapKey(coin.Denom)) {
+					msg += fmt.Sprintf("\tno tax cap of %s\n", coin.Denom)
+					broken++
+				}
+			}
+		}
+
+		supply := k.supplyKeeper.GetSupply(ctx).GetTotal()
+		k.IterateTaxCap(ctx, func(denom string, taxCap sdk.Int) bool {
+			if supply.AmountOf(denom).IsPositive() && !taxCap.IsPositive() {
+				msg += fmt.Sprintf("\tnon positive tax cap %s%s\n", taxCap, denom)
+				broken++
+			}
+
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "tax caps",
+			fmt.Sprintf("found %d missing or invalid tax caps\n%s", broken, msg)), broken != 0
+	}
+}
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/supply"
+
+	core "github.com/terra-project/core/types"
+)
+
+func TestTaxProceedsInvariant(t *testing.T) {
+	input := CreateTestInput(t)
+	invariant := TaxProceedsInvariant(input.TreasuryKeeper)
+
+	_, broken := invariant(input.Ctx)
+	require.False(t, broken)
+
+	input.TreasuryKeeper.RecordEpochTaxProceeds(input.Ctx, sdk.NewCoins(sdk.NewInt64Coin(core.MicroSDRDenom, 100)))
+	_, broken = invariant(input.Ctx)
+	require.False(t, broken)
+
+	// luna is never taxed
+	input.TreasuryKeeper.RecordEpochTaxProceeds(input.Ctx, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100)))
+	_, broken = invariant(input.Ctx)
+	require.True(t, broken)
+
+	// unsorted coins
+	input.TreasuryKeeper.SetEpochTaxProceeds(input.Ctx, sdk.Coins{
+		sdk.NewInt64Coin(core.MicroSDRDenom, 100),
+		sdk.NewInt64Coin(core.MicroKRWDenom, 100),
+	})
+	_, broken = invariant(input.Ctx)
+	require.True(t, broken)
+}
+
+func TestTaxCapsInvariant(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx := input.Ctx.WithBlockHeight(1)
+	invariant := TaxCapsInvariant(input.TreasuryKeeper)
+
+	input.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins(
+		sdk.NewInt64Coin(core.MicroLunaDenom, 100),
+		sdk.NewInt64Coin(core.MicroSDRDenom, 100),
+		sdk.NewInt64Coin(core.MicroKRWDenom, 100),
+	)))
+	input.TreasuryKeeper.RecordEpochInitialIssuance(ctx)
+
+	// luna and sdr need no tax cap
+	input.TreasuryKeeper.SetTaxCap(ctx, core.MicroKRWDenom, sdk.NewInt(1000000))
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// a denom of the epoch initial issuance without a tax cap
+	input.TreasuryKeeper.SetEpochInitialIssuance(ctx, sdk.NewCoins(
+		sdk.NewInt64Coin(core.MicroKRWDenom, 100),
+		sdk.NewInt64Coin(core.MicroUSDDenom, 100),
+	))
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+
+	input.TreasuryKeeper.SetMissingTaxCaps(ctx, input.TreasuryKeeper.GetEpochInitialIssuance(ctx))
+	require.Equal(t, input.TreasuryKeeper.TaxPolicy(ctx).Cap.Amount, input.TreasuryKeeper.GetTaxCap(ctx, core.MicroUSDDenom))
+	require.Equal(t, sdk.NewInt(1000000), input.TreasuryKeeper.GetTaxCap(ctx, core.MicroKRWDenom))
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// a denom which came into the supply during the epoch is capped by the tax policy
+	input.SupplyKeeper.SetSupply(ctx, supply.NewSupply(sdk.NewCoins(
+		sdk.NewInt64Coin(core.MicroKRWDenom, 100),
+		sdk.NewInt64Coin(core.MicroMNTDenom, 100),
+	)))
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// a zero tax cap of a denom out of the supply is never used
+	input.TreasuryKeeper.SetTaxCap(ctx, core.MicroEURDenom, sdk.ZeroInt())
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	input.TreasuryKeeper.SetTaxCap(ctx, core.MicroKRWDenom, sdk.ZeroInt())
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+}
+
+func TestTaxCapsInvariantBeforeActivation(t *testing.T) {
+	input := CreateTestInput(t)
+	invariant := TaxCapsInvariant(input.TreasuryKeeper)
+
+	// the missing tax caps are stored at the end of the block 10
+	const chainID = "treasury-tax-caps-test"
+	require.NoError(t, core.DefaultFeatureRegistry.SetActivationHeight(chainID, core.FeatureTreasuryTaxCaps, 10))
+	ctx := input.Ctx.WithChainID(chainID).WithBlockHeight(10)
+
+	input.TreasuryKeeper.SetEpochInitialIssuance(ctx, sdk.NewCoins(sdk.NewInt64Coin(core.MicroKRWDenom, 100)))
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	_, broken = invariant(ctx.WithBlockHeight(11))
+	require.True(t, broken)
+}
@@ -25,6 +25,7 @@ type Keeper struct {
 	marketKeeper  types.MarketKeeper
 	stakingKeeper types.StakingKeeper
 	distrKeeper   types.DistributionKeeper
+	oracleKeeper  types.OracleKeeper
 
 	oracleModuleName       string
 	distributionModuleName string
@@ -34,7 +35,7 @@ type Keeper struct {
 func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspace,
 	supplyKeeper types.SupplyKeeper, marketKeeper types.MarketKeeper,
 	stakingKeeper types.StakingKeeper, distrKeeper types.DistributionKeeper,
-	oracleModuleName string, distributionModuleName string) Keeper {
+	oracleKeeper types.OracleKeeper, oracleModuleName string, distributionModuleName string) Keeper {
 
 	// ensure treasury module account is set
 	if addr := supplyKeeper.GetModuleAddress(types.ModuleName); addr == nil {
@@ -54,6 +55,7 @@ func NewKeeper(cdc *codec.Codec, storeKey sdk.StoreKey, paramSpace params.Subspa
 		marketKeeper:           marketKeeper,
 		stakingKeeper:          stakingKeeper,
 		distrKeeper:            distrKeeper,
+		oracleKeeper:           oracleKeeper,
 		oracleModuleName:       oracleModuleName,
 		distributionModuleName: distributionModuleName,
 	}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/treasury/internal/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -29,6 +30,23 @@ func (k Keeper) UpdateTaxCap(ctx sdk.Context) sdk.Coins {
 	return newCaps
 }
 
+// SetMissingTaxCaps stores the TaxPolicy cap as the tax cap of the denoms without a tax cap,
+// which were capped by it already, so every taxed denom of the epoch has a tax cap
+func (k Keeper) SetMissingTaxCaps(ctx sdk.Context, coins sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	cap := k.TaxPolicy(ctx).Cap.Amount
+	for _, coin := range coins {
+		// ignore uluna tax cap (uluna has no tax); sdr tax cap is the TaxPolicy cap
+		if coin.Denom == core.MicroLunaDenom || coin.Denom == core.MicroSDRDenom {
+			continue
+		}
+
+		if !store.Has(types.GetTaxCapKey(coin.Denom)) {
+			k.SetTaxCap(ctx, coin.Denom, cap)
+		}
+	}
+}
+
 // UpdateTaxPolicy updates tax-rate with t(t+1) = t(t) * (TL_year(t) + INC) / TL_month(t)
 func (k Keeper) UpdateTaxPolicy(ctx sdk.Context) (newTaxRate sdk.Dec) {
 	params := k.GetParams(ctx)
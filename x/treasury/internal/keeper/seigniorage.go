@@ -39,6 +39,12 @@ func (k Keeper) SettleSeigniorage(ctx sdk.Context) {
 		panic(err)
 	}
 
+	// Update oracle undistributed rewards
+	if core.IsFeatureActive(ctx, core.FeatureOracleRewardTracking) {
+		undistributedRewards := k.oracleKeeper.GetUndistributedRewards(ctx)
+		k.oracleKeeper.SetUndistributedRewards(ctx, undistributedRewards.Add(oracleRewardCoins...))
+	}
+
 	// Send left to distribution module
 	leftAmt := seigniorageAmt.Sub(oracleRewardAmt)
 	leftCoins := sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, leftAmt))
@@ -37,4 +37,28 @@ func TestSettle(t *testing.T) {
 
 	require.Equal(t, oracleRewardAmt, oracleAcc.GetCoins().AmountOf(core.MicroLunaDenom))
 	require.Equal(t, leftAmt, feePool.CommunityPool.AmountOf(core.MicroLunaDenom).TruncateInt())
+	require.Equal(t, oracleAcc.GetCoins(), input.OracleKeeper.GetUndistributedRewards(input.Ctx))
+}
+
+func TestSettleBeforeOracleRewardTracking(t *testing.T) {
+	input := CreateTestInput(t)
+
+	// the oracle reward tracking is enabled after the settlement
+	const chainID = "oracle-reward-tracking-test"
+	require.NoError(t, core.DefaultFeatureRegistry.SetActivationHeight(chainID, core.FeatureOracleRewardTracking, core.BlocksPerWeek+1))
+	input.Ctx = input.Ctx.WithChainID(chainID)
+
+	supply := input.SupplyKeeper.GetSupply(input.Ctx)
+	supply = supply.SetTotal(sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1000000)))
+	input.SupplyKeeper.SetSupply(input.Ctx, supply)
+	input.TreasuryKeeper.RecordEpochInitialIssuance(input.Ctx)
+
+	input.Ctx = input.Ctx.WithBlockHeight(core.BlocksPerWeek)
+	supply = supply.SetTotal(sdk.NewCoins(sdk.NewCoin(core.MicroLunaDenom, sdk.ZeroInt())))
+	input.SupplyKeeper.SetSupply(input.Ctx, supply)
+
+	input.TreasuryKeeper.SettleSeigniorage(input.Ctx)
+	oracleAcc := input.SupplyKeeper.GetModuleAccount(input.Ctx, input.TreasuryKeeper.oracleModuleName)
+	require.False(t, oracleAcc.GetCoins().Empty())
+	require.True(t, input.OracleKeeper.GetUndistributedRewards(input.Ctx).Empty())
 }
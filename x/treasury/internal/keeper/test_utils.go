@@ -176,7 +176,7 @@ func CreateTestInput(t *testing.T) TestInput {
 		cdc,
 		keyTreasury, paramsKeeper.Subspace(types.DefaultParamspace),
 		supplyKeeper, marketKeeper, stakingKeeper, distrKeeper,
-		oracle.ModuleName, distr.ModuleName,
+		oracleKeeper, oracle.ModuleName, distr.ModuleName,
 	)
 
 	treasuryKeeper.SetParams(ctx, types.DefaultParams())
@@ -30,3 +30,9 @@ type DistributionKeeper interface {
 	GetFeePool(ctx sdk.Context) (feePool distrtypes.FeePool)
 	SetFeePool(ctx sdk.Context, feePool distrtypes.FeePool)
 }
+
+// OracleKeeper expected keeper for oracle module
+type OracleKeeper interface {
+	GetUndistributedRewards(ctx sdk.Context) sdk.Coins
+	SetUndistributedRewards(ctx sdk.Context, rewards sdk.Coins)
+}
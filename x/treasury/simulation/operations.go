@@ -0,0 +1,117 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/simapp/helpers"
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/auth/ante"
+	"github.com/terra-project/core/x/treasury/internal/keeper"
+	"github.com/terra-project/core/x/treasury/internal/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgSendWithTax = "op_weight_msg_send_with_tax"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simulation.AppParams, cdc *codec.Codec, ak authkeeper.AccountKeeper, bk bank.Keeper, k keeper.Keeper,
+) simulation.WeightedOperations {
+
+	var weightMsgSendWithTax int
+	appParams.GetOrGenerate(cdc, OpWeightMsgSendWithTax, &weightMsgSendWithTax, nil,
+		func(_ *rand.Rand) {
+			weightMsgSendWithTax = simappparams.DefaultWeightMsgSend
+		},
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgSendWithTax,
+			SimulateMsgSendWithTax(ak, bk, k),
+		),
+	}
+}
+
+// SimulateMsgSendWithTax generates a MsgSend of terra coins paying the stability tax as fees,
+// and checks the tax is added to the tax proceeds of the epoch.
+// nolint: funlen
+func SimulateMsgSendWithTax(ak authkeeper.AccountKeeper, bk bank.Keeper, k keeper.Keeper) simulation.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
+	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
+		if !bk.GetSendEnabled(ctx) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		simAccount, _ := simulation.RandomAcc(r, accs)
+		toSimAcc, _ := simulation.RandomAcc(r, accs)
+		if simAccount.Address.Equals(toSimAcc.Address) {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		account := ak.GetAccount(ctx, simAccount.Address)
+		spendableCoins := account.SpendableCoins(ctx.BlockTime())
+
+		// only the terra coins are taxed
+		var terraCoins sdk.Coins
+		for _, coin := range spendableCoins {
+			if coin.Denom != core.MicroLunaDenom && coin.Denom != sdk.DefaultBondDenom {
+				terraCoins = append(terraCoins, coin)
+			}
+		}
+
+		sendCoins := simulation.RandSubsetCoins(r, terraCoins)
+		if sendCoins.Empty() {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		msg := bank.NewMsgSend(simAccount.Address, toSimAcc.Address, sendCoins)
+		taxes := ante.FilterMsgAndComputeTax(ctx, k, []sdk.Msg{msg})
+		if _, hasNeg := spendableCoins.SafeSub(sendCoins.Add(taxes...)); hasNeg {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
+		}
+
+		taxProceeds := k.PeekEpochTaxProceeds(ctx)
+
+		tx := helpers.GenTx(
+			[]sdk.Msg{msg},
+			taxes,
+			helpers.DefaultGenTxGas,
+			chainID,
+			[]uint64{account.GetAccountNumber()},
+			[]uint64{account.GetSequence()},
+			simAccount.PrivKey,
+		)
+
+		_, _, err := app.Deliver(tx)
+		if err != nil {
+			if strings.Contains(err.Error(), "insufficient fee") {
+				return simulation.NoOpMsg(types.ModuleName), nil, nil
+			}
+
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		if expected := taxProceeds.Add(taxes...); !k.PeekEpochTaxProceeds(ctx).IsEqual(expected) {
+			return simulation.NoOpMsg(types.ModuleName), nil,
+				fmt.Errorf("tax proceeds %s after paying %s; expected %s", k.PeekEpochTaxProceeds(ctx), taxes, expected)
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
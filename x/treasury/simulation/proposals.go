@@ -58,7 +58,7 @@ func SimulateRewardWeightUpdateProposalContent(k keeper.Keeper) simulation.Conte
 		diff := rewardPolicy.RateMax.Sub(rewardPolicy.RateMin)
 		rewardWeight := simulation.RandomDecAmount(r, diff).Add(rewardPolicy.RateMin)
 
-		return types.NewTaxRateUpdateProposal(
+		return types.NewRewardWeightUpdateProposal(
 			simulation.RandStringOfLength(r, 10),
 			simulation.RandStringOfLength(r, 100),
 			rewardWeight,
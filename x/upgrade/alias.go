@@ -1,13 +1,40 @@
 // nolint
 // autogenerated code using github.com/rigelrozanski/multitool
 // aliases generated for the following subdirectories:
+// ALIASGEN: github.com/terra-project/core/x/upgrade/internal/keeper/
 // ALIASGEN: github.com/terra-project/core/x/upgrade/internal/types/
 package upgrade
 
 import (
+	"github.com/terra-project/core/x/upgrade/internal/keeper"
 	"github.com/terra-project/core/x/upgrade/internal/types"
 )
 
+const (
+	InitialConsensusVersion = types.InitialConsensusVersion
+)
+
 var (
-	RegisterCodec = types.RegisterCodec
+	// functions aliases
+	NewVersionKeeper     = keeper.NewVersionKeeper
+	RegisterCodec        = types.RegisterCodec
+	NewMigrationRegistry = types.NewMigrationRegistry
+	ConsensusVersions    = types.ConsensusVersions
+	GetModuleVersionKey  = types.GetModuleVersionKey
+
+	// variable aliases
+	ErrDuplicateMigration = types.ErrDuplicateMigration
+	ErrMissingMigration   = types.ErrMissingMigration
+	ErrInvalidVersion     = types.ErrInvalidVersion
+	ErrMigrationFailed    = types.ErrMigrationFailed
+	ModuleVersionKey      = types.ModuleVersionKey
+)
+
+type (
+	VersionKeeper       = keeper.VersionKeeper
+	VersionMap          = types.VersionMap
+	HasConsensusVersion = types.HasConsensusVersion
+	HasMigrations       = types.HasMigrations
+	MigrationHandler    = types.MigrationHandler
+	MigrationRegistry   = types.MigrationRegistry
 )
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/upgrade/internal/types"
+)
+
+// VersionKeeper tracks the consensus versions of the modules in the upgrade store
+type VersionKeeper struct {
+	storeKey sdk.StoreKey
+}
+
+// NewVersionKeeper creates a new VersionKeeper on the upgrade store
+func NewVersionKeeper(storeKey sdk.StoreKey) VersionKeeper {
+	return VersionKeeper{storeKey: storeKey}
+}
+
+// GetModuleVersions returns the stored consensus versions of the modules,
+// which are empty until the versions are first set
+func (k VersionKeeper) GetModuleVersions(ctx sdk.Context) types.VersionMap {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ModuleVersionKey)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	vm := make(types.VersionMap)
+	for ; iter.Valid(); iter.Next() {
+		vm[string(iter.Key())] = binary.BigEndian.Uint64(iter.Value())
+	}
+
+	return vm
+}
+
+// SetModuleVersions replaces the stored consensus versions of the modules
+func (k VersionKeeper) SetModuleVersions(ctx sdk.Context, vm types.VersionMap) {
+	store := ctx.KVStore(k.storeKey)
+	for moduleName := range k.GetModuleVersions(ctx) {
+		if _, ok := vm[moduleName]; !ok {
+			store.Delete(types.GetModuleVersionKey(moduleName))
+		}
+	}
+
+	for moduleName, version := range vm {
+		store.Set(types.GetModuleVersionKey(moduleName), sdk.Uint64ToBigEndian(version))
+	}
+}
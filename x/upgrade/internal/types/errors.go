@@ -0,0 +1,14 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+)
+
+// Upgrade errors
+var (
+	ErrDuplicateMigration = sdkerrors.Register(upgrade.ModuleName, 1, "duplicate migration")
+	ErrMissingMigration   = sdkerrors.Register(upgrade.ModuleName, 2, "missing migration")
+	ErrInvalidVersion     = sdkerrors.Register(upgrade.ModuleName, 3, "invalid consensus version")
+	ErrMigrationFailed    = sdkerrors.Register(upgrade.ModuleName, 4, "migration failed")
+)
@@ -0,0 +1,16 @@
+package types
+
+// Keys for the consensus versions in the upgrade store, after
+// the plan and done keys of the upgrade keeper
+// Items are stored with the following key: values
+//
+// - 0x10<moduleName_Bytes>: uint64
+var (
+	// Keys for store prefixes
+	ModuleVersionKey = []byte{0x10} // prefix for each key to a module consensus version
+)
+
+// GetModuleVersionKey returns the key of the consensus version of the module
+func GetModuleVersionKey(moduleName string) []byte {
+	return append(ModuleVersionKey, []byte(moduleName)...)
+}
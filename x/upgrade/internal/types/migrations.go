@@ -0,0 +1,132 @@
+package types
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// InitialConsensusVersion is the consensus version of the modules not
+// implementing HasConsensusVersion, and of all the modules of the chains
+// upgraded before the consensus versions were tracked
+const InitialConsensusVersion uint64 = 1
+
+// VersionMap maps the module names to their consensus versions
+type VersionMap map[string]uint64
+
+// HasConsensusVersion is implemented by the modules versioning the layout of their store;
+// the version is bumped with a migration from the previous one on every breaking change
+type HasConsensusVersion interface {
+	ConsensusVersion() uint64
+}
+
+// HasMigrations is implemented by the modules with in-place store migrations
+type HasMigrations interface {
+	RegisterMigrations(registry MigrationRegistry) error
+}
+
+// MigrationHandler migrates the store of a module in place from
+// a consensus version to the next one
+type MigrationHandler func(ctx sdk.Context) error
+
+// MigrationRegistry holds the migrations of the modules by the consensus version they migrate from
+type MigrationRegistry struct {
+	migrations map[string]map[uint64]MigrationHandler
+}
+
+// NewMigrationRegistry returns a registry without migrations
+func NewMigrationRegistry() MigrationRegistry {
+	return MigrationRegistry{migrations: make(map[string]map[uint64]MigrationHandler)}
+}
+
+// RegisterMigration registers the migration of the module from the consensus version to the next one
+func (r MigrationRegistry) RegisterMigration(moduleName string, fromVersion uint64, handler MigrationHandler) error {
+	if fromVersion < InitialConsensusVersion {
+		return sdkerrors.Wrapf(ErrInvalidVersion, "migration of %s from version %d", moduleName, fromVersion)
+	}
+
+	if r.migrations[moduleName] == nil {
+		r.migrations[moduleName] = make(map[uint64]MigrationHandler)
+	}
+
+	if _, ok := r.migrations[moduleName][fromVersion]; ok {
+		return sdkerrors.Wrapf(ErrDuplicateMigration, "migration of %s from version %d", moduleName, fromVersion)
+	}
+
+	r.migrations[moduleName][fromVersion] = handler
+	return nil
+}
+
+// RunMigrations migrates the modules of the manager from their versions of fromVM to their
+// consensus versions, in the init genesis order, and returns the new consensus versions;
+// the modules missing in fromVM are added by the upgrade and initialized from their default genesis
+func (r MigrationRegistry) RunMigrations(ctx sdk.Context, mm *module.Manager, fromVM VersionMap) (VersionMap, error) {
+	toVM := ConsensusVersions(mm)
+	for _, moduleName := range migrationOrder(mm) {
+		toVersion := toVM[moduleName]
+		fromVersion, ok := fromVM[moduleName]
+		if !ok {
+			am := mm.Modules[moduleName]
+			if updates := am.InitGenesis(ctx, am.DefaultGenesis()); len(updates) != 0 {
+				return nil, sdkerrors.Wrapf(ErrMigrationFailed, "added module %s returned validator updates", moduleName)
+			}
+
+			continue
+		}
+
+		if fromVersion > toVersion {
+			return nil, sdkerrors.Wrapf(ErrInvalidVersion, "%s is at version %d, newer than version %d", moduleName, fromVersion, toVersion)
+		}
+
+		for version := fromVersion; version < toVersion; version++ {
+			handler, ok := r.migrations[moduleName][version]
+			if !ok {
+				return nil, sdkerrors.Wrapf(ErrMissingMigration, "migration of %s from version %d", moduleName, version)
+			}
+
+			if err := handler(ctx); err != nil {
+				return nil, sdkerrors.Wrapf(ErrMigrationFailed, "migration of %s from version %d: %s", moduleName, version, err)
+			}
+		}
+	}
+
+	return toVM, nil
+}
+
+// ConsensusVersions returns the consensus versions of the modules of the manager
+func ConsensusVersions(mm *module.Manager) VersionMap {
+	vm := make(VersionMap, len(mm.Modules))
+	for moduleName, am := range mm.Modules {
+		vm[moduleName] = InitialConsensusVersion
+		if v, ok := am.(HasConsensusVersion); ok {
+			vm[moduleName] = v.ConsensusVersion()
+		}
+	}
+
+	return vm
+}
+
+// migrationOrder returns the init genesis order of the manager followed
+// by the modules missing in it, so that every module is migrated
+func migrationOrder(mm *module.Manager) []string {
+	ordered := make(map[string]bool, len(mm.OrderInitGenesis))
+	order := make([]string, 0, len(mm.Modules))
+	for _, moduleName := range mm.OrderInitGenesis {
+		if _, ok := mm.Modules[moduleName]; ok && !ordered[moduleName] {
+			ordered[moduleName] = true
+			order = append(order, moduleName)
+		}
+	}
+
+	var rest []string
+	for moduleName := range mm.Modules {
+		if !ordered[moduleName] {
+			rest = append(rest, moduleName)
+		}
+	}
+
+	sort.Strings(rest)
+	return append(order, rest...)
+}
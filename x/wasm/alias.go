@@ -11,73 +11,155 @@ import (
 )
 
 const (
-	DefaultFeatures            = types.DefaultFeatures
-	ModuleName                 = types.ModuleName
-	StoreKey                   = types.StoreKey
-	TStoreKey                  = types.TStoreKey
-	QuerierRoute               = types.QuerierRoute
-	RouterKey                  = types.RouterKey
-	WasmMsgParserRouteBank     = types.WasmMsgParserRouteBank
-	WasmMsgParserRouteStaking  = types.WasmMsgParserRouteStaking
-	WasmMsgParserRouteMarket   = types.WasmMsgParserRouteMarket
-	WasmMsgParserRouteWasm     = types.WasmMsgParserRouteWasm
-	DefaultParamspace          = types.DefaultParamspace
-	EnforcedMaxContractSize    = types.EnforcedMaxContractSize
-	EnforcedMaxContractGas     = types.EnforcedMaxContractGas
-	EnforcedMaxContractMsgSize = types.EnforcedMaxContractMsgSize
-	DefaultMaxContractSize     = types.DefaultMaxContractSize
-	DefaultMaxContractGas      = types.DefaultMaxContractGas
-	DefaultMaxContractMsgSize  = types.DefaultMaxContractMsgSize
-	QueryGetByteCode           = types.QueryGetByteCode
-	QueryGetCodeInfo           = types.QueryGetCodeInfo
-	QueryGetContractInfo       = types.QueryGetContractInfo
-	QueryRawStore              = types.QueryRawStore
-	QueryContractStore         = types.QueryContractStore
-	WasmQueryRouteBank         = types.WasmQueryRouteBank
-	WasmQueryRouteStaking      = types.WasmQueryRouteStaking
-	WasmQueryRouteMarket       = types.WasmQueryRouteMarket
-	WasmQueryRouteOracle       = types.WasmQueryRouteOracle
-	WasmQueryRouteTreasury     = types.WasmQueryRouteTreasury
-	WasmQueryRouteWasm         = types.WasmQueryRouteWasm
+	DefaultFeatures                  = types.DefaultFeatures
+	MinCustomEventTypeLength         = types.MinCustomEventTypeLength
+	MaxCustomEventTypeLength         = types.MaxCustomEventTypeLength
+	MaxCustomEventAttributeKeyLength = types.MaxCustomEventAttributeKeyLength
+	ModuleName                       = types.ModuleName
+	StoreKey                         = types.StoreKey
+	TStoreKey                        = types.TStoreKey
+	QuerierRoute                     = types.QuerierRoute
+	RouterKey                        = types.RouterKey
+	WasmMsgParserRouteBank           = types.WasmMsgParserRouteBank
+	WasmMsgParserRouteStaking        = types.WasmMsgParserRouteStaking
+	WasmMsgParserRouteMarket         = types.WasmMsgParserRouteMarket
+	WasmMsgParserRouteWasm           = types.WasmMsgParserRouteWasm
+	WasmMsgParserRouteDistribution   = types.WasmMsgParserRouteDistribution
+	WasmMsgParserRouteGov            = types.WasmMsgParserRouteGov
+	WasmCustomMsgRouteSubMsg         = types.WasmCustomMsgRouteSubMsg
+	ReplyAlways                      = types.ReplyAlways
+	ReplyError                       = types.ReplyError
+	ReplySuccess                     = types.ReplySuccess
+	AccessTypeUnspecified            = types.AccessTypeUnspecified
+	AccessTypeNobody                 = types.AccessTypeNobody
+	AccessTypeOnlyAddresses          = types.AccessTypeOnlyAddresses
+	AccessTypeEverybody              = types.AccessTypeEverybody
+	MaxLabelSize                     = types.MaxLabelSize
+	MaxSaltSize                      = types.MaxSaltSize
+	ContractHistoryOperationInit     = types.ContractHistoryOperationInit
+	ContractHistoryOperationMigrate  = types.ContractHistoryOperationMigrate
+	ContractHistoryOperationGenesis  = types.ContractHistoryOperationGenesis
+	DefaultParamspace                = types.DefaultParamspace
+	EnforcedMaxContractSize          = types.EnforcedMaxContractSize
+	EnforcedMaxContractGas           = types.EnforcedMaxContractGas
+	EnforcedMaxContractMsgSize       = types.EnforcedMaxContractMsgSize
+	EnforcedMaxContractQueryDepth    = types.EnforcedMaxContractQueryDepth
+	DefaultMaxContractSize           = types.DefaultMaxContractSize
+	DefaultMaxContractGas            = types.DefaultMaxContractGas
+	DefaultMaxContractMsgSize        = types.DefaultMaxContractMsgSize
+	DefaultMaxQueryDepth             = types.DefaultMaxQueryDepth
+	ProposalTypeStoreCode            = types.ProposalTypeStoreCode
+	ProposalTypeInstantiateContract  = types.ProposalTypeInstantiateContract
+	ProposalTypeMigrateContract      = types.ProposalTypeMigrateContract
+	ProposalTypeUpdateContractAdmin  = types.ProposalTypeUpdateContractAdmin
+	QueryGetByteCode                 = types.QueryGetByteCode
+	QueryGetCodeInfo                 = types.QueryGetCodeInfo
+	QueryGetContractInfo             = types.QueryGetContractInfo
+	QueryRawStore                    = types.QueryRawStore
+	QueryContractStore               = types.QueryContractStore
+	QueryParameters                  = types.QueryParameters
+	QueryContractHistory             = types.QueryContractHistory
+	QueryContractsByCode             = types.QueryContractsByCode
+	QueryContractsByOwner            = types.QueryContractsByOwner
+	DefaultContractsQueryLimit       = types.DefaultContractsQueryLimit
+	QueryContractStoreList           = types.QueryContractStoreList
+	QueryPredictAddress              = types.QueryPredictAddress
+	DefaultContractStoreListLimit    = types.DefaultContractStoreListLimit
+	MaxContractStoreListLimit        = types.MaxContractStoreListLimit
+	WasmQueryRouteBank               = types.WasmQueryRouteBank
+	WasmQueryRouteStaking            = types.WasmQueryRouteStaking
+	WasmQueryRouteMarket             = types.WasmQueryRouteMarket
+	WasmQueryRouteOracle             = types.WasmQueryRouteOracle
+	WasmQueryRouteTreasury           = types.WasmQueryRouteTreasury
+	WasmQueryRouteWasm               = types.WasmQueryRouteWasm
+	WasmQueryRouteDistribution       = types.WasmQueryRouteDistribution
+	WasmQueryRouteGov                = types.WasmQueryRouteGov
+	WasmQueryRouteSlashing           = types.WasmQueryRouteSlashing
+	GasTraceCategoryVM               = types.GasTraceCategoryVM
+	GasTraceCategoryStorageRead      = types.GasTraceCategoryStorageRead
+	GasTraceCategoryStorageWrite     = types.GasTraceCategoryStorageWrite
+	GasTraceCategoryHumanize         = types.GasTraceCategoryHumanize
+	GasTraceCategoryCanonicalize     = types.GasTraceCategoryCanonicalize
+	GasTraceCategoryQuery            = types.GasTraceCategoryQuery
+	GasTraceCategoryDispatch         = types.GasTraceCategoryDispatch
+	GasTraceCategoryOther            = types.GasTraceCategoryOther
+	GasDescSubQuery                  = types.GasDescSubQuery
 )
 
 var (
 	// functions aliases
-	NewKeeper                     = keeper.NewKeeper
-	NewQuerier                    = keeper.NewQuerier
-	NewWasmMsgParser              = keeper.NewWasmMsgParser
-	NewWasmQuerier                = keeper.NewWasmQuerier
-	RegisterCodec                 = types.RegisterCodec
-	ParseEvents                   = types.ParseEvents
-	ParseToCoin                   = types.ParseToCoin
-	ParseToCoins                  = types.ParseToCoins
-	EncodeSdkCoin                 = types.EncodeSdkCoin
-	EncodeSdkCoins                = types.EncodeSdkCoins
-	NewCodeInfo                   = types.NewCodeInfo
-	NewContractInfo               = types.NewContractInfo
-	NewWasmAPIParams              = types.NewWasmAPIParams
-	NewWasmCoins                  = types.NewWasmCoins
-	NewGenesisState               = types.NewGenesisState
-	DefaultGenesisState           = types.DefaultGenesisState
-	ValidateGenesis               = types.ValidateGenesis
-	GetCodeInfoKey                = types.GetCodeInfoKey
-	GetContractInfoKey            = types.GetContractInfoKey
-	GetContractStoreKey           = types.GetContractStoreKey
-	NewMsgStoreCode               = types.NewMsgStoreCode
-	NewMsgInstantiateContract     = types.NewMsgInstantiateContract
-	NewMsgExecuteContract         = types.NewMsgExecuteContract
-	NewMsgMigrateContract         = types.NewMsgMigrateContract
-	NewMsgUpdateContractOwner     = types.NewMsgUpdateContractOwner
-	NewModuleMsgParser            = types.NewModuleMsgParser
-	DefaultParams                 = types.DefaultParams
-	ParamKeyTable                 = types.ParamKeyTable
-	NewQueryCodeIDParams          = types.NewQueryCodeIDParams
-	NewQueryContractAddressParams = types.NewQueryContractAddressParams
-	NewQueryRawStoreParams        = types.NewQueryRawStoreParams
-	NewQueryContractParams        = types.NewQueryContractParams
-	NewModuleQuerier              = types.NewModuleQuerier
+	NewKeeper                       = keeper.NewKeeper
+	NewQuerier                      = keeper.NewQuerier
+	NewWasmMsgParser                = keeper.NewWasmMsgParser
+	NewWasmQuerier                  = keeper.NewWasmQuerier
+	NewMigrator                     = keeper.NewMigrator
+	RegisterInvariants              = keeper.RegisterInvariants
+	ContractIndexesInvariant        = keeper.ContractIndexesInvariant
+	LastIDsInvariant                = keeper.LastIDsInvariant
+	AccessTypeFromString            = types.AccessTypeFromString
+	NewAccessConfig                 = types.NewAccessConfig
+	OnlyAddresses                   = types.OnlyAddresses
+	RegisterCodec                   = types.RegisterCodec
+	ParseEvents                     = types.ParseEvents
+	ParseToCoin                     = types.ParseToCoin
+	ParseToCoins                    = types.ParseToCoins
+	EncodeSdkCoin                   = types.EncodeSdkCoin
+	EncodeSdkCoins                  = types.EncodeSdkCoins
+	NewCodeInfo                     = types.NewCodeInfo
+	NewContractInfo                 = types.NewContractInfo
+	ValidateSalt                    = types.ValidateSalt
+	PredictableContractAddress      = types.PredictableContractAddress
+	ValidateLabel                   = types.ValidateLabel
+	NewContractHistoryEntry         = types.NewContractHistoryEntry
+	NewWasmAPIParams                = types.NewWasmAPIParams
+	NewWasmCoins                    = types.NewWasmCoins
+	NewGasTrace                     = types.NewGasTrace
+	WithGasTrace                    = types.WithGasTrace
+	GetGasTrace                     = types.GetGasTrace
+	ParseGasTraces                  = types.ParseGasTraces
+	NewGenesisState                 = types.NewGenesisState
+	DefaultGenesisState             = types.DefaultGenesisState
+	ValidateGenesis                 = types.ValidateGenesis
+	GetCodeInfoKey                  = types.GetCodeInfoKey
+	GetContractInfoKey              = types.GetContractInfoKey
+	GetContractStoreKey             = types.GetContractStoreKey
+	GetContractHistoryPrefix        = types.GetContractHistoryPrefix
+	GetContractHistoryKey           = types.GetContractHistoryKey
+	GetContractByCodeIndexPrefix    = types.GetContractByCodeIndexPrefix
+	GetContractByCodeIndexKey       = types.GetContractByCodeIndexKey
+	GetContractByOwnerIndexPrefix   = types.GetContractByOwnerIndexPrefix
+	GetContractByOwnerIndexKey      = types.GetContractByOwnerIndexKey
+	NewMsgStoreCode                 = types.NewMsgStoreCode
+	NewMsgInstantiateContract       = types.NewMsgInstantiateContract
+	NewMsgInstantiateContract2      = types.NewMsgInstantiateContract2
+	NewMsgExecuteContract           = types.NewMsgExecuteContract
+	NewContractAuthorization        = types.NewContractAuthorization
+	NewExecuteContractAuthorization = types.NewExecuteContractAuthorization
+	NewMsgMigrateContract           = types.NewMsgMigrateContract
+	NewMsgUpdateContractOwner       = types.NewMsgUpdateContractOwner
+	NewMsgClearContractAdmin        = types.NewMsgClearContractAdmin
+	NewModuleMsgParser              = types.NewModuleMsgParser
+	DefaultParams                   = types.DefaultParams
+	ParamKeyTable                   = types.ParamKeyTable
+	NewStoreCodeProposal            = types.NewStoreCodeProposal
+	NewInstantiateContractProposal  = types.NewInstantiateContractProposal
+	NewMigrateContractProposal      = types.NewMigrateContractProposal
+	NewUpdateContractAdminProposal  = types.NewUpdateContractAdminProposal
+	NewQueryCodeIDParams            = types.NewQueryCodeIDParams
+	NewQueryContractAddressParams   = types.NewQueryContractAddressParams
+	NewQueryRawStoreParams          = types.NewQueryRawStoreParams
+	NewQueryContractParams          = types.NewQueryContractParams
+	NewQueryContractsByCodeParams   = types.NewQueryContractsByCodeParams
+	NewQueryContractsByOwnerParams  = types.NewQueryContractsByOwnerParams
+	NewQueryContractStoreListParams = types.NewQueryContractStoreListParams
+	NewQueryPredictAddressParams    = types.NewQueryPredictAddressParams
+	NewModuleQuerier                = types.NewModuleQuerier
+	WithQueryDepth                  = types.WithQueryDepth
+	GetQueryDepth                   = types.GetQueryDepth
 
 	// variable aliases
+	AllowEverybody                  = types.AllowEverybody
+	AllowNobody                     = types.AllowNobody
 	ModuleCdc                       = types.ModuleCdc
 	ErrStoreCodeFailed              = types.ErrStoreCodeFailed
 	ErrAccountExists                = types.ErrAccountExists
@@ -91,43 +173,82 @@ var (
 	ErrNoRegisteredParser           = types.ErrNoRegisteredParser
 	ErrMigrationFailed              = types.ErrMigrationFailed
 	ErrNotMigratable                = types.ErrNotMigratable
+	ErrContractQueryFailed          = types.ErrContractQueryFailed
+	ErrQueryDepthExceeded           = types.ErrQueryDepthExceeded
+	ErrQueryGasCapExceeded          = types.ErrQueryGasCapExceeded
+	ErrInvalidEvent                 = types.ErrInvalidEvent
 	LastCodeIDKey                   = types.LastCodeIDKey
 	LastInstanceIDKey               = types.LastInstanceIDKey
 	CodeKey                         = types.CodeKey
 	ContractInfoKey                 = types.ContractInfoKey
 	ContractStoreKey                = types.ContractStoreKey
+	ContractHistoryKey              = types.ContractHistoryKey
+	ContractByCodeIndexKey          = types.ContractByCodeIndexKey
+	ContractByOwnerIndexKey         = types.ContractByOwnerIndexKey
 	ParamStoreKeyMaxContractSize    = types.ParamStoreKeyMaxContractSize
 	ParamStoreKeyMaxContractGas     = types.ParamStoreKeyMaxContractGas
 	ParamStoreKeyMaxContractMsgSize = types.ParamStoreKeyMaxContractMsgSize
+	ParamStoreKeyUploadAccess       = types.ParamStoreKeyUploadAccess
+	ParamStoreKeyMaxQueryDepth      = types.ParamStoreKeyMaxQueryDepth
+	DefaultUploadAccess             = types.DefaultUploadAccess
+	ReplySender                     = types.ReplySender
 )
 
 type (
-	Keeper                     = keeper.Keeper
-	WasmMsgParser              = keeper.WasmMsgParser
-	WasmQuerier                = keeper.WasmQuerier
-	Model                      = types.Model
-	CodeInfo                   = types.CodeInfo
-	ContractInfo               = types.ContractInfo
-	AccountKeeper              = types.AccountKeeper
-	BankKeeper                 = types.BankKeeper
-	TreasuryKeeper             = types.TreasuryKeeper
-	GenesisState               = types.GenesisState
-	Code                       = types.Code
-	Contract                   = types.Contract
-	MsgStoreCode               = types.MsgStoreCode
-	MsgInstantiateContract     = types.MsgInstantiateContract
-	MsgExecuteContract         = types.MsgExecuteContract
-	MsgMigrateContract         = types.MsgMigrateContract
-	MsgUpdateContractOwner     = types.MsgUpdateContractOwner
-	WasmMsgParserInterface     = types.WasmMsgParserInterface
-	WasmCustomMsg              = types.WasmCustomMsg
-	MsgParser                  = types.MsgParser
-	Params                     = types.Params
-	QueryCodeIDParams          = types.QueryCodeIDParams
-	QueryContractAddressParams = types.QueryContractAddressParams
-	QueryRawStoreParams        = types.QueryRawStoreParams
-	QueryContractParams        = types.QueryContractParams
-	WasmQuerierInterface       = types.WasmQuerierInterface
-	Querier                    = types.Querier
-	WasmCustomQuery            = types.WasmCustomQuery
+	Keeper                       = keeper.Keeper
+	WasmMsgParser                = keeper.WasmMsgParser
+	WasmQuerier                  = keeper.WasmQuerier
+	Migrator                     = keeper.Migrator
+	AccessType                   = types.AccessType
+	AccessConfig                 = types.AccessConfig
+	Model                        = types.Model
+	CodeInfo                     = types.CodeInfo
+	ContractInfo                 = types.ContractInfo
+	ContractHistoryOperation     = types.ContractHistoryOperation
+	ContractHistoryEntry         = types.ContractHistoryEntry
+	AccountKeeper                = types.AccountKeeper
+	BankKeeper                   = types.BankKeeper
+	TreasuryKeeper               = types.TreasuryKeeper
+	GasTraceCategory             = types.GasTraceCategory
+	GasTraceEntry                = types.GasTraceEntry
+	GasTraceTotal                = types.GasTraceTotal
+	GasTrace                     = types.GasTrace
+	GenesisState                 = types.GenesisState
+	Code                         = types.Code
+	Contract                     = types.Contract
+	MsgStoreCode                 = types.MsgStoreCode
+	MsgInstantiateContract       = types.MsgInstantiateContract
+	MsgInstantiateContract2      = types.MsgInstantiateContract2
+	MsgExecuteContract           = types.MsgExecuteContract
+	ContractAuthorization        = types.ContractAuthorization
+	ExecuteContractAuthorization = types.ExecuteContractAuthorization
+	MsgMigrateContract           = types.MsgMigrateContract
+	MsgUpdateContractOwner       = types.MsgUpdateContractOwner
+	MsgClearContractAdmin        = types.MsgClearContractAdmin
+	WasmMsgParserInterface       = types.WasmMsgParserInterface
+	WasmCustomMsg                = types.WasmCustomMsg
+	SubMsg                       = types.SubMsg
+	ReplyOn                      = types.ReplyOn
+	ReplyMsg                     = types.ReplyMsg
+	Reply                        = types.Reply
+	SubMsgResult                 = types.SubMsgResult
+	SubMsgError                  = types.SubMsgError
+	MsgParser                    = types.MsgParser
+	Params                       = types.Params
+	StoreCodeProposal            = types.StoreCodeProposal
+	InstantiateContractProposal  = types.InstantiateContractProposal
+	MigrateContractProposal      = types.MigrateContractProposal
+	UpdateContractAdminProposal  = types.UpdateContractAdminProposal
+	QueryCodeIDParams            = types.QueryCodeIDParams
+	QueryContractAddressParams   = types.QueryContractAddressParams
+	QueryRawStoreParams          = types.QueryRawStoreParams
+	QueryContractParams          = types.QueryContractParams
+	QueryContractsByCodeParams   = types.QueryContractsByCodeParams
+	QueryContractsByOwnerParams  = types.QueryContractsByOwnerParams
+	QueryContractStoreListParams = types.QueryContractStoreListParams
+	ContractStoreListResponse    = types.ContractStoreListResponse
+	QueryPredictAddressParams    = types.QueryPredictAddressParams
+	WasmQuerierInterface         = types.WasmQuerierInterface
+	Querier                      = types.Querier
+	WasmCustomQuery              = types.WasmCustomQuery
 )
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"io/ioutil"
 	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -19,7 +21,13 @@ import (
 	"github.com/terra-project/core/x/wasm/internal/types"
 )
 
-const flagRaw = "raw"
+const (
+	flagRaw         = "raw"
+	flagPrefix      = "prefix"
+	flagStartKey    = "start-key"
+	flagEndKey      = "end-key"
+	flagKeyEncoding = "key-encoding"
+)
 
 // GetQueryCmd returns the cli query commands for wasm   module
 func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
@@ -34,9 +42,14 @@ func GetQueryCmd(queryRoute string, cdc *codec.Codec) *cobra.Command {
 		GetCmdQueryByteCode(queryRoute, cdc),
 		GetCmdQueryCodeInfo(queryRoute, cdc),
 		GetCmdGetContractInfo(queryRoute, cdc),
+		GetCmdGetContractHistory(queryRoute, cdc),
+		GetCmdListContractsByCode(queryRoute, cdc),
+		GetCmdListContractsByOwner(queryRoute, cdc),
 		GetCmdGetContractStore(queryRoute, cdc),
 		GetCmdGetRawStore(queryRoute, cdc),
+		GetCmdListContractStore(queryRoute, cdc),
 		GetCmdQueryParams(queryRoute, cdc),
+		GetCmdPredictAddress(queryRoute, cdc),
 	)...)
 	return queryCmd
 }
@@ -155,6 +168,116 @@ func GetCmdGetContractInfo(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	}
 }
 
+// GetCmdGetContractHistory prints the code history of a given contract
+func GetCmdGetContractHistory(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "contract-history [contract-address]",
+		Short: "Prints out the code history of a contract given its address",
+		Long:  "Prints out the code history of a contract given its address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryContractAddressParams(addr)
+			bz, err := cliCtx.Codec.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryContractHistory)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var history []types.ContractHistoryEntry
+			cdc.MustUnmarshalJSON(res, &history)
+			return cliCtx.PrintOutput(history)
+		},
+	}
+}
+
+// GetCmdListContractsByCode lists the contracts instantiated from a given code
+func GetCmdListContractsByCode(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contracts-by-code [code-id]",
+		Short: "List the addresses of the contracts instantiated from a code",
+		Long:  "List the addresses of the contracts instantiated from a code",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryContractsByCodeParams(codeID, viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
+			bz, err := cliCtx.Codec.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryContractsByCode)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var contracts []sdk.AccAddress
+			cdc.MustUnmarshalJSON(res, &contracts)
+			return cliCtx.PrintOutput(contracts)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of contracts to query for")
+	cmd.Flags().Int(flags.FlagLimit, types.DefaultContractsQueryLimit, "pagination limit of contracts to query for")
+	return cmd
+}
+
+// GetCmdListContractsByOwner lists the contracts owned by a given address
+func GetCmdListContractsByOwner(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "contracts-by-owner [owner-address]",
+		Short: "List the addresses of the contracts owned by an address",
+		Long:  "List the addresses of the contracts owned by an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryContractsByOwnerParams(owner, viper.GetInt(flags.FlagPage), viper.GetInt(flags.FlagLimit))
+			bz, err := cliCtx.Codec.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryContractsByOwner)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var contracts []sdk.AccAddress
+			cdc.MustUnmarshalJSON(res, &contracts)
+			return cliCtx.PrintOutput(contracts)
+		},
+	}
+
+	cmd.Flags().Int(flags.FlagPage, 1, "pagination page of contracts to query for")
+	cmd.Flags().Int(flags.FlagLimit, types.DefaultContractsQueryLimit, "pagination limit of contracts to query for")
+	return cmd
+}
+
 // GetCmdGetContractStore send query msg to a given contract
 func GetCmdGetContractStore(queryRoute string, cdc *codec.Codec) *cobra.Command {
 	return &cobra.Command{
@@ -261,3 +384,104 @@ func GetCmdQueryParams(queryRoute string, cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdListContractStore lists the raw models of a contract store
+func GetCmdListContractStore(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-store [bech32-address]",
+		Short: "Lists the raw models of a contract store",
+		Long: strings.TrimSpace(`
+Lists the raw models of a contract store in the ascending key order.
+The listing can be bounded by a start key (inclusive) and an end key (exclusive)
+and filtered by a key prefix. The keys are given in the --key-encoding format.
+
+$ terracli query wasm list-store terra1... --prefix=636f6e666967 --limit=10
+
+When more models are left, pass the returned next_key as --start-key
+with --key-encoding=base64 to fetch the next page.
+`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			addr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			encoding := viper.GetString(flagKeyEncoding)
+			keys := make([][]byte, 3)
+			for i, flag := range []string{flagPrefix, flagStartKey, flagEndKey} {
+				keys[i], err = utils.DecodeKey(viper.GetString(flag), encoding)
+				if err != nil {
+					return fmt.Errorf("failed to decode --%s: %s", flag, err)
+				}
+			}
+
+			params := types.NewQueryContractStoreListParams(addr, keys[0], keys[1], keys[2], viper.GetInt(flags.FlagLimit))
+			bz, err := cliCtx.Codec.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryContractStoreList)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var response types.ContractStoreListResponse
+			cdc.MustUnmarshalJSON(res, &response)
+			return cliCtx.PrintOutput(response)
+		},
+	}
+
+	cmd.Flags().String(flagPrefix, "", "only list the keys starting with the prefix")
+	cmd.Flags().String(flagStartKey, "", "list from the key, inclusive")
+	cmd.Flags().String(flagEndKey, "", "list until the key, exclusive")
+	cmd.Flags().String(flagKeyEncoding, utils.KeyEncodingHex, "encoding of the given keys (hex|base64|raw)")
+	cmd.Flags().Int(flags.FlagLimit, types.DefaultContractStoreListLimit, "maximum number of models to list")
+	return cmd
+}
+
+// GetCmdPredictAddress prints the address of the contract instantiated with a given salt
+func GetCmdPredictAddress(queryRoute string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "predict-address [code-id] [creator] [salt]",
+		Short: "Prints out the address of the contract instantiated with the given salt",
+		Long: `Prints out the address of the contract that the creator instantiates from the code with the given salt
+
+$ terracli query wasm predict-address 1 terra1... my-salt
+`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			codeID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			creator, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			params := types.NewQueryPredictAddressParams(creator, codeID, []byte(args[2]))
+			bz, err := cliCtx.Codec.MarshalJSON(params)
+			if err != nil {
+				return err
+			}
+
+			route := fmt.Sprintf("custom/%s/%s", queryRoute, types.QueryPredictAddress)
+			res, _, err := cliCtx.QueryWithData(route, bz)
+			if err != nil {
+				return err
+			}
+
+			var contractAddress sdk.AccAddress
+			cdc.MustUnmarshalJSON(res, &contractAddress)
+			return cliCtx.PrintOutput(contractAddress)
+		},
+	}
+}
@@ -17,8 +17,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
 
 	feeutils "github.com/terra-project/core/x/auth/client/utils"
 	wasmUtils "github.com/terra-project/core/x/wasm/client/utils"
@@ -29,6 +31,12 @@ const (
 	flagTo         = "to"
 	flagAmount     = "amount"
 	flagMigratable = "migratable"
+	flagLabel      = "label"
+	flagTrace      = "trace"
+	flagSalt       = "salt"
+
+	flagInstantiatePermission = "instantiate-permission"
+	flagInstantiateAddresses  = "instantiate-addresses"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -46,6 +54,7 @@ func GetTxCmd(cdc *codec.Codec) *cobra.Command {
 		ExecuteContractCmd(cdc),
 		MigrateContractCmd(cdc),
 		UpdateContractOwnerCmd(cdc),
+		ClearContractAdminCmd(cdc),
 	)...)
 	return txCmd
 }
@@ -89,8 +98,14 @@ func StoreCodeCmd(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("invalid input file. Use wasm binary or gzip")
 			}
 
+			instantiatePermission, err := parseInstantiatePermission()
+			if err != nil {
+				return err
+			}
+
 			// build and sign the transaction, then broadcast to Tendermint
 			msg := types.NewMsgStoreCode(fromAddr, wasmBytes)
+			msg.InstantiatePermission = instantiatePermission
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -98,9 +113,48 @@ func StoreCodeCmd(cdc *codec.Codec) *cobra.Command {
 			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
 		},
 	}
+
+	cmd.Flags().String(flagInstantiatePermission, "", "who can instantiate the code (everybody|nobody|only_addresses), defaults to everybody")
+	cmd.Flags().String(flagInstantiateAddresses, "", "comma separated addresses allowed to instantiate the code with only_addresses permission")
 	return cmd
 }
 
+// parseInstantiatePermission builds the instantiate permission from the flags, nil when not given
+func parseInstantiatePermission() (*types.AccessConfig, error) {
+	permissionStr := viper.GetString(flagInstantiatePermission)
+	addressesStr := viper.GetString(flagInstantiateAddresses)
+	if permissionStr == "" {
+		if addressesStr != "" {
+			return nil, fmt.Errorf("flag --%s requires --%s=%s", flagInstantiateAddresses, flagInstantiatePermission, types.AccessTypeOnlyAddresses)
+		}
+
+		return nil, nil
+	}
+
+	permission, err := types.AccessTypeFromString(permissionStr)
+	if err != nil {
+		return nil, err
+	}
+
+	var addresses []sdk.AccAddress
+	for _, addrStr := range strings.Split(addressesStr, ",") {
+		addrStr = strings.TrimSpace(addrStr)
+		if addrStr == "" {
+			continue
+		}
+
+		addr, err := sdk.AccAddressFromBech32(addrStr)
+		if err != nil {
+			return nil, err
+		}
+
+		addresses = append(addresses, addr)
+	}
+
+	accessConfig := types.NewAccessConfig(permission, addresses...)
+	return &accessConfig, nil
+}
+
 // InstantiateContractCmd will instantiate a contract from previously uploaded code.
 func InstantiateContractCmd(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -114,6 +168,11 @@ $ terracli instantiate 1 '{"arbiter": "terra~~"}'
 You can also instantiate it with funds
 
 $ terracli instantiate 1 '{"arbiter": "terra~~"}' "1000000uluna"
+
+With a salt, the contract address is derived from the sender, the code hash and the salt,
+so it can be known before the instantiation with "terracli query wasm predict-address"
+
+$ terracli instantiate 1 '{"arbiter": "terra~~"}' --salt "my-salt"
 `,
 		Args: cobra.RangeArgs(2, 3),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -154,7 +213,17 @@ $ terracli instantiate 1 '{"arbiter": "terra~~"}' "1000000uluna"
 			}
 
 			// build and sign the transaction, then broadcast to Tendermint
-			msg := types.NewMsgInstantiateContract(fromAddr, codeID, initMsgBz, coins, migratable)
+			var msg sdk.Msg
+			if salt := viper.GetString(flagSalt); salt != "" {
+				msg2 := types.NewMsgInstantiateContract2(fromAddr, codeID, initMsgBz, coins, migratable, []byte(salt))
+				msg2.Label = viper.GetString(flagLabel)
+				msg = msg2
+			} else {
+				msg1 := types.NewMsgInstantiateContract(fromAddr, codeID, initMsgBz, coins, migratable)
+				msg1.Label = viper.GetString(flagLabel)
+				msg = msg1
+			}
+
 			if err := msg.ValidateBasic(); err != nil {
 				return err
 			}
@@ -186,6 +255,8 @@ $ terracli instantiate 1 '{"arbiter": "terra~~"}' "1000000uluna"
 	}
 
 	cmd.Flags().Bool(flagMigratable, false, "setting the flag will make the contract migratable")
+	cmd.Flags().String(flagLabel, "", "optional human readable label of the contract")
+	cmd.Flags().String(flagSalt, "", "optional salt to instantiate the contract at a predictable address")
 	return cmd
 }
 
@@ -236,6 +307,14 @@ func ExecuteContractCmd(cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			if viper.GetBool(flagTrace) {
+				if !cliCtx.Simulate {
+					return fmt.Errorf("--%s requires --%s", flagTrace, flags.FlagDryRun)
+				}
+
+				return simulateWithGasTrace(cliCtx, txBldr, []sdk.Msg{msg})
+			}
+
 			if !cliCtx.GenerateOnly && txBldr.Fees().IsZero() {
 				// extimate tax and gas
 				fees, gas, err := feeutils.ComputeFees(cliCtx, feeutils.ComputeReqParams{
@@ -262,6 +341,8 @@ func ExecuteContractCmd(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(flagTrace, false, "Print the gas breakdown of the execution; requires --dry-run")
+
 	return cmd
 }
 
@@ -362,3 +443,292 @@ $ terracli tx wasm update-owner terra... terra...
 
 	return cmd
 }
+
+// ClearContractAdminCmd will clear the owner of a contract, which makes it immutable for good.
+func ClearContractAdminCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "clear-contract-admin [contract-addr-bech32]",
+		Short: "clear the owner of a contract",
+		Long: strings.TrimSpace(`
+Clear the owner of a contract and disable its migration, which makes the contract immutable for good
+
+$ terracli tx wasm clear-contract-admin terra...
+		`),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			fromAddr := cliCtx.GetFromAddress()
+			if fromAddr.Empty() {
+				return fmt.Errorf("must specify flag --from")
+			}
+
+			contractAddr, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			// build and sign the transaction, then broadcast to Tendermint
+			msg := types.NewMsgClearContractAdmin(fromAddr, contractAddr)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitStoreCodeProposal implements the command to submit a store-code proposal
+func GetCmdSubmitStoreCodeProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "store-code [wasm-file] [proposal-file]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to upload a wasm binary",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to upload a wasm binary regardless of the upload access param.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal store-code <path/to/contract.wasm> <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Store Code",
+  "description": "Upload the token contract",
+  "creator": "terra1...",
+  "instantiate_permission": {
+    "permission": "only_addresses",
+    "addresses": ["terra1..."]
+  },
+  "deposit": [
+    {
+      "denom": "uluna",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			wasmBytes, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			// gzip the wasm file
+			if wasmUtils.IsWasm(wasmBytes) {
+				wasmBytes, err = wasmUtils.GzipIt(wasmBytes)
+				if err != nil {
+					return err
+				}
+			} else if !wasmUtils.IsGzip(wasmBytes) {
+				return fmt.Errorf("invalid input file. Use wasm binary or gzip")
+			}
+
+			proposal, err := ParseStoreCodeProposalJSON(args[1])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewStoreCodeProposal(proposal.Title, proposal.Description, proposal.Creator, wasmBytes, proposal.InstantiatePermission)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitInstantiateContractProposal implements the command to submit an instantiate-contract proposal
+func GetCmdSubmitInstantiateContractProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "instantiate-contract [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to instantiate a wasm contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to instantiate a wasm contract regardless of the instantiate permission of the code.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal instantiate-contract <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Instantiate Contract",
+  "description": "Instantiate the token contract",
+  "owner": "terra1...",
+  "code_id": "1",
+  "init_msg": {"name": "token"},
+  "init_coins": [],
+  "migratable": false,
+  "label": "token",
+  "deposit": [
+    {
+      "denom": "uluna",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseInstantiateContractProposalJSON(args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewInstantiateContractProposal(proposal.Title, proposal.Description,
+				proposal.Owner, proposal.CodeID, proposal.InitMsg, proposal.InitCoins, proposal.Migratable, proposal.Label)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitMigrateContractProposal implements the command to submit a migrate-contract proposal
+func GetCmdSubmitMigrateContractProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate-contract [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to migrate a wasm contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to migrate a wasm contract regardless of its owner.
+The contract must still be migratable. The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal migrate-contract <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Migrate Contract",
+  "description": "Migrate the token contract to the fixed code",
+  "contract": "terra1...",
+  "new_code_id": "2",
+  "migrate_msg": {},
+  "deposit": [
+    {
+      "denom": "uluna",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseMigrateContractProposalJSON(args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewMigrateContractProposal(proposal.Title, proposal.Description,
+				proposal.Contract, proposal.NewCodeID, proposal.MigrateMsg)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+// GetCmdSubmitUpdateContractAdminProposal implements the command to submit an update-contract-admin proposal
+func GetCmdSubmitUpdateContractAdminProposal(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-contract-admin [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to update the owner of a wasm contract",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to update the owner of a wasm contract regardless of the current owner.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal update-contract-admin <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Update Contract Admin",
+  "description": "Recover the token contract whose admin key was lost",
+  "contract": "terra1...",
+  "new_admin": "terra1...",
+  "deposit": [
+    {
+      "denom": "uluna",
+      "amount": "10000"
+    }
+  ]
+}
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inBuf := bufio.NewReader(cmd.InOrStdin())
+			txBldr := auth.NewTxBuilderFromCLI(inBuf).WithTxEncoder(utils.GetTxEncoder(cdc))
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			proposal, err := ParseUpdateContractAdminProposalJSON(args[0])
+			if err != nil {
+				return err
+			}
+
+			from := cliCtx.GetFromAddress()
+			content := types.NewUpdateContractAdminProposal(proposal.Title, proposal.Description,
+				proposal.Contract, proposal.NewAdmin)
+
+			msg := gov.NewMsgSubmitProposal(content, proposal.Deposit, from)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(cliCtx, txBldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+
+	"github.com/terra-project/core/x/wasm/internal/types"
+)
+
+type (
+
+	// StoreCodeProposalJSON defines a StoreCodeProposal with a deposit; the code is given as a separate file
+	StoreCodeProposalJSON struct {
+		Title                 string              `json:"title" yaml:"title"`
+		Description           string              `json:"description" yaml:"description"`
+		Creator               sdk.AccAddress      `json:"creator" yaml:"creator"`
+		InstantiatePermission *types.AccessConfig `json:"instantiate_permission,omitempty" yaml:"instantiate_permission,omitempty"`
+		Deposit               sdk.Coins           `json:"deposit" yaml:"deposit"`
+	}
+
+	// InstantiateContractProposalJSON defines an InstantiateContractProposal with a deposit
+	InstantiateContractProposalJSON struct {
+		Title       string          `json:"title" yaml:"title"`
+		Description string          `json:"description" yaml:"description"`
+		Owner       sdk.AccAddress  `json:"owner" yaml:"owner"`
+		CodeID      uint64          `json:"code_id,string" yaml:"code_id"`
+		InitMsg     json.RawMessage `json:"init_msg" yaml:"init_msg"`
+		InitCoins   sdk.Coins       `json:"init_coins" yaml:"init_coins"`
+		Migratable  bool            `json:"migratable" yaml:"migratable"`
+		Label       string          `json:"label,omitempty" yaml:"label,omitempty"`
+		Deposit     sdk.Coins       `json:"deposit" yaml:"deposit"`
+	}
+
+	// MigrateContractProposalJSON defines a MigrateContractProposal with a deposit
+	MigrateContractProposalJSON struct {
+		Title       string          `json:"title" yaml:"title"`
+		Description string          `json:"description" yaml:"description"`
+		Contract    sdk.AccAddress  `json:"contract" yaml:"contract"`
+		NewCodeID   uint64          `json:"new_code_id,string" yaml:"new_code_id"`
+		MigrateMsg  json.RawMessage `json:"migrate_msg" yaml:"migrate_msg"`
+		Deposit     sdk.Coins       `json:"deposit" yaml:"deposit"`
+	}
+
+	// UpdateContractAdminProposalJSON defines an UpdateContractAdminProposal with a deposit
+	UpdateContractAdminProposalJSON struct {
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Contract    sdk.AccAddress `json:"contract" yaml:"contract"`
+		NewAdmin    sdk.AccAddress `json:"new_admin" yaml:"new_admin"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// GasTraceResponse defines the result of a traced dry run
+	GasTraceResponse struct {
+		GasEstimate uint64           `json:"gas_estimate" yaml:"gas_estimate"`
+		GasUsed     uint64           `json:"gas_used" yaml:"gas_used"`
+		GasTraces   []types.GasTrace `json:"gas_traces" yaml:"gas_traces"`
+	}
+)
+
+// String implements fmt.Stringer interface
+func (r GasTraceResponse) String() string {
+	return fmt.Sprintf(`GasTraceResponse
+	gas_estimate: %d,
+	gas_used:     %d,
+	gas_traces:   %v`,
+		r.GasEstimate, r.GasUsed, r.GasTraces)
+}
+
+// ParseStoreCodeProposalJSON reads and parses a StoreCodeProposalJSON from a file.
+func ParseStoreCodeProposalJSON(proposalFile string) (StoreCodeProposalJSON, error) {
+	proposal := StoreCodeProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseInstantiateContractProposalJSON reads and parses an InstantiateContractProposalJSON from a file.
+// The init msg is kept as a raw json object, so the file is decoded with encoding/json instead of amino.
+func ParseInstantiateContractProposalJSON(proposalFile string) (InstantiateContractProposalJSON, error) {
+	proposal := InstantiateContractProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseMigrateContractProposalJSON reads and parses a MigrateContractProposalJSON from a file.
+// The migrate msg is kept as a raw json object, so the file is decoded with encoding/json instead of amino.
+func ParseMigrateContractProposalJSON(proposalFile string) (MigrateContractProposalJSON, error) {
+	proposal := MigrateContractProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// ParseUpdateContractAdminProposalJSON reads and parses an UpdateContractAdminProposalJSON from a file.
+func ParseUpdateContractAdminProposalJSON(proposalFile string) (UpdateContractAdminProposalJSON, error) {
+	proposal := UpdateContractAdminProposalJSON{}
+
+	contents, err := ioutil.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err := json.Unmarshal(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
+
+// simulateWithGasTrace simulates the msgs and prints the gas traces of the wasm msgs
+func simulateWithGasTrace(cliCtx context.CLIContext, txBldr auth.TxBuilder, msgs []sdk.Msg) error {
+	txBldr, err := utils.PrepareTxBuilder(txBldr, cliCtx)
+	if err != nil {
+		return err
+	}
+
+	txBytes, err := txBldr.BuildTxForSim(msgs)
+	if err != nil {
+		return err
+	}
+
+	simRes, adjusted, err := utils.CalculateGas(cliCtx.QueryWithData, cliCtx.Codec, txBytes, txBldr.GasAdjustment())
+	if err != nil {
+		return err
+	}
+
+	response := GasTraceResponse{GasEstimate: adjusted, GasUsed: simRes.GasUsed, GasTraces: []types.GasTrace{}}
+	if simRes.Result != nil {
+		response.GasTraces, err = types.ParseGasTraces(simRes.Result.Events)
+		if err != nil {
+			return err
+		}
+	}
+
+	return cliCtx.PrintOutput(response)
+}
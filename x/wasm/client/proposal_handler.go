@@ -0,0 +1,15 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/terra-project/core/x/wasm/client/cli"
+	"github.com/terra-project/core/x/wasm/client/rest"
+)
+
+// wasm proposal handlers
+var (
+	StoreCodeProposalHandler           = govclient.NewProposalHandler(cli.GetCmdSubmitStoreCodeProposal, rest.StoreCodeProposalRESTHandler)
+	InstantiateContractProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitInstantiateContractProposal, rest.InstantiateContractProposalRESTHandler)
+	MigrateContractProposalHandler     = govclient.NewProposalHandler(cli.GetCmdSubmitMigrateContractProposal, rest.MigrateContractProposalRESTHandler)
+	UpdateContractAdminProposalHandler = govclient.NewProposalHandler(cli.GetCmdSubmitUpdateContractAdminProposal, rest.UpdateContractAdminProposalRESTHandler)
+)
@@ -0,0 +1,208 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/cosmos/cosmos-sdk/x/gov"
+
+	wasmUtils "github.com/terra-project/core/x/wasm/client/utils"
+	"github.com/terra-project/core/x/wasm/internal/types"
+)
+
+type (
+	// StoreCodeProposalReq defines a store-code proposal request body.
+	StoreCodeProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title                 string              `json:"title" yaml:"title"`
+		Description           string              `json:"description" yaml:"description"`
+		Creator               sdk.AccAddress      `json:"creator" yaml:"creator"`
+		WasmBytes             []byte              `json:"wasm_bytes" yaml:"wasm_bytes"`
+		InstantiatePermission *types.AccessConfig `json:"instantiate_permission,omitempty" yaml:"instantiate_permission,omitempty"`
+		Proposer              sdk.AccAddress      `json:"proposer" yaml:"proposer"`
+		Deposit               sdk.Coins           `json:"deposit" yaml:"deposit"`
+	}
+
+	// InstantiateContractProposalReq defines an instantiate-contract proposal request body.
+	InstantiateContractProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Owner       sdk.AccAddress `json:"owner" yaml:"owner"`
+		CodeID      uint64         `json:"code_id" yaml:"code_id"`
+		InitMsg     string         `json:"init_msg" yaml:"init_msg"`
+		InitCoins   sdk.Coins      `json:"init_coins" yaml:"init_coins"`
+		Migratable  bool           `json:"migratable" yaml:"migratable"`
+		Label       string         `json:"label,omitempty" yaml:"label,omitempty"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// MigrateContractProposalReq defines a migrate-contract proposal request body.
+	MigrateContractProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Contract    sdk.AccAddress `json:"contract" yaml:"contract"`
+		NewCodeID   uint64         `json:"new_code_id" yaml:"new_code_id"`
+		MigrateMsg  string         `json:"migrate_msg" yaml:"migrate_msg"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+
+	// UpdateContractAdminProposalReq defines an update-contract-admin proposal request body.
+	UpdateContractAdminProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Contract    sdk.AccAddress `json:"contract" yaml:"contract"`
+		NewAdmin    sdk.AccAddress `json:"new_admin" yaml:"new_admin"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+)
+
+func postStoreCodeProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req StoreCodeProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		var err error
+		wasmBytes := req.WasmBytes
+		if wasmBytesLen := uint64(len(wasmBytes)); wasmBytesLen > types.EnforcedMaxContractSize {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Binary size exceeds maximum limit")
+			return
+		}
+
+		// gzip the wasm file
+		if wasmUtils.IsWasm(wasmBytes) {
+			wasmBytes, err = wasmUtils.GzipIt(wasmBytes)
+			if err != nil {
+				rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+		} else if !wasmUtils.IsGzip(wasmBytes) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid input file, use wasm binary or zip")
+			return
+		}
+
+		content := types.NewStoreCodeProposal(req.Title, req.Description, req.Creator, wasmBytes, req.InstantiatePermission)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postInstantiateContractProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req InstantiateContractProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		initMsgBz := []byte(req.InitMsg)
+		if !json.Valid(initMsgBz) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "msg must be a json string format")
+			return
+		}
+
+		// limit the input size
+		if initMsgLen := uint64(len(initMsgBz)); initMsgLen > types.EnforcedMaxContractMsgSize {
+			rest.WriteErrorResponse(w, http.StatusBadRequest,
+				fmt.Sprintf("init msg size exceeds the max size hard-cap (allowed:%d, actual: %d)",
+					types.EnforcedMaxContractMsgSize, initMsgLen))
+			return
+		}
+
+		content := types.NewInstantiateContractProposal(req.Title, req.Description,
+			req.Owner, req.CodeID, initMsgBz, req.InitCoins, req.Migratable, req.Label)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postMigrateContractProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req MigrateContractProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		migrateMsgBz := []byte(req.MigrateMsg)
+		if !json.Valid(migrateMsgBz) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "msg must be a json string format")
+			return
+		}
+
+		content := types.NewMigrateContractProposal(req.Title, req.Description, req.Contract, req.NewCodeID, migrateMsgBz)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
+
+func postUpdateContractAdminProposalHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateContractAdminProposalReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewUpdateContractAdminProposal(req.Title, req.Description, req.Contract, req.NewAdmin)
+
+		msg := gov.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if err := msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
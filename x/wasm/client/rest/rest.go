@@ -4,11 +4,15 @@ import (
 	"github.com/gorilla/mux"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
 )
 
 const (
 	RestCodeID          = "code_id"
 	RestContractAddress = "contract_address"
+	RestOwner           = "owner"
+	RestCreator         = "creator"
+	RestSalt            = "salt"
 )
 
 // RegisterRoutes registers staking-related REST handlers to a router
@@ -16,3 +20,35 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	registerQueryRoutes(cliCtx, r)
 	registerTxRoutes(cliCtx, r)
 }
+
+// StoreCodeProposalRESTHandler returns a ProposalRESTHandler that exposes the store code REST handler with a given sub-route.
+func StoreCodeProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "store_code",
+		Handler:  postStoreCodeProposalHandlerFn(cliCtx),
+	}
+}
+
+// InstantiateContractProposalRESTHandler returns a ProposalRESTHandler that exposes the instantiate contract REST handler with a given sub-route.
+func InstantiateContractProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "instantiate_contract",
+		Handler:  postInstantiateContractProposalHandlerFn(cliCtx),
+	}
+}
+
+// MigrateContractProposalRESTHandler returns a ProposalRESTHandler that exposes the migrate contract REST handler with a given sub-route.
+func MigrateContractProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "migrate_contract",
+		Handler:  postMigrateContractProposalHandlerFn(cliCtx),
+	}
+}
+
+// UpdateContractAdminProposalRESTHandler returns a ProposalRESTHandler that exposes the update contract admin REST handler with a given sub-route.
+func UpdateContractAdminProposalRESTHandler(cliCtx context.CLIContext) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update_contract_admin",
+		Handler:  postUpdateContractAdminProposalHandlerFn(cliCtx),
+	}
+}
@@ -23,11 +23,13 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc(fmt.Sprintf("/wasm/contracts/{%s}", RestContractAddress), executeContractHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/wasm/contract/{%s}/migrate", RestContractAddress), migrateContractHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc(fmt.Sprintf("/wasm/contract/{%s}/owner", RestContractAddress), updateOwnerContractHandlerFn(cliCtx)).Methods("POST")
+	r.HandleFunc(fmt.Sprintf("/wasm/contract/{%s}/clear_admin", RestContractAddress), clearContractAdminHandlerFn(cliCtx)).Methods("POST")
 }
 
 type storeCodeReq struct {
-	BaseReq   rest.BaseReq `json:"base_req" yaml:"base_req"`
-	WasmBytes []byte       `json:"wasm_bytes"`
+	BaseReq               rest.BaseReq        `json:"base_req" yaml:"base_req"`
+	WasmBytes             []byte              `json:"wasm_bytes"`
+	InstantiatePermission *types.AccessConfig `json:"instantiate_permission,omitempty" yaml:"instantiate_permission,omitempty"`
 }
 
 type instantiateContractReq struct {
@@ -35,6 +37,7 @@ type instantiateContractReq struct {
 	InitCoins  sdk.Coins    `json:"init_coins" yaml:"init_coins"`
 	InitMsg    string       `json:"init_msg" yaml:"init_msg"`
 	Migratable bool         `json:"migratable" yaml:"migratable"`
+	Label      string       `json:"label,omitempty" yaml:"label,omitempty"`
 }
 
 type executeContractReq struct {
@@ -54,6 +57,10 @@ type updateContractOwnerReq struct {
 	NewOwner sdk.AccAddress `json:"new_owner" yaml:"new_owner"`
 }
 
+type clearContractAdminReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+}
+
 func storeCodeHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		var req storeCodeReq
@@ -93,6 +100,7 @@ func storeCodeHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 
 		// build and sign the transaction, then broadcast to Tendermint
 		msg := types.NewMsgStoreCode(fromAddr, wasmBytes)
+		msg.InstantiatePermission = req.InstantiatePermission
 		if err = msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
@@ -143,6 +151,7 @@ func instantiateContractHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		msg := types.NewMsgInstantiateContract(fromAddr, codeID, initMsgBz, req.InitCoins, req.Migratable)
+		msg.Label = req.Label
 		if err = msg.ValidateBasic(); err != nil {
 			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 		}
@@ -332,3 +341,39 @@ func updateOwnerContractHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
 }
+
+func clearContractAdminHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req clearContractAdminReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+		vars := mux.Vars(r)
+		contractAddr := vars[RestContractAddress]
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		contractAddress, err := sdk.AccAddressFromBech32(contractAddr)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		fromAddr, err := sdk.AccAddressFromBech32(req.BaseReq.From)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		msg := types.NewMsgClearContractAdmin(fromAddr, contractAddress)
+		if err = msg.ValidateBasic(); err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		utils.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -3,7 +3,17 @@ package utils
 import (
 	"bytes"
 	"compress/gzip"
+	"encoding/base64"
 	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+)
+
+// Key encodings accepted by the store listing commands
+const (
+	KeyEncodingHex    = "hex"
+	KeyEncodingBase64 = "base64"
+	KeyEncodingRaw    = "raw"
 )
 
 var (
@@ -53,3 +63,18 @@ func EncodeKey(key string) []byte {
 
 	return keyBz
 }
+
+// DecodeKey decodes a store key given in the key encoding
+func DecodeKey(key, encoding string) ([]byte, error) {
+	switch encoding {
+	case KeyEncodingHex:
+		return hex.DecodeString(key)
+	case KeyEncodingBase64:
+		return base64.StdEncoding.DecodeString(key)
+	case KeyEncodingRaw:
+		return []byte(key), nil
+	default:
+		return nil, fmt.Errorf("invalid key encoding %s, expected one of %s, %s and %s",
+			encoding, KeyEncodingHex, KeyEncodingBase64, KeyEncodingRaw)
+	}
+}
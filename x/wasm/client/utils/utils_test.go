@@ -69,3 +69,28 @@ func TestEncodeKey(t *testing.T) {
 	expected := []byte{0x00, 0x06, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67}
 	require.Equal(t, expected, EncodeKey(key))
 }
+
+func TestDecodeKey(t *testing.T) {
+	for _, tc := range []struct {
+		key      string
+		encoding string
+		expected []byte
+		expErr   bool
+	}{
+		{"636f6e666967", KeyEncodingHex, []byte("config"), false},
+		{"Y29uZmln", KeyEncodingBase64, []byte("config"), false},
+		{"config", KeyEncodingRaw, []byte("config"), false},
+		{"", KeyEncodingHex, []byte{}, false},
+		{"zz", KeyEncodingHex, nil, true},
+		{"config", "utf16", nil, true},
+	} {
+		bz, err := DecodeKey(tc.key, tc.encoding)
+		if tc.expErr {
+			require.Error(t, err, tc.key)
+			continue
+		}
+
+		require.NoError(t, err, tc.key)
+		require.Equal(t, tc.expected, bz, tc.key)
+	}
+}
@@ -24,9 +24,11 @@ type BaseConfig struct {
 	ContractQueryGasLimit uint64 `mapstructure:"contract-query-gas-limit"`
 
 	// Only The logs from the contracts, which are listed in
-	// this array or instantiated from the address in this array,
-	// are stored in the local storage. To keep all logs,
+	// this array, instantiated from the address in this array
+	// or running a code whose id is in this array, are stored
+	// in the local storage. To keep all logs,
 	// a node operator can set "*" (not recommended).
+	// The typed custom events are not filtered by this array.
 	ContractLoggingWhitelist string `mapstructure:"contract-logging-whitelist"`
 }
 
@@ -57,8 +59,8 @@ func (config *Config) WhitelistToMap() (loggingWhitelist map[string]bool) {
 	loggingWhitelist = make(map[string]bool)
 
 	if config.ContractLoggingWhitelist != "*" {
-		for _, addr := range strings.Split(config.ContractLoggingWhitelist, ",") {
-			loggingWhitelist[addr] = true
+		for _, entry := range strings.Split(config.ContractLoggingWhitelist, ",") {
+			loggingWhitelist[strings.TrimSpace(entry)] = true
 		}
 
 		config.loggingAll = false
@@ -19,8 +19,10 @@ const defaultConfigTemplate = `# This is a TOML config file.
 contract-query-gas-limit = "{{ .BaseConfig.ContractQueryGasLimit }}"
 
 # Only The logs from the contracts listed in this array
-# are stored in the local storage. To keep all logs,
+# are stored in the local storage. The array takes contract
+# addresses, creator addresses and code ids. To keep all logs,
 # a node operator can set "*" (not recommended).
+# The typed custom events (wasm-<type>) are always kept.
 contract-logging-whitelist = "{{ .BaseConfig.ContractLoggingWhitelist }}"
 `
 
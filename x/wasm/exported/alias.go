@@ -8,14 +8,17 @@ var (
 	EncodeSdkCoins = types.EncodeSdkCoins
 	ParseToCoin    = types.ParseToCoin
 	ParseToCoins   = types.ParseToCoins
+	ParseGasTraces = types.ParseGasTraces
 
 	ErrInvalidMsg = types.ErrInvalidMsg
 )
 
 type (
-	WasmMsgParserInterface = types.WasmMsgParserInterface
-	WasmQuerierInterface   = types.WasmQuerierInterface
-	MsgInstantiateContract = types.MsgInstantiateContract
-	MsgExecuteContract     = types.MsgExecuteContract
-	MsgStoreCode           = types.MsgStoreCode
+	WasmMsgParserInterface  = types.WasmMsgParserInterface
+	WasmQuerierInterface    = types.WasmQuerierInterface
+	MsgInstantiateContract  = types.MsgInstantiateContract
+	MsgInstantiateContract2 = types.MsgInstantiateContract2
+	MsgExecuteContract      = types.MsgExecuteContract
+	MsgStoreCode            = types.MsgStoreCode
+	GasTrace                = types.GasTrace
 )
@@ -2,6 +2,11 @@ package wasm
 
 import (
 	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/terra-project/core/x/wasm/internal/types"
@@ -18,7 +23,15 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	keeper.SetLastInstanceID(ctx, data.LastInstanceID)
 
 	for _, code := range data.Codes {
-		codeHash, err := keeper.CompileCode(ctx, code.CodesBytes)
+		bytecode := code.CodesBytes
+		if len(bytecode) == 0 && code.CodeFile != "" {
+			var err error
+			if bytecode, err = ioutil.ReadFile(code.CodeFile); err != nil {
+				panic(err)
+			}
+		}
+
+		codeHash, err := keeper.CompileCode(ctx, bytecode)
 		if err != nil {
 			panic(err)
 		}
@@ -33,6 +46,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	for _, contract := range data.Contracts {
 		keeper.SetContractInfo(ctx, contract.ContractInfo.Address, contract.ContractInfo)
 		keeper.SetContractStore(ctx, contract.ContractInfo.Address, contract.ContractStore)
+		keeper.AppendContractHistory(ctx, contract.ContractInfo.Address, contract.History...)
 	}
 }
 
@@ -82,6 +96,7 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		contracts = append(contracts, types.Contract{
 			ContractInfo:  contract,
 			ContractStore: models,
+			History:       keeper.GetContractHistory(ctx, contract.Address),
 		})
 
 		return false
@@ -91,3 +106,126 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 
 	return types.NewGenesisState(params, lastCodeID, lastInstanceID, codes, contracts)
 }
+
+// StreamGenesis writes the genesis state of the keeper to the writer in the
+// JSON of ExportGenesis, code by code and model by model so the state is not
+// held in memory. When codeDir is given, the code bytes are written to
+// <codeDir>/<code_id>.wasm and the codes reference the files by their absolute
+// paths instead, so InitGenesis reads them from any working directory.
+func StreamGenesis(ctx sdk.Context, keeper Keeper, w io.Writer, codeDir string) error {
+	lastCodeID, err := keeper.GetLastCodeID(ctx)
+	if err != nil {
+		return err
+	}
+
+	lastInstanceID, err := keeper.GetLastInstanceID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if codeDir != "" {
+		if codeDir, err = filepath.Abs(codeDir); err != nil {
+			return err
+		}
+
+		if err := os.MkdirAll(codeDir, 0755); err != nil {
+			return err
+		}
+	}
+
+	sw := genesisStreamWriter{w: w}
+	sw.raw(`{"params":`)
+	sw.json(keeper.GetParams(ctx))
+	sw.raw(`,"last_code_id":`)
+	sw.json(lastCodeID)
+	sw.raw(`,"last_instance_id":`)
+	sw.json(lastInstanceID)
+
+	sw.raw(`,"codes":[`)
+	for i := uint64(1); i <= lastCodeID && sw.err == nil; i++ {
+		codeInfo, err := keeper.GetCodeInfo(ctx, i)
+		if err != nil {
+			return err
+		}
+
+		bytecode, err := keeper.GetByteCode(ctx, i)
+		if err != nil {
+			return err
+		}
+
+		code := types.Code{CodeInfo: codeInfo, CodesBytes: bytecode}
+		if codeDir != "" {
+			code = types.Code{CodeInfo: codeInfo, CodeFile: filepath.Join(codeDir, fmt.Sprintf("%d.wasm", i))}
+			if err := ioutil.WriteFile(code.CodeFile, bytecode, 0644); err != nil {
+				return err
+			}
+		}
+
+		if i > 1 {
+			sw.raw(",")
+		}
+		sw.json(code)
+	}
+
+	sw.raw(`],"contracts":[`)
+	first := true
+	keeper.IterateContractInfo(ctx, func(contract types.ContractInfo) bool {
+		if !first {
+			sw.raw(",")
+		}
+		first = false
+
+		sw.raw(`{"contract_info":`)
+		sw.json(contract)
+
+		sw.raw(`,"contract_store":[`)
+		iter := keeper.GetContractStoreIterator(ctx, contract.Address)
+		for firstModel := true; iter.Valid() && sw.err == nil; iter.Next() {
+			if !firstModel {
+				sw.raw(",")
+			}
+			firstModel = false
+
+			sw.json(types.Model{Key: iter.Key(), Value: iter.Value()})
+		}
+		iter.Close()
+		sw.raw("]")
+
+		if history := keeper.GetContractHistory(ctx, contract.Address); len(history) != 0 {
+			sw.raw(`,"history":`)
+			sw.json(history)
+		}
+
+		sw.raw("}")
+		return sw.err != nil
+	})
+	sw.raw("]}")
+
+	return sw.err
+}
+
+// genesisStreamWriter writes the JSON pieces of the genesis state, keeping the first error
+type genesisStreamWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (sw *genesisStreamWriter) raw(s string) {
+	if sw.err == nil {
+		_, sw.err = io.WriteString(sw.w, s)
+	}
+}
+
+func (sw *genesisStreamWriter) json(o interface{}) {
+	if sw.err != nil {
+		return
+	}
+
+	bz, err := types.ModuleCdc.MarshalJSON(o)
+	if err != nil {
+		sw.err = err
+		return
+	}
+
+	_, sw.err = sw.w.Write(bz)
+}
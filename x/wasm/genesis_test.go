@@ -1,7 +1,11 @@
 package wasm
 
 import (
+	"bytes"
 	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	wasmTypes "github.com/CosmWasm/go-cosmwasm/types"
@@ -94,7 +98,7 @@ func TestInitGenesis(t *testing.T) {
 	require.NoError(t, sdkErr)
 	require.Equal(t, testContract, bytecode)
 
-	expectedContractInfo := NewContractInfo(1, contractAddr, creator, initMsgBz, true)
+	expectedContractInfo := NewContractInfo(1, contractAddr, creator, initMsgBz, true, "")
 	contractInfo, sdkErr := data.keeper.GetContractInfo(data.ctx, contractAddr)
 	require.NoError(t, sdkErr)
 	require.Equal(t, expectedContractInfo, contractInfo)
@@ -139,4 +143,79 @@ func TestInitGenesis(t *testing.T) {
 	}
 
 	assertContractStore(t, models, expectedConfigState)
+
+	// history and indexes are restored on the new app
+	require.Equal(t, data.keeper.GetContractHistory(data.ctx, contractAddr), newData.keeper.GetContractHistory(newData.ctx, contractAddr))
+	require.Len(t, newData.keeper.GetContractHistory(newData.ctx, contractAddr), 1)
+	require.Equal(t, []sdk.AccAddress{contractAddr}, newData.keeper.GetContractsByCode(newData.ctx, 1, 1, 10))
+	require.Equal(t, []sdk.AccAddress{contractAddr}, newData.keeper.GetContractsByOwner(newData.ctx, creator, 1, 10))
+
+	// the streamed genesis is the same as the exported one
+	var buf bytes.Buffer
+	require.NoError(t, StreamGenesis(data.ctx, data.keeper, &buf, ""))
+	require.Equal(t, string(sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(genState))), string(sdk.MustSortJSON(buf.Bytes())))
+
+	// the codes are referenced by the absolute paths of the files
+	codeDir, err := ioutil.TempDir("", "wasm_codes")
+	require.NoError(t, err)
+	defer os.RemoveAll(codeDir)
+	wd, err := os.Getwd()
+	require.NoError(t, err)
+	relCodeDir, err := filepath.Rel(wd, codeDir)
+	require.NoError(t, err)
+
+	buf.Reset()
+	require.NoError(t, StreamGenesis(data.ctx, data.keeper, &buf, relCodeDir))
+
+	var streamedGenState GenesisState
+	require.NoError(t, ModuleCdc.UnmarshalJSON(buf.Bytes(), &streamedGenState))
+	require.Len(t, streamedGenState.Codes, 2)
+	require.Empty(t, streamedGenState.Codes[0].CodesBytes)
+	require.Equal(t, filepath.Join(codeDir, "1.wasm"), streamedGenState.Codes[0].CodeFile)
+
+	fileData, fileCleanup := setupTest(t)
+	defer fileCleanup()
+
+	InitGenesis(fileData.ctx, fileData.keeper, streamedGenState)
+	bytecode, err = fileData.keeper.GetByteCode(fileData.ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, maskContract, bytecode)
+	require.Equal(t, genState, ExportGenesis(fileData.ctx, fileData.keeper))
+}
+
+func TestExportGenesisWithSaltedContract(t *testing.T) {
+	loadContracts()
+
+	data, cleanup := setupTest(t)
+	defer cleanup()
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	creator := createFakeFundedAccount(data.ctx, data.acctKeeper, deposit.Add(deposit...))
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+
+	h := data.module.NewHandler()
+	_, err := h(data.ctx, MsgStoreCode{Sender: creator, WASMByteCode: testContract})
+	require.NoError(t, err)
+
+	initMsgBz, err := json.Marshal(initMsg{Verifier: fred.String(), Beneficiary: bob.String()})
+	require.NoError(t, err)
+
+	_, err = h(data.ctx, NewMsgInstantiateContract(creator, 1, initMsgBz, deposit, true))
+	require.NoError(t, err)
+
+	_, err = h(data.ctx, NewMsgInstantiateContract2(creator, 1, initMsgBz, deposit, true, []byte("salt")))
+	require.NoError(t, err)
+
+	// the salted contract takes no instance ID
+	genState := ExportGenesis(data.ctx, data.keeper)
+	require.Len(t, genState.Contracts, 2)
+	require.Equal(t, uint64(1), genState.LastInstanceID)
+	require.NoError(t, ValidateGenesis(genState))
+
+	newData, newCleanup := setupTest(t)
+	defer newCleanup()
+
+	InitGenesis(newData.ctx, newData.keeper, genState)
+	require.Equal(t, genState, ExportGenesis(newData.ctx, newData.keeper))
 }
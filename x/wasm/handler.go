@@ -5,25 +5,43 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	core "github.com/terra-project/core/types"
+
 	"github.com/terra-project/core/x/wasm/internal/types"
 )
 
 // NewHandler returns a handler for "wasm" type messages.
 func NewHandler(k Keeper) sdk.Handler {
-	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+	return func(ctx sdk.Context, msg sdk.Msg) (res *sdk.Result, err error) {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
 
+		// record the gas trace of the outermost wasm msg on simulation only,
+		// the msgs dispatched by contracts are recorded in the same trace
+		if core.IsSimulation(ctx) && types.GetGasTrace(ctx) == nil {
+			var trace *types.GasTrace
+			ctx, trace = types.WithGasTrace(ctx)
+			defer func() {
+				res = attachGasTrace(ctx, k, trace, res, err)
+			}()
+		}
+
 		switch msg := msg.(type) {
 		case MsgStoreCode:
 			return handleStoreCode(ctx, k, msg)
 		case MsgInstantiateContract:
 			return handleInstantiate(ctx, k, msg)
+		case MsgInstantiateContract2:
+			return handleInstantiate2(ctx, k, msg)
 		case MsgExecuteContract:
 			return handleExecute(ctx, k, msg)
 		case MsgMigrateContract:
 			return handleMigrate(ctx, k, msg)
 		case MsgUpdateContractOwner:
 			return handleUpdateContractOwner(ctx, k, msg)
+		case MsgClearContractAdmin:
+			return handleClearContractAdmin(ctx, k, msg)
 
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized wasm message type: %T", msg)
@@ -31,8 +49,36 @@ func NewHandler(k Keeper) sdk.Handler {
 	}
 }
 
+// attachGasTrace adds the gas trace to the result as an event; the trace of a failed
+// msg, which has no result, or of a panicking one, which has neither a result nor
+// an error, is logged instead, leaving the error or the panic untouched
+func attachGasTrace(ctx sdk.Context, k Keeper, trace *types.GasTrace, res *sdk.Result, err error) *sdk.Result {
+	trace.Summarize()
+	bz, jsonErr := types.ModuleCdc.MarshalJSON(trace)
+	if jsonErr != nil {
+		return res
+	}
+
+	if err != nil || res == nil {
+		k.Logger(ctx).Debug("gas trace of the failed msg", "trace", string(bz))
+		return res
+	}
+
+	res.Events = append(res.Events, sdk.NewEvent(
+		types.EventTypeGasTrace,
+		sdk.NewAttribute(types.AttributeKeyGasTrace, string(bz)),
+	))
+
+	return res
+}
+
 func handleStoreCode(ctx sdk.Context, k Keeper, msg MsgStoreCode) (*sdk.Result, error) {
-	codeID, err := k.StoreCode(ctx, msg.Sender, msg.WASMByteCode)
+	instantiatePermission := types.AllowEverybody
+	if msg.InstantiatePermission != nil {
+		instantiatePermission = *msg.InstantiatePermission
+	}
+
+	codeID, err := k.StoreCodeWithPermission(ctx, msg.Sender, msg.WASMByteCode, instantiatePermission)
 	if err != nil {
 		return nil, err
 	}
@@ -55,7 +101,30 @@ func handleStoreCode(ctx sdk.Context, k Keeper, msg MsgStoreCode) (*sdk.Result,
 }
 
 func handleInstantiate(ctx sdk.Context, k Keeper, msg MsgInstantiateContract) (*sdk.Result, error) {
-	contractAddr, err := k.InstantiateContract(ctx, msg.CodeID, msg.Owner, msg.InitMsg, msg.InitCoins, msg.Migratable)
+	contractAddr, err := k.InstantiateContract(ctx, msg.CodeID, msg.Owner, msg.InitMsg, msg.InitCoins, msg.Migratable, msg.Label)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{Events: filterMessageEvents(ctx.EventManager()).AppendEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeInstantiateContract,
+				sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner.String()),
+				sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", msg.CodeID)),
+				sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddr.String()),
+			),
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(sdk.AttributeKeySender, msg.Owner.String()),
+			),
+		},
+	)}, nil
+}
+
+func handleInstantiate2(ctx sdk.Context, k Keeper, msg MsgInstantiateContract2) (*sdk.Result, error) {
+	contractAddr, err := k.InstantiateContract2(ctx, msg.CodeID, msg.Owner, msg.InitMsg, msg.InitCoins, msg.Migratable, msg.Label, msg.Salt)
 	if err != nil {
 		return nil, err
 	}
@@ -122,18 +191,10 @@ func handleMigrate(ctx sdk.Context, k Keeper, msg MsgMigrateContract) (*sdk.Resu
 }
 
 func handleUpdateContractOwner(ctx sdk.Context, k Keeper, msg MsgUpdateContractOwner) (*sdk.Result, error) {
-	contractInfo, err := k.GetContractInfo(ctx, msg.Contract)
-	if err != nil {
+	if err := k.UpdateContractOwner(ctx, msg.Contract, msg.Owner, msg.NewOwner); err != nil {
 		return nil, err
 	}
 
-	if !contractInfo.Owner.Equals(msg.Owner) {
-		return nil, sdkerrors.ErrUnauthorized
-	}
-
-	contractInfo.Owner = msg.NewOwner
-	k.SetContractInfo(ctx, msg.Contract, contractInfo)
-
 	ctx.EventManager().EmitEvents(
 		sdk.Events{
 			sdk.NewEvent(
@@ -151,6 +212,130 @@ func handleUpdateContractOwner(ctx sdk.Context, k Keeper, msg MsgUpdateContractO
 	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
 }
 
+func handleClearContractAdmin(ctx sdk.Context, k Keeper, msg MsgClearContractAdmin) (*sdk.Result, error) {
+	if err := k.ClearContractAdmin(ctx, msg.Contract, msg.Owner); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(
+		sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeClearContractAdmin,
+				sdk.NewAttribute(types.AttributeKeyContractAddress, msg.Contract.String()),
+			),
+			sdk.NewEvent(
+				sdk.EventTypeMessage,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			),
+		},
+	)
+
+	return &sdk.Result{Events: ctx.EventManager().Events()}, nil
+}
+
+// NewWasmProposalHandler custom gov proposal handler
+func NewWasmProposalHandler(k Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case StoreCodeProposal:
+			return handleStoreCodeProposal(ctx, k, c)
+		case InstantiateContractProposal:
+			return handleInstantiateContractProposal(ctx, k, c)
+		case MigrateContractProposal:
+			return handleMigrateContractProposal(ctx, k, c)
+		case UpdateContractAdminProposal:
+			return handleUpdateContractAdminProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized wasm proposal content type: %T", c)
+		}
+	}
+}
+
+// handleStoreCodeProposal is a handler for uploading a code regardless of the upload access
+func handleStoreCodeProposal(ctx sdk.Context, k Keeper, p StoreCodeProposal) error {
+	instantiatePermission := types.AllowEverybody
+	if p.InstantiatePermission != nil {
+		instantiatePermission = *p.InstantiatePermission
+	}
+
+	codeID, err := k.StoreCodeByGov(ctx, p.Creator, p.WASMByteCode, instantiatePermission)
+	if err != nil {
+		return err
+	}
+
+	// Emit gov handler events
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeStoreCode,
+			sdk.NewAttribute(types.AttributeKeySender, p.Creator.String()),
+			sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", codeID)),
+		),
+	)
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("stored code %d by governance", codeID))
+	return nil
+}
+
+// handleInstantiateContractProposal is a handler for instantiating a contract regardless of the instantiate permission
+func handleInstantiateContractProposal(ctx sdk.Context, k Keeper, p InstantiateContractProposal) error {
+	contractAddr, err := k.InstantiateContractByGov(ctx, p.CodeID, p.Owner, p.InitMsg, p.InitCoins, p.Migratable, p.Label)
+	if err != nil {
+		return err
+	}
+
+	// Emit gov handler events
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeInstantiateContract,
+			sdk.NewAttribute(types.AttributeKeyOwner, p.Owner.String()),
+			sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", p.CodeID)),
+			sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddr.String()),
+		),
+	)
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("instantiated contract %s by governance", contractAddr))
+	return nil
+}
+
+// handleMigrateContractProposal is a handler for migrating a contract regardless of its owner
+func handleMigrateContractProposal(ctx sdk.Context, k Keeper, p MigrateContractProposal) error {
+	if _, err := k.MigrateContractByGov(ctx, p.Contract, p.NewCodeID, p.MigrateMsg); err != nil {
+		return err
+	}
+
+	// Emit gov handler events
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeMigrateContract,
+			sdk.NewAttribute(types.AttributeKeyCodeID, fmt.Sprintf("%d", p.NewCodeID)),
+			sdk.NewAttribute(types.AttributeKeyContractAddress, p.Contract.String()),
+		),
+	)
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("migrated contract %s to code %d by governance", p.Contract, p.NewCodeID))
+	return nil
+}
+
+// handleUpdateContractAdminProposal is a handler for updating a contract owner regardless of the current owner
+func handleUpdateContractAdminProposal(ctx sdk.Context, k Keeper, p UpdateContractAdminProposal) error {
+	if err := k.UpdateContractOwnerByGov(ctx, p.Contract, p.NewAdmin); err != nil {
+		return err
+	}
+
+	// Emit gov handler events
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeUpdateContractOwner,
+			sdk.NewAttribute(types.AttributeKeyOwner, p.NewAdmin.String()),
+			sdk.NewAttribute(types.AttributeKeyContractAddress, p.Contract.String()),
+		),
+	)
+
+	logger := k.Logger(ctx)
+	logger.Info(fmt.Sprintf("updated admin of contract %s to %s by governance", p.Contract, p.NewAdmin))
+	return nil
+}
+
 // filterMessageEvents returns the same events with all of type == EventTypeMessage removed.
 // this is so only our top-level message event comes through
 func filterMessageEvents(manager *sdk.EventManager) sdk.Events {
@@ -137,7 +137,7 @@ func TestHandleInstantiate(t *testing.T) {
 	require.False(t, contractAddr.Empty())
 
 	contractInfo, err := data.keeper.GetContractInfo(data.ctx, contractAddr)
-	expectedContractInfo := types.NewContractInfo(1, contractAddr, creator, initMsgBz, true)
+	expectedContractInfo := types.NewContractInfo(1, contractAddr, creator, initMsgBz, true, "")
 	require.Equal(t, expectedContractInfo, contractInfo)
 
 	iter := data.keeper.GetContractStoreIterator(data.ctx, contractAddr)
@@ -213,7 +213,7 @@ func TestHandleExecute(t *testing.T) {
 	require.False(t, contractAddr.Empty())
 
 	contractInfo, err := data.keeper.GetContractInfo(data.ctx, contractAddr)
-	expectedContractInfo := types.NewContractInfo(1, contractAddr, creator, initMsgBz, true)
+	expectedContractInfo := types.NewContractInfo(1, contractAddr, creator, initMsgBz, true, "")
 	require.Equal(t, expectedContractInfo, contractInfo)
 
 	// ensure bob doesn't exist
@@ -325,7 +325,7 @@ func TestHandleExecuteEscrow(t *testing.T) {
 	require.False(t, contractAddr.Empty())
 
 	contractInfo, err := data.keeper.GetContractInfo(data.ctx, contractAddr)
-	expectedContractInfo := types.NewContractInfo(1, contractAddr, creator, initMsgBz, true)
+	expectedContractInfo := types.NewContractInfo(1, contractAddr, creator, initMsgBz, true, "")
 	require.Equal(t, expectedContractInfo, contractInfo)
 
 	handleMsg := map[string]interface{}{
@@ -511,3 +511,135 @@ func TestHandleUpdateOwner(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, fred, cInfo.Owner)
 }
+
+func TestHandleClearContractAdmin(t *testing.T) {
+	loadContracts()
+
+	data, cleanup := setupTest(t)
+	defer cleanup()
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	topUp := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 5000))
+	creator := createFakeFundedAccount(data.ctx, data.acctKeeper, deposit.Add(deposit...))
+	fred := createFakeFundedAccount(data.ctx, data.acctKeeper, topUp)
+
+	h := data.module.NewHandler()
+
+	storeMsg := MsgStoreCode{
+		Sender:       creator,
+		WASMByteCode: testContract,
+	}
+	_, err := h(data.ctx, storeMsg)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	initData := map[string]interface{}{
+		"verifier":    fred.String(),
+		"beneficiary": bob.String(),
+	}
+	initDataBz, err := json.Marshal(initData)
+	require.NoError(t, err)
+
+	contractAddr, err := data.keeper.InstantiateContract(data.ctx, 1, creator, initDataBz, deposit, true, "")
+	require.NoError(t, err)
+
+	// only the owner can clear the admin
+	_, err = h(data.ctx, NewMsgClearContractAdmin(fred, contractAddr))
+	require.Error(t, err)
+
+	_, err = h(data.ctx, NewMsgClearContractAdmin(creator, contractAddr))
+	require.NoError(t, err)
+
+	cInfo, err := data.keeper.GetContractInfo(data.ctx, contractAddr)
+	require.NoError(t, err)
+	require.True(t, cInfo.Owner.Empty())
+	require.False(t, cInfo.Migratable)
+
+	// the contract is immutable for good
+	_, err = h(data.ctx, NewMsgUpdateContractOwner(creator, fred, contractAddr))
+	require.Error(t, err)
+	_, err = h(data.ctx, NewMsgMigrateContract(creator, contractAddr, 1, []byte("{}")))
+	require.Error(t, err)
+	_, err = h(data.ctx, NewMsgClearContractAdmin(creator, contractAddr))
+	require.Error(t, err)
+}
+
+func TestHandleExecuteGasTrace(t *testing.T) {
+	loadContracts()
+
+	data, cleanup := setupTest(t)
+	defer cleanup()
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	creator := createFakeFundedAccount(data.ctx, data.acctKeeper, deposit)
+	fred := createFakeFundedAccount(data.ctx, data.acctKeeper, deposit)
+
+	h := data.module.NewHandler()
+
+	_, err := h(data.ctx, MsgStoreCode{Sender: creator, WASMByteCode: testContract})
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	initMsgBz, err := json.Marshal(initMsg{Verifier: fred.String(), Beneficiary: bob.String()})
+	require.NoError(t, err)
+
+	contractAddr, err := data.keeper.InstantiateContract(data.ctx, 1, creator, initMsgBz, deposit, true, "")
+	require.NoError(t, err)
+
+	execCmd := MsgExecuteContract{
+		Sender:     fred,
+		Contract:   contractAddr,
+		ExecuteMsg: []byte(`{"release":{}}`),
+	}
+
+	// no trace outside of a simulation
+	cacheCtx, _ := data.ctx.CacheContext()
+	res, err := h(cacheCtx, execCmd)
+	require.NoError(t, err)
+	traces, err := types.ParseGasTraces(res.Events)
+	require.NoError(t, err)
+	require.Empty(t, traces)
+
+	ctx := core.WithSimulation(data.ctx)
+	gasBefore := ctx.GasMeter().GasConsumed()
+	res, err = h(ctx, execCmd)
+	require.NoError(t, err)
+	gasUsed := ctx.GasMeter().GasConsumed() - gasBefore
+
+	traces, err = types.ParseGasTraces(res.Events)
+	require.NoError(t, err)
+	require.Len(t, traces, 1)
+
+	trace := traces[0]
+	require.Equal(t, gasUsed, trace.GasUsed)
+
+	var sum uint64
+	categories := make(map[types.GasTraceCategory]bool)
+	for _, total := range trace.Totals {
+		sum += total.Gas
+		categories[total.Category] = true
+	}
+
+	require.Equal(t, gasUsed, sum)
+	require.True(t, categories[types.GasTraceCategoryVM])
+	require.True(t, categories[types.GasTraceCategoryStorageRead])
+	require.True(t, categories[types.GasTraceCategoryDispatch])
+
+	// the error of a failed msg is not wrapped with the trace
+	_, err = h(ctx, MsgExecuteContract{Sender: fred, Contract: contractAddr, ExecuteMsg: []byte(`{"invalid":{}}`)})
+	require.Error(t, err)
+	require.NotContains(t, err.Error(), "gas trace")
+
+	// the out of gas panic reaches the caller as it is
+	outOfGasCtx := ctx.WithGasMeter(sdk.NewGasMeter(10000))
+	func() {
+		defer func() {
+			r := recover()
+			require.NotNil(t, r)
+			_, ok := r.(sdk.ErrorOutOfGas)
+			require.True(t, ok, "unexpected panic %v", r)
+		}()
+
+		_, _ = h(outOfGasCtx, execCmd)
+	}()
+}
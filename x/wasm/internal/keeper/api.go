@@ -15,6 +15,11 @@ func (k Keeper) getCosmwamAPI(ctx sdk.Context) cosmwasm.GoAPI {
 			if len(canon) != sdk.AddrLen {
 				return "", 0, fmt.Errorf("Expected %d byte address", sdk.AddrLen)
 			}
+
+			if trace := types.GetGasTrace(ctx); trace != nil {
+				trace.RecordAPICall(types.GasTraceCategoryHumanize, types.HumanizeCost)
+			}
+
 			return sdk.AccAddress(canon).String(), types.HumanizeCost * types.GasMultiplier, nil
 		},
 		CanonicalAddress: func(human string) (canonicalAddr []byte, usedGas uint64, err error) {
@@ -23,6 +28,10 @@ func (k Keeper) getCosmwamAPI(ctx sdk.Context) cosmwasm.GoAPI {
 				return nil, 0, err
 			}
 
+			if trace := types.GetGasTrace(ctx); trace != nil {
+				trace.RecordAPICall(types.GasTraceCategoryCanonicalize, types.CanonicalizeCost)
+			}
+
 			return addr, types.CanonicalizeCost * types.GasMultiplier, nil
 		},
 	}
@@ -1,8 +1,13 @@
 package keeper
 
 import (
+	"encoding/json"
+	"strconv"
+
 	wasmTypes "github.com/CosmWasm/go-cosmwasm/types"
+	core "github.com/terra-project/core/types"
 	"github.com/terra-project/core/x/auth/ante"
+	"github.com/terra-project/core/x/wasm/internal/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -10,8 +15,41 @@ import (
 )
 
 func (k Keeper) dispatchMessages(ctx sdk.Context, contractAddr sdk.AccAddress, msgs []wasmTypes.CosmosMsg) error {
+	// consecutive plain msgs are dispatched together, so the contract without
+	// submessages is charged tax for all msgs before any execution as before
+	var plainMsgs []wasmTypes.CosmosMsg
+	for _, msg := range msgs {
+		subMsg, ok, err := types.ParseSubMsg(msg)
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			plainMsgs = append(plainMsgs, msg)
+			continue
+		}
+
+		if err := k.dispatchPlainMessages(ctx, contractAddr, plainMsgs); err != nil {
+			return err
+		}
+
+		plainMsgs = nil
+		if err := k.dispatchSubMessage(ctx, contractAddr, subMsg); err != nil {
+			return err
+		}
+	}
+
+	return k.dispatchPlainMessages(ctx, contractAddr, plainMsgs)
+}
+
+func (k Keeper) dispatchPlainMessages(ctx sdk.Context, contractAddr sdk.AccAddress, msgs []wasmTypes.CosmosMsg) error {
 	var sdkMsgs []sdk.Msg
 	for _, msg := range msgs {
+		// the custom msgs of the wasm route were ignored before the feature
+		if route, ok := types.CustomMsgRoute(msg); ok && route == types.WasmMsgParserRouteWasm &&
+			!core.IsFeatureActive(ctx, core.FeatureWasmCustomMsgs) {
+			continue
+		}
 
 		msgs, err := k.msgParser.Parse(contractAddr, msg)
 		if err != nil {
@@ -39,6 +77,46 @@ func (k Keeper) dispatchMessages(ctx sdk.Context, contractAddr sdk.AccAddress, m
 	return nil
 }
 
+// dispatchSubMessage executes the submessage in a cached context, which is committed only
+// on success, and calls the contract back with the result as its reply policy requires.
+// The gas used by the submessage is consumed from the same gas meter whether it succeeds or not.
+func (k Keeper) dispatchSubMessage(ctx sdk.Context, contractAddr sdk.AccAddress, subMsg types.SubMsg) error {
+	cacheCtx, write := ctx.CacheContext()
+	err := k.dispatchMessages(cacheCtx, contractAddr, []wasmTypes.CosmosMsg{subMsg.Msg})
+	if err == nil {
+		write()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+
+	success := err == nil
+	if !subMsg.ShouldReply(success) {
+		// the contract does not handle the error
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeReply,
+			sdk.NewAttribute(types.AttributeKeyContractAddress, contractAddr.String()),
+			sdk.NewAttribute(types.AttributeKeySubMsgID, strconv.FormatUint(subMsg.ID, 10)),
+			sdk.NewAttribute(types.AttributeKeyReplyOn, string(subMsg.ReplyOn)),
+			sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(success)),
+		),
+	)
+
+	replyBz, jsonErr := json.Marshal(types.NewReplyMsg(subMsg.ID, cacheCtx.EventManager().Events(), err))
+	if jsonErr != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, jsonErr.Error())
+	}
+
+	// the reply is sent by ReplySender, not to be taken for a self-call
+	if _, err := k.ExecuteContract(ctx, contractAddr, types.ReplySender, replyBz, nil); err != nil {
+		return sdkerrors.Wrapf(err, "reply to sub msg %d", subMsg.ID)
+	}
+
+	return nil
+}
+
 func (k Keeper) handleSdkMessage(ctx sdk.Context, contractAddr sdk.AccAddress, msg sdk.Msg) error {
 	// make sure this account can send it
 	for _, acct := range msg.GetSigners() {
@@ -53,6 +131,11 @@ func (k Keeper) handleSdkMessage(ctx sdk.Context, contractAddr sdk.AccAddress, m
 		return sdkerrors.Wrap(sdkerrors.ErrUnknownRequest, msg.Route())
 	}
 
+	if trace := types.GetGasTrace(ctx); trace != nil {
+		trace.BeginScope(types.GasTraceCategoryDispatch)
+		defer trace.EndScope()
+	}
+
 	res, err := h(ctx, msg)
 	if err != nil {
 		return err
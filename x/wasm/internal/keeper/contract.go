@@ -6,6 +6,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authexported "github.com/cosmos/cosmos-sdk/x/auth/exported"
+	supplyexported "github.com/cosmos/cosmos-sdk/x/supply/exported"
 	"github.com/tendermint/tendermint/crypto"
 	core "github.com/terra-project/core/types"
 	"github.com/terra-project/core/x/wasm/internal/types"
@@ -45,8 +47,29 @@ func (k Keeper) CompileCode(ctx sdk.Context, wasmCode []byte) (codeHash []byte,
 	return
 }
 
-// StoreCode uploads and compiles a WASM contract bytecode, returning a short identifier for the stored code
+// StoreCode uploads and compiles a WASM contract bytecode, returning a short identifier for the stored code.
+// The stored code can be instantiated by everybody.
 func (k Keeper) StoreCode(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte) (codeID uint64, err error) {
+	return k.StoreCodeWithPermission(ctx, creator, wasmCode, types.AllowEverybody)
+}
+
+// StoreCodeWithPermission uploads and compiles a WASM contract bytecode with the given instantiate permission.
+// The creator must be allowed by the upload access param.
+func (k Keeper) StoreCodeWithPermission(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiatePermission types.AccessConfig) (codeID uint64, err error) {
+	if !k.UploadAccess(ctx).Allowed(creator) {
+		return 0, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to upload code", creator)
+	}
+
+	return k.storeCode(ctx, creator, wasmCode, instantiatePermission)
+}
+
+// StoreCodeByGov uploads and compiles a WASM contract bytecode on behalf of the creator,
+// bypassing the upload access param. It must only be called by the governance proposal handler.
+func (k Keeper) StoreCodeByGov(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiatePermission types.AccessConfig) (codeID uint64, err error) {
+	return k.storeCode(ctx, creator, wasmCode, instantiatePermission)
+}
+
+func (k Keeper) storeCode(ctx sdk.Context, creator sdk.AccAddress, wasmCode []byte, instantiatePermission types.AccessConfig) (codeID uint64, err error) {
 	codeHash, err := k.CompileCode(ctx, wasmCode)
 	if err != nil {
 		return 0, err
@@ -58,7 +81,7 @@ func (k Keeper) StoreCode(ctx sdk.Context, creator sdk.AccAddress, wasmCode []by
 	}
 
 	codeID++
-	codeInfo := types.NewCodeInfo(codeID, codeHash, creator)
+	codeInfo := types.NewCodeInfo(codeID, codeHash, creator, instantiatePermission)
 
 	k.SetLastCodeID(ctx, codeID)
 	k.SetCodeInfo(ctx, codeID, codeInfo)
@@ -66,37 +89,107 @@ func (k Keeper) StoreCode(ctx sdk.Context, creator sdk.AccAddress, wasmCode []by
 	return codeID, nil
 }
 
-// InstantiateContract creates an instance of a WASM contract
+// InstantiateContract creates an instance of a WASM contract.
+// The creator must be allowed by the instantiate permission of the code.
 func (k Keeper) InstantiateContract(
 	ctx sdk.Context,
 	codeID uint64,
 	creator sdk.AccAddress,
 	initMsg []byte,
 	deposit sdk.Coins,
-	migratable bool) (contractAddress sdk.AccAddress, err error) {
+	migratable bool,
+	label string) (contractAddress sdk.AccAddress, err error) {
+	return k.instantiate(ctx, codeID, creator, initMsg, deposit, migratable, label, nil, true)
+}
+
+// InstantiateContract2 creates an instance of a WASM contract at the address derived from
+// the creator, the code hash and the salt, which can be known before the instantiation
+func (k Keeper) InstantiateContract2(
+	ctx sdk.Context,
+	codeID uint64,
+	creator sdk.AccAddress,
+	initMsg []byte,
+	deposit sdk.Coins,
+	migratable bool,
+	label string,
+	salt []byte) (contractAddress sdk.AccAddress, err error) {
+	if err := types.ValidateSalt(salt); err != nil {
+		return nil, err
+	}
+
+	return k.instantiate(ctx, codeID, creator, initMsg, deposit, migratable, label, salt, true)
+}
+
+// InstantiateContractByGov creates an instance of a WASM contract on behalf of the creator,
+// bypassing the instantiate permission of the code. It must only be called by the governance
+// proposal handler.
+func (k Keeper) InstantiateContractByGov(
+	ctx sdk.Context,
+	codeID uint64,
+	creator sdk.AccAddress,
+	initMsg []byte,
+	deposit sdk.Coins,
+	migratable bool,
+	label string) (contractAddress sdk.AccAddress, err error) {
+	return k.instantiate(ctx, codeID, creator, initMsg, deposit, migratable, label, nil, false)
+}
+
+func (k Keeper) instantiate(
+	ctx sdk.Context,
+	codeID uint64,
+	creator sdk.AccAddress,
+	initMsg []byte,
+	deposit sdk.Coins,
+	migratable bool,
+	label string,
+	salt []byte,
+	checkPermission bool) (contractAddress sdk.AccAddress, err error) {
 	ctx.GasMeter().ConsumeGas(types.InstanceCost, "Loading CosmWasm module: init")
 
 	if uint64(len(initMsg)) > k.MaxContractMsgSize(ctx) {
 		return nil, sdkerrors.Wrap(types.ErrInstantiateFailed, "init msg size is too huge")
 	}
 
-	instanceID, err := k.GetLastInstanceID(ctx)
+	if err := types.ValidateLabel(label); err != nil {
+		return nil, err
+	}
+
+	// get code info
+	codeInfo, err := k.GetCodeInfo(ctx, codeID)
 	if err != nil {
 		return nil, err
 	}
 
-	instanceID++
+	if checkPermission && !codeInfo.InstantiatePermission.Allowed(creator) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to instantiate code %d", creator, codeID)
+	}
+
+	// create contract address; the instance counter is only used without a salt
+	var instanceID uint64
+	if salt == nil {
+		instanceID, err = k.GetLastInstanceID(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		instanceID++
+		contractAddress = k.generateContractAddress(ctx, codeID, instanceID)
+	} else {
+		contractAddress = types.PredictableContractAddress(creator, codeInfo.CodeHash, salt)
+	}
 
-	// create contract address
-	contractAddress = k.generateContractAddress(ctx, codeID, instanceID)
+	// a predicted address can be funded before the instantiation, so the unused account of
+	// the address is taken over for the contract instead of blocking the salt
 	existingAcct := k.accountKeeper.GetAccount(ctx, contractAddress)
-	if existingAcct != nil {
+	if existingAcct != nil && (salt == nil || !k.isUnusedAccount(ctx, existingAcct)) {
 		return nil, sdkerrors.Wrap(types.ErrAccountExists, existingAcct.GetAddress().String())
 	}
 
 	// create contract account
-	contractAccount := k.accountKeeper.NewAccountWithAddress(ctx, contractAddress)
-	k.accountKeeper.SetAccount(ctx, contractAccount)
+	if existingAcct == nil {
+		contractAccount := k.accountKeeper.NewAccountWithAddress(ctx, contractAddress)
+		k.accountKeeper.SetAccount(ctx, contractAccount)
+	}
 
 	// deposit initial contract funds
 	if !deposit.IsZero() {
@@ -106,17 +199,6 @@ func (k Keeper) InstantiateContract(
 		}
 	}
 
-	// get code info
-	store := ctx.KVStore(k.storeKey)
-	bz := store.Get(types.GetCodeInfoKey(codeID))
-	if bz == nil {
-		err = sdkerrors.Wrapf(types.ErrNotFound, "codeID %d", codeID)
-		return
-	}
-
-	var codeInfo types.CodeInfo
-	k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &codeInfo)
-
 	// prepare params for contract instantiate call
 	apiParams := types.NewWasmAPIParams(ctx, creator, deposit, contractAddress)
 
@@ -144,15 +226,29 @@ func (k Keeper) InstantiateContract(
 	}
 
 	// Must store contract info first, so last part can use it
-	contractInfo := types.NewContractInfo(codeID, contractAddress, creator, initMsg, migratable)
+	contractInfo := types.NewContractInfo(codeID, contractAddress, creator, initMsg, migratable, label)
+
+	if salt == nil {
+		k.SetLastInstanceID(ctx, instanceID)
+	}
 
-	k.SetLastInstanceID(ctx, instanceID)
 	k.SetContractInfo(ctx, contractAddress, contractInfo)
+	k.AppendContractHistory(ctx, contractAddress, types.NewContractHistoryEntry(
+		types.ContractHistoryOperationInit, codeID, ctx.BlockHeight(), initMsg))
+
+	events, customEvents, err := types.ParseEvents(res.Log, contractAddress, core.IsFeatureActive(ctx, core.FeatureWasmTypedEvents))
+	if err != nil {
+		err = sdkerrors.Wrap(types.ErrInstantiateFailed, err.Error())
+		return
+	}
+
+	ctx.EventManager().EmitEvents(customEvents)
 
-	// check contract creator address is in whitelist
-	if _, ok := k.loggingWhitelist[creator.String()]; ok || k.wasmConfig.LoggingAll() {
-		events := types.ParseEvents(res.Log, contractAddress)
+	// check contract creator address or code id is in whitelist
+	if k.isLoggingWhitelisted(codeID, creator) {
 		ctx.EventManager().EmitEvents(events)
+
+		_, ok := k.loggingWhitelist[creator.String()]
 		if ok && !ctx.IsCheckTx() && !ctx.IsReCheckTx() {
 			// If a contract is created from whitelist,
 			// add the contract to whitelist.
@@ -217,9 +313,14 @@ func (k Keeper) ExecuteContract(ctx sdk.Context, contractAddress sdk.AccAddress,
 		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, err.Error())
 	}
 
-	// emit all events from the contract in the logging whitelist
-	if _, ok := k.loggingWhitelist[contractAddress.String()]; k.wasmConfig.LoggingAll() || ok {
-		events := types.ParseEvents(res.Log, contractAddress)
+	events, customEvents, err := types.ParseEvents(res.Log, contractAddress, core.IsFeatureActive(ctx, core.FeatureWasmTypedEvents))
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrExecuteFailed, err.Error())
+	}
+
+	// custom events are always emitted, and the logs only from the contracts in the logging whitelist
+	ctx.EventManager().EmitEvents(customEvents)
+	if k.isLoggingWhitelisted(codeInfo.CodeID, contractAddress) {
 		ctx.EventManager().EmitEvents(events)
 	}
 
@@ -232,7 +333,23 @@ func (k Keeper) ExecuteContract(ctx sdk.Context, contractAddress sdk.AccAddress,
 }
 
 // MigrateContract allows to upgrade a contract to a new code with data migration.
+// The caller must be the owner of the contract.
 func (k Keeper) MigrateContract(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, migrateMsg []byte) ([]byte, error) {
+	return k.migrate(ctx, contractAddress, caller, newCodeID, migrateMsg, true)
+}
+
+// MigrateContractByGov migrates a migratable contract on behalf of its owner, bypassing the owner check.
+// It must only be called by the governance proposal handler.
+func (k Keeper) MigrateContractByGov(ctx sdk.Context, contractAddress sdk.AccAddress, newCodeID uint64, migrateMsg []byte) ([]byte, error) {
+	contractInfo, err := k.GetContractInfo(ctx, contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.migrate(ctx, contractAddress, contractInfo.Owner, newCodeID, migrateMsg, false)
+}
+
+func (k Keeper) migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller sdk.AccAddress, newCodeID uint64, migrateMsg []byte, checkOwner bool) ([]byte, error) {
 	ctx.GasMeter().ConsumeGas(types.InstanceCost, "Loading CosmWasm module: migrate")
 
 	if uint64(len(migrateMsg)) > k.MaxContractMsgSize(ctx) {
@@ -248,7 +365,7 @@ func (k Keeper) MigrateContract(ctx sdk.Context, contractAddress sdk.AccAddress,
 		return nil, types.ErrNotMigratable
 	}
 
-	if !contractInfo.Owner.Equals(caller) {
+	if checkOwner && (contractInfo.Owner.Empty() || !contractInfo.Owner.Equals(caller)) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "no permission")
 	}
 
@@ -280,14 +397,21 @@ func (k Keeper) MigrateContract(ctx sdk.Context, contractAddress sdk.AccAddress,
 		return nil, sdkerrors.Wrap(types.ErrMigrationFailed, err.Error())
 	}
 
-	// emit all events from the contract in the logging whitelist
-	if _, ok := k.loggingWhitelist[contractAddress.String()]; k.wasmConfig.LoggingAll() || ok {
-		events := types.ParseEvents(res.Log, contractAddress)
+	events, customEvents, err := types.ParseEvents(res.Log, contractAddress, core.IsFeatureActive(ctx, core.FeatureWasmTypedEvents))
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrMigrationFailed, err.Error())
+	}
+
+	// custom events are always emitted, and the logs only from the contracts in the logging whitelist
+	ctx.EventManager().EmitEvents(customEvents)
+	if k.isLoggingWhitelisted(newCodeID, contractAddress) {
 		ctx.EventManager().EmitEvents(events)
 	}
 
 	contractInfo.CodeID = newCodeID
 	k.SetContractInfo(ctx, contractAddress, contractInfo)
+	k.AppendContractHistory(ctx, contractAddress, types.NewContractHistoryEntry(
+		types.ContractHistoryOperationMigrate, newCodeID, ctx.BlockHeight(), migrateMsg))
 
 	if err := k.dispatchMessages(ctx, contractAddress, res.Messages); err != nil {
 		return nil, sdkerrors.Wrap(err, "dispatch")
@@ -296,6 +420,91 @@ func (k Keeper) MigrateContract(ctx sdk.Context, contractAddress sdk.AccAddress,
 	return res.Data, nil
 }
 
+// UpdateContractOwner transfers the ownership of a contract to the new owner.
+// The caller must be the current owner of the contract.
+func (k Keeper) UpdateContractOwner(ctx sdk.Context, contractAddress, caller, newOwner sdk.AccAddress) error {
+	contractInfo, err := k.GetContractInfo(ctx, contractAddress)
+	if err != nil {
+		return err
+	}
+
+	if contractInfo.Owner.Empty() || !contractInfo.Owner.Equals(caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "no permission")
+	}
+
+	contractInfo.Owner = newOwner
+	k.SetContractInfo(ctx, contractAddress, contractInfo)
+
+	return nil
+}
+
+// UpdateContractOwnerByGov transfers the ownership of a contract, bypassing the owner check.
+// Contracts whose admin was cleared stay immutable. It must only be called by the governance
+// proposal handler.
+func (k Keeper) UpdateContractOwnerByGov(ctx sdk.Context, contractAddress, newOwner sdk.AccAddress) error {
+	contractInfo, err := k.GetContractInfo(ctx, contractAddress)
+	if err != nil {
+		return err
+	}
+
+	if contractInfo.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "contract admin was cleared")
+	}
+
+	contractInfo.Owner = newOwner
+	k.SetContractInfo(ctx, contractAddress, contractInfo)
+
+	return nil
+}
+
+// ClearContractAdmin removes the owner of a contract and disables the migration,
+// which makes the contract immutable for good. The caller must be the current owner.
+func (k Keeper) ClearContractAdmin(ctx sdk.Context, contractAddress, caller sdk.AccAddress) error {
+	contractInfo, err := k.GetContractInfo(ctx, contractAddress)
+	if err != nil {
+		return err
+	}
+
+	if contractInfo.Owner.Empty() || !contractInfo.Owner.Equals(caller) {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "no permission")
+	}
+
+	contractInfo.Owner = nil
+	contractInfo.Migratable = false
+	k.SetContractInfo(ctx, contractAddress, contractInfo)
+
+	return nil
+}
+
+// PredictContractAddress returns the address InstantiateContract2 gives to the contract
+// of the code instantiated by the creator with the salt
+func (k Keeper) PredictContractAddress(ctx sdk.Context, codeID uint64, creator sdk.AccAddress, salt []byte) (sdk.AccAddress, error) {
+	if err := types.ValidateSalt(salt); err != nil {
+		return nil, err
+	}
+
+	codeInfo, err := k.GetCodeInfo(ctx, codeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.PredictableContractAddress(creator, codeInfo.CodeHash, salt), nil
+}
+
+// isUnusedAccount returns whether the account has never signed a transaction and is no
+// contract nor module account; the vesting accounts created at the address are unused too
+func (k Keeper) isUnusedAccount(ctx sdk.Context, acc authexported.Account) bool {
+	if _, ok := acc.(supplyexported.ModuleAccountI); ok {
+		return false
+	}
+
+	if acc.GetPubKey() != nil || acc.GetSequence() != 0 {
+		return false
+	}
+
+	return !ctx.KVStore(k.storeKey).Has(types.GetContractInfoKey(acc.GetAddress()))
+}
+
 // generates a contract address from codeID + instanceID
 // and increases last instanceID
 func (k Keeper) generateContractAddress(ctx sdk.Context, codeID uint64, instanceID uint64) sdk.AccAddress {
@@ -326,6 +535,16 @@ func (k Keeper) queryToStore(ctx sdk.Context, contractAddress sdk.AccAddress, ke
 }
 
 func (k Keeper) queryToContract(ctx sdk.Context, contractAddr sdk.AccAddress, queryMsg []byte) ([]byte, error) {
+	// the param read is not charged, so the depth limit does not change the query gas
+	if core.IsFeatureActive(ctx, core.FeatureWasmQueryLimits) {
+		depth := types.GetQueryDepth(ctx) + 1
+		if maxDepth := k.MaxQueryDepth(ctx.WithGasMeter(sdk.NewInfiniteGasMeter())); depth > maxDepth {
+			return nil, sdkerrors.Wrapf(types.ErrQueryDepthExceeded, "max depth %d", maxDepth)
+		}
+
+		ctx = types.WithQueryDepth(ctx, depth)
+	}
+
 	ctx.GasMeter().ConsumeGas(types.InstanceCost, "Loading CosmWasm module: query")
 
 	codeInfo, contractStorePrefix, err := k.getContractDetails(ctx, contractAddr)
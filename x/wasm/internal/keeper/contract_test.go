@@ -8,15 +8,19 @@ import (
 	"testing"
 	"time"
 
+	wasmTypes "github.com/CosmWasm/go-cosmwasm/types"
+
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/auth/vesting"
 	"github.com/terra-project/core/x/wasm/internal/types"
 )
 
@@ -122,11 +126,325 @@ func TestInstantiate(t *testing.T) {
 	require.NoError(t, err)
 
 	// create with no balance is also legal
-	addr, err := keeper.InstantiateContract(ctx, codeID, creator, initMsgBz, nil, true)
+	addr, err := keeper.InstantiateContract(ctx, codeID, creator, initMsgBz, nil, true, "")
 	require.NoError(t, err)
 	require.Equal(t, "cosmos18vd8fpwxzck93qlwghaj6arh4p7c5n89uzcee5", addr.String())
 }
 
+func TestInstantiateWithSalt(t *testing.T) {
+	// Create & set temp as home
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set(flags.FlagHome, tempDir)
+	input := CreateTestInput(t)
+	ctx, accKeeper, keeper := input.Ctx, input.AccKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+
+	initMsgBz, err := json.Marshal(InitMsg{
+		Verifier:    fred,
+		Beneficiary: bob,
+	})
+	require.NoError(t, err)
+
+	salt := []byte("salt")
+	predicted, err := keeper.PredictContractAddress(ctx, codeID, creator, salt)
+	require.NoError(t, err)
+
+	addr, err := keeper.InstantiateContract2(ctx, codeID, creator, initMsgBz, nil, true, "", salt)
+	require.NoError(t, err)
+	require.Equal(t, predicted, addr)
+
+	// the instance counter is not used for the salted instantiation
+	instanceID, err := keeper.GetLastInstanceID(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), instanceID)
+
+	// the same salt can be used only once
+	_, err = keeper.InstantiateContract2(ctx, codeID, creator, initMsgBz, nil, true, "", salt)
+	require.True(t, types.ErrAccountExists.Is(err), err)
+
+	// but the address differs by the creator and the salt
+	otherAddr, err := keeper.InstantiateContract2(ctx, codeID, creator, initMsgBz, nil, true, "", []byte("other salt"))
+	require.NoError(t, err)
+	require.NotEqual(t, addr, otherAddr)
+
+	_, _, other := keyPubAddr()
+	otherPredicted, err := keeper.PredictContractAddress(ctx, codeID, other, salt)
+	require.NoError(t, err)
+	require.NotEqual(t, addr, otherPredicted)
+
+	_, err = keeper.InstantiateContract2(ctx, codeID, creator, initMsgBz, nil, true, "", nil)
+	require.Error(t, err)
+}
+
+func TestInstantiateWithSaltAtFundedAddress(t *testing.T) {
+	// Create & set temp as home
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set(flags.FlagHome, tempDir)
+	input := CreateTestInput(t)
+	ctx, accKeeper, bankKeeper, keeper := input.Ctx, input.AccKeeper, input.BankKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+
+	initMsgBz, err := json.Marshal(InitMsg{
+		Verifier:    fred,
+		Beneficiary: bob,
+	})
+	require.NoError(t, err)
+
+	// anyone can send coins to the predicted address before the instantiation
+	salt := []byte("salt")
+	predicted, err := keeper.PredictContractAddress(ctx, codeID, creator, salt)
+	require.NoError(t, err)
+
+	funds := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1))
+	funder := createFakeFundedAccount(ctx, accKeeper, funds)
+	require.NoError(t, bankKeeper.SendCoins(ctx, funder, predicted, funds))
+
+	addr, err := keeper.InstantiateContract2(ctx, codeID, creator, initMsgBz, deposit, true, "", salt)
+	require.NoError(t, err)
+	require.Equal(t, predicted, addr)
+	require.Equal(t, deposit.Add(funds...), bankKeeper.GetCoins(ctx, addr))
+
+	// the account of the contract is not taken over again
+	otherCreator := createFakeFundedAccount(ctx, accKeeper, deposit)
+	_, err = keeper.InstantiateContract2(ctx, codeID, creator, initMsgBz, nil, true, "", salt)
+	require.True(t, types.ErrAccountExists.Is(err), err)
+
+	// nor an account which has signed a transaction
+	otherPredicted, err := keeper.PredictContractAddress(ctx, codeID, otherCreator, salt)
+	require.NoError(t, err)
+
+	acc := accKeeper.NewAccountWithAddress(ctx, otherPredicted)
+	require.NoError(t, acc.SetSequence(1))
+	accKeeper.SetAccount(ctx, acc)
+
+	_, err = keeper.InstantiateContract2(ctx, codeID, otherCreator, initMsgBz, nil, true, "", salt)
+	require.True(t, types.ErrAccountExists.Is(err), err)
+
+	// a vesting account created at the predicted address is taken over as well
+	vestingCreator := createFakeFundedAccount(ctx, accKeeper, deposit)
+	vestingPredicted, err := keeper.PredictContractAddress(ctx, codeID, vestingCreator, salt)
+	require.NoError(t, err)
+
+	baseAcc := auth.NewBaseAccountWithAddress(vestingPredicted)
+	vestingAcc := vesting.NewLazyGradedVestingAccount(&baseAcc, vesting.VestingSchedules{{
+		Denom:         core.MicroLunaDenom,
+		LazySchedules: vesting.LazySchedules{{StartTime: 1000, EndTime: 2000, Ratio: sdk.OneDec()}},
+	}})
+	accKeeper.SetAccount(ctx, vestingAcc)
+
+	addr, err = keeper.InstantiateContract2(ctx, codeID, vestingCreator, initMsgBz, deposit, true, "", salt)
+	require.NoError(t, err)
+	require.Equal(t, vestingPredicted, addr)
+	require.Equal(t, deposit, bankKeeper.GetCoins(ctx, addr))
+}
+
+func TestDispatchWasmCustomInstantiate(t *testing.T) {
+	// Create & set temp as home
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set(flags.FlagHome, tempDir)
+	input := CreateTestInput(t)
+	accKeeper, keeper := input.AccKeeper, input.WasmKeeper
+
+	// the custom msgs of the wasm route are enabled at the height 10
+	const chainID = "wasm-custom-msgs-test"
+	require.NoError(t, core.DefaultFeatureRegistry.SetActivationHeight(chainID, core.FeatureWasmCustomMsgs, 10))
+	ctx := input.Ctx.WithChainID(chainID).WithBlockHeight(9)
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: creator, Beneficiary: bob})
+	require.NoError(t, err)
+
+	contractAddr, err := keeper.InstantiateContract(ctx, codeID, creator, initMsgBz, nil, true, "")
+	require.NoError(t, err)
+
+	salt := []byte("salt")
+	msgData, err := json.Marshal(types.WasmCustomWasmMsg{
+		Instantiate: &types.WasmCustomInstantiateMsg{
+			CodeID:     codeID,
+			Msg:        initMsgBz,
+			Send:       wasmTypes.Coins{},
+			Migratable: true,
+			Salt:       salt,
+		},
+	})
+	require.NoError(t, err)
+
+	customMsg, err := json.Marshal(types.WasmCustomMsg{Route: types.WasmMsgParserRouteWasm, MsgData: msgData})
+	require.NoError(t, err)
+	msgs := []wasmTypes.CosmosMsg{{Custom: customMsg}}
+
+	predicted, err := keeper.PredictContractAddress(ctx, codeID, contractAddr, salt)
+	require.NoError(t, err)
+
+	// the msg is ignored before the activation
+	require.NoError(t, keeper.dispatchMessages(ctx, contractAddr, msgs))
+	_, err = keeper.GetContractInfo(ctx, predicted)
+	require.Error(t, err)
+
+	ctx = ctx.WithBlockHeight(10)
+	require.NoError(t, keeper.dispatchMessages(ctx, contractAddr, msgs))
+
+	contractInfo, err := keeper.GetContractInfo(ctx, predicted)
+	require.NoError(t, err)
+	require.Equal(t, contractAddr, contractInfo.Owner)
+	require.True(t, contractInfo.Migratable)
+}
+
+func TestStoreCodeWithUploadAccess(t *testing.T) {
+	// Create & set temp as home
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set(flags.FlagHome, tempDir)
+
+	input := CreateTestInput(t)
+	ctx, accKeeper, keeper := input.Ctx, input.AccKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+	_, _, other := keyPubAddr()
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+
+	// nobody can upload
+	params := keeper.GetParams(ctx)
+	params.UploadAccess = types.AllowNobody
+	keeper.SetParams(ctx, params)
+
+	_, err = keeper.StoreCode(ctx, creator, wasmCode)
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err), err)
+
+	// only the creator can upload
+	params.UploadAccess = types.OnlyAddresses(creator)
+	keeper.SetParams(ctx, params)
+
+	_, err = keeper.StoreCode(ctx, other, wasmCode)
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err), err)
+
+	codeID, err := keeper.StoreCodeWithPermission(ctx, creator, wasmCode, types.OnlyAddresses(other))
+	require.NoError(t, err)
+
+	codeInfo, err := keeper.GetCodeInfo(ctx, codeID)
+	require.NoError(t, err)
+	require.Equal(t, types.OnlyAddresses(other), codeInfo.InstantiatePermission)
+
+	// governance ignores the upload access
+	params.UploadAccess = types.AllowNobody
+	keeper.SetParams(ctx, params)
+
+	codeID, err = keeper.StoreCodeByGov(ctx, other, wasmCode, types.AllowEverybody)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), codeID)
+}
+
+func TestInstantiateWithPermission(t *testing.T) {
+	// Create & set temp as home
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set(flags.FlagHome, tempDir)
+	input := CreateTestInput(t)
+	ctx, accKeeper, keeper := input.Ctx, input.AccKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+	other := createFakeFundedAccount(ctx, accKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+
+	initMsgBz, err := json.Marshal(InitMsg{
+		Verifier:    fred,
+		Beneficiary: bob,
+	})
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		permission types.AccessConfig
+		sender     sdk.AccAddress
+		expErr     bool
+	}{
+		"everybody": {
+			permission: types.AllowEverybody,
+			sender:     other,
+		},
+		"nobody": {
+			permission: types.AllowNobody,
+			sender:     creator,
+			expErr:     true,
+		},
+		"only address matches": {
+			permission: types.OnlyAddresses(other),
+			sender:     other,
+		},
+		"only address does not match": {
+			permission: types.OnlyAddresses(other),
+			sender:     creator,
+			expErr:     true,
+		},
+	}
+
+	for msg, spec := range specs {
+		t.Run(msg, func(t *testing.T) {
+			codeID, err := keeper.StoreCodeWithPermission(ctx, creator, wasmCode, spec.permission)
+			require.NoError(t, err)
+
+			_, err = keeper.InstantiateContract(ctx, codeID, spec.sender, initMsgBz, nil, true, "")
+			if spec.expErr {
+				require.True(t, sdkerrors.ErrUnauthorized.Is(err), err)
+
+				// governance ignores the instantiate permission
+				_, err = keeper.InstantiateContractByGov(ctx, codeID, spec.sender, initMsgBz, nil, true, "")
+				require.NoError(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestInstantiateWithNonExistingCodeID(t *testing.T) {
 	// Create & set temp as home
 	tempDir, err := ioutil.TempDir("", "wasmtest")
@@ -144,7 +462,7 @@ func TestInstantiateWithNonExistingCodeID(t *testing.T) {
 	require.NoError(t, err)
 
 	const nonExistingCodeID = 9999
-	_, err = keeper.InstantiateContract(ctx, nonExistingCodeID, creator, initMsgBz, nil, true)
+	_, err = keeper.InstantiateContract(ctx, nonExistingCodeID, creator, initMsgBz, nil, true, "")
 	require.Error(t, err, sdkerrors.Wrapf(types.ErrNotFound, "codeID %d", nonExistingCodeID))
 }
 
@@ -168,7 +486,7 @@ func TestInstantiateWithBigInitMsg(t *testing.T) {
 
 	// test max init msg size
 	initMsgBz := make([]byte, keeper.MaxContractMsgSize(ctx)+1)
-	_, err = keeper.InstantiateContract(ctx, codeID, creator, initMsgBz, deposit, true)
+	_, err = keeper.InstantiateContract(ctx, codeID, creator, initMsgBz, deposit, true, "")
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "init msg size is too huge")
 }
@@ -201,7 +519,7 @@ func TestExecute(t *testing.T) {
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
 
-	addr, err := keeper.InstantiateContract(ctx, codeID, creator, initMsgBz, deposit, true)
+	addr, err := keeper.InstantiateContract(ctx, codeID, creator, initMsgBz, deposit, true, "")
 	require.NoError(t, err)
 	require.Equal(t, "cosmos18vd8fpwxzck93qlwghaj6arh4p7c5n89uzcee5", addr.String())
 
@@ -299,7 +617,7 @@ func TestExecuteWithHugeMsg(t *testing.T) {
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
 
-	addr, err := keeper.InstantiateContract(ctx, codeID, creator, initMsgBz, deposit, true)
+	addr, err := keeper.InstantiateContract(ctx, codeID, creator, initMsgBz, deposit, true, "")
 	require.NoError(t, err)
 	require.Equal(t, "cosmos18vd8fpwxzck93qlwghaj6arh4p7c5n89uzcee5", addr.String())
 
@@ -337,7 +655,7 @@ func TestExecuteWithPanic(t *testing.T) {
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
 
-	addr, err := keeper.InstantiateContract(ctx, contractID, creator, initMsgBz, deposit, true)
+	addr, err := keeper.InstantiateContract(ctx, contractID, creator, initMsgBz, deposit, true, "")
 	require.NoError(t, err)
 
 	// let's make sure we get a reasonable error, no panic/crash
@@ -372,7 +690,7 @@ func TestExecuteWithCpuLoop(t *testing.T) {
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
 
-	addr, err := keeper.InstantiateContract(ctx, contractID, creator, initMsgBz, deposit, true)
+	addr, err := keeper.InstantiateContract(ctx, contractID, creator, initMsgBz, deposit, true, "")
 	require.NoError(t, err)
 
 	// make sure we set a limit before calling
@@ -412,7 +730,7 @@ func TestExecuteWithStorageLoop(t *testing.T) {
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
 
-	addr, err := keeper.InstantiateContract(ctx, contractID, creator, initMsgBz, deposit, true)
+	addr, err := keeper.InstantiateContract(ctx, contractID, creator, initMsgBz, deposit, true, "")
 	require.NoError(t, err)
 
 	// make sure we set a limit before calling
@@ -528,7 +846,7 @@ func TestMigrate(t *testing.T) {
 	for msg, spec := range specs {
 		t.Run(msg, func(t *testing.T) {
 			ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
-			addr, err := keeper.InstantiateContract(ctx, originalContractID, creator, initMsgBz, nil, spec.migratable)
+			addr, err := keeper.InstantiateContract(ctx, originalContractID, creator, initMsgBz, nil, spec.migratable, "")
 			require.NoError(t, err)
 			if spec.overrideContractAddr != nil {
 				addr = spec.overrideContractAddr
@@ -584,7 +902,7 @@ func TestMigrateWithDispatchedMessage(t *testing.T) {
 	require.NoError(t, err)
 
 	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
-	contractAddr, err := keeper.InstantiateContract(ctx, originalContractID, creator, initMsgBz, deposit, true)
+	contractAddr, err := keeper.InstantiateContract(ctx, originalContractID, creator, initMsgBz, deposit, true, "")
 	require.NoError(t, err)
 
 	migMsg := struct {
@@ -638,6 +956,14 @@ func TestMigrateWithDispatchedMessage(t *testing.T) {
 	// and all deposit tokens sent to myPayoutAddr
 	balance := accKeeper.GetAccount(ctx, myPayoutAddr).GetCoins()
 	assert.Equal(t, deposit, balance)
+
+	// history records the init and the migration
+	assert.Equal(t, []types.ContractHistoryEntry{
+		types.NewContractHistoryEntry(types.ContractHistoryOperationInit, originalContractID, ctx.BlockHeight()-1, initMsgBz),
+		types.NewContractHistoryEntry(types.ContractHistoryOperationMigrate, burnerContractID, ctx.BlockHeight(), migMsgBz),
+	}, keeper.GetContractHistory(ctx, contractAddr))
+	assert.Empty(t, keeper.GetContractsByCode(ctx, originalContractID, 1, 10))
+	assert.Equal(t, []sdk.AccAddress{contractAddr}, keeper.GetContractsByCode(ctx, burnerContractID, 1, 10))
 }
 
 func prettyEvents(t *testing.T, events sdk.Events) string {
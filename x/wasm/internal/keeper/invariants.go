@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/wasm/internal/types"
+)
+
+// RegisterInvariants registers all wasm invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "contract-indexes", ContractIndexesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "last-ids", LastIDsInvariant(k))
+}
+
+// ContractIndexesInvariant checks that every contract is indexed by its code and by its
+// owner, and that the indexes hold no other contracts
+func ContractIndexesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var broken int
+
+		store := ctx.KVStore(k.storeKey)
+		var numContracts, numOwnedContracts int
+		k.IterateContractInfo(ctx, func(info types.ContractInfo) bool {
+			numContracts++
+			if !store.Has(types.GetContractByCodeIndexKey(info.CodeID, info.Address)) {
+				msg += fmt.Sprintf("\tcontract %s is not indexed by its code %d\n", info.Address, info.CodeID)
+				broken++
+			}
+
+			if info.Owner.Empty() {
+				return false
+			}
+
+			numOwnedContracts++
+			if !store.Has(types.GetContractByOwnerIndexKey(info.Owner, info.Address)) {
+				msg += fmt.Sprintf("\tcontract %s is not indexed by its owner %s\n", info.Address, info.Owner)
+				broken++
+			}
+
+			return false
+		})
+
+		// as every contract is indexed once, the other entries are stale
+		if n := countKeys(store, types.ContractByCodeIndexKey); n > numContracts {
+			msg += fmt.Sprintf("\tcode index holds %d contracts of %d\n", n, numContracts)
+			broken += n - numContracts
+		}
+
+		if n := countKeys(store, types.ContractByOwnerIndexKey); n > numOwnedContracts {
+			msg += fmt.Sprintf("\towner index holds %d contracts of %d with an owner\n", n, numOwnedContracts)
+			broken += n - numOwnedContracts
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "contract indexes",
+			fmt.Sprintf("found %d contract index entries not matching the contracts\n%s", broken, msg)), broken != 0
+	}
+}
+
+// LastIDsInvariant checks that the codes are numbered from 1 to LastCodeID, and that
+// LastInstanceID counts the contracts instantiated without a salt; as the salted
+// contracts take no instance ID, no contract may hold the next instance address
+func LastIDsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		lastCodeID, err := k.GetLastCodeID(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "last ids", err.Error()), true
+		}
+
+		lastInstanceID, err := k.GetLastInstanceID(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "last ids", err.Error()), true
+		}
+
+		var msg string
+		var broken int
+
+		store := ctx.KVStore(k.storeKey)
+		iter := sdk.KVStorePrefixIterator(store, types.CodeKey)
+		var numCodes uint64
+		for ; iter.Valid(); iter.Next() {
+			numCodes++
+			if codeID := binary.BigEndian.Uint64(iter.Key()[len(types.CodeKey):]); codeID == 0 || codeID > lastCodeID {
+				msg += fmt.Sprintf("\tcode %d is out of the last code ID %d\n", codeID, lastCodeID)
+				broken++
+			}
+		}
+		iter.Close()
+
+		if numCodes != lastCodeID {
+			msg += fmt.Sprintf("\t%d codes are stored for the last code ID %d\n", numCodes, lastCodeID)
+			broken++
+		}
+
+		if numContracts := countKeys(store, types.ContractInfoKey); uint64(numContracts) < lastInstanceID {
+			msg += fmt.Sprintf("\t%d contracts are stored for the last instance ID %d\n", numContracts, lastInstanceID)
+			broken++
+		}
+
+		for codeID := uint64(1); codeID <= lastCodeID; codeID++ {
+			if addr := k.generateContractAddress(ctx, codeID, lastInstanceID+1); store.Has(types.GetContractInfoKey(addr)) {
+				msg += fmt.Sprintf("\tcontract %s of code %d takes the next instance ID %d\n", addr, codeID, lastInstanceID+1)
+				broken++
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "last ids",
+			fmt.Sprintf("found %d stored infos not matching the last IDs\n%s", broken, msg)), broken != 0
+	}
+}
+
+func countKeys(store sdk.KVStore, prefix []byte) (n int) {
+	iter := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		n++
+	}
+
+	return n
+}
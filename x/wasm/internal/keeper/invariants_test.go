@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/terra-project/core/x/wasm/internal/types"
+)
+
+func TestContractIndexesInvariant(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.WasmKeeper
+	invariant := ContractIndexesInvariant(keeper)
+
+	_, _, owner := keyPubAddr()
+	contractAddr := keeper.generateContractAddress(ctx, 1, 1)
+	keeper.SetContractInfo(ctx, contractAddr, types.NewContractInfo(1, contractAddr, owner, []byte("{}"), true, ""))
+
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// a contract missing in the owner index
+	store := ctx.KVStore(keeper.storeKey)
+	store.Delete(types.GetContractByOwnerIndexKey(owner, contractAddr))
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+
+	store.Set(types.GetContractByOwnerIndexKey(owner, contractAddr), []byte{1})
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// a stale entry of the code index
+	store.Set(types.GetContractByCodeIndexKey(2, contractAddr), []byte{1})
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+}
+
+func TestLastIDsInvariant(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.WasmKeeper
+	invariant := LastIDsInvariant(keeper)
+
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	_, _, creator := keyPubAddr()
+	keeper.SetCodeInfo(ctx, 1, types.NewCodeInfo(1, []byte("hash"), creator, types.AllowEverybody))
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+
+	keeper.SetLastCodeID(ctx, 1)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// a contract of which the instance ID is not counted
+	contractAddr := keeper.generateContractAddress(ctx, 1, 1)
+	keeper.SetContractInfo(ctx, contractAddr, types.NewContractInfo(1, contractAddr, creator, []byte("{}"), true, ""))
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+
+	keeper.SetLastInstanceID(ctx, 1)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// a counted instance ID without a contract
+	keeper.SetLastInstanceID(ctx, 2)
+	_, broken = invariant(ctx)
+	require.True(t, broken)
+}
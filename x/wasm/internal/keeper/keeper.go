@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/binary"
 	"fmt"
 	"path/filepath"
+	"strconv"
 
 	wasm "github.com/CosmWasm/go-cosmwasm"
 	"github.com/spf13/viper"
@@ -91,6 +93,26 @@ func (k Keeper) StoreConfig() {
 	config.WriteConfigFile(wasmConfigFilePath, k.wasmConfig)
 }
 
+// isLoggingWhitelisted returns whether the contract logs are kept by this node, which is
+// the case when the code id or any of the addresses is in the logging whitelist
+func (k Keeper) isLoggingWhitelisted(codeID uint64, addrs ...sdk.AccAddress) bool {
+	if k.wasmConfig.LoggingAll() {
+		return true
+	}
+
+	if _, ok := k.loggingWhitelist[strconv.FormatUint(codeID, 10)]; ok {
+		return true
+	}
+
+	for _, addr := range addrs {
+		if _, ok := k.loggingWhitelist[addr.String()]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
 // GetLastCodeID return last code ID
 func (k Keeper) GetLastCodeID(ctx sdk.Context) (uint64, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -157,16 +179,107 @@ func (k Keeper) GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress)
 }
 
 // SetContractInfo stores ContractInfo for the given contractAddress
-func (k Keeper) SetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress, codeInfo types.ContractInfo) {
+// and keeps the code and owner indexes up to date
+func (k Keeper) SetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress, contractInfo types.ContractInfo) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetContractInfoKey(contractAddress)
+
+	// remove stale index entries of the previous contract info
+	if bz := store.Get(key); bz != nil {
+		var prevInfo types.ContractInfo
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(bz, &prevInfo)
+
+		store.Delete(types.GetContractByCodeIndexKey(prevInfo.CodeID, contractAddress))
+		if !prevInfo.Owner.Empty() {
+			store.Delete(types.GetContractByOwnerIndexKey(prevInfo.Owner, contractAddress))
+		}
+	}
+
+	b := k.cdc.MustMarshalBinaryLengthPrefixed(contractInfo)
+	store.Set(key, b)
+
+	store.Set(types.GetContractByCodeIndexKey(contractInfo.CodeID, contractAddress), []byte{1})
+	if !contractInfo.Owner.Empty() {
+		store.Set(types.GetContractByOwnerIndexKey(contractInfo.Owner, contractAddress), []byte{1})
+	}
+}
+
+// GetContractsByCode returns a page of the contract addresses instantiated from the given code
+func (k Keeper) GetContractsByCode(ctx sdk.Context, codeID uint64, page, limit int) []sdk.AccAddress {
+	return k.getIndexedContracts(ctx, types.GetContractByCodeIndexPrefix(codeID), page, limit)
+}
+
+// GetContractsByOwner returns a page of the contract addresses owned by the given address
+func (k Keeper) GetContractsByOwner(ctx sdk.Context, owner sdk.AccAddress, page, limit int) []sdk.AccAddress {
+	return k.getIndexedContracts(ctx, types.GetContractByOwnerIndexPrefix(owner), page, limit)
+}
+
+// getIndexedContracts iterates the index with the given prefix, where the keys
+// are suffixed with the contract address, and returns the requested page
+func (k Keeper) getIndexedContracts(ctx sdk.Context, indexPrefix []byte, page, limit int) []sdk.AccAddress {
+	contracts := []sdk.AccAddress{}
+	if page < 1 || limit < 1 {
+		return contracts
+	}
+
+	start := (page - 1) * limit
+	end := start + limit
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), indexPrefix)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for i := 0; iter.Valid() && i < end; iter.Next() {
+		if i >= start {
+			contracts = append(contracts, sdk.AccAddress(iter.Key()))
+		}
+
+		i++
+	}
+
+	return contracts
+}
+
+// AppendContractHistory appends the entries to the history of the given contract
+func (k Keeper) AppendContractHistory(ctx sdk.Context, contractAddress sdk.AccAddress, entries ...types.ContractHistoryEntry) {
 	store := ctx.KVStore(k.storeKey)
-	b := k.cdc.MustMarshalBinaryLengthPrefixed(codeInfo)
-	store.Set(types.GetContractInfoKey(contractAddress), b)
+
+	// find the next position from the last entry
+	var pos uint64
+	iter := prefix.NewStore(store, types.GetContractHistoryPrefix(contractAddress)).ReverseIterator(nil, nil)
+	if iter.Valid() {
+		pos = binary.BigEndian.Uint64(iter.Key()) + 1
+	}
+	iter.Close()
+
+	for _, entry := range entries {
+		bz := k.cdc.MustMarshalBinaryLengthPrefixed(entry)
+		store.Set(types.GetContractHistoryKey(contractAddress, pos), bz)
+		pos++
+	}
+}
+
+// GetContractHistory returns the history entries of the given contract in insertion order
+func (k Keeper) GetContractHistory(ctx sdk.Context, contractAddress sdk.AccAddress) []types.ContractHistoryEntry {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetContractHistoryPrefix(contractAddress))
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	entries := []types.ContractHistoryEntry{}
+	for ; iter.Valid(); iter.Next() {
+		var entry types.ContractHistoryEntry
+		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &entry)
+		entries = append(entries, entry)
+	}
+
+	return entries
 }
 
 // IterateContractInfo iterates all contract infos
 func (k Keeper) IterateContractInfo(ctx sdk.Context, cb func(types.ContractInfo) bool) {
 	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ContractInfoKey)
 	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
 	for ; iter.Valid(); iter.Next() {
 		var contract types.ContractInfo
 		k.cdc.MustUnmarshalBinaryLengthPrefixed(iter.Value(), &contract)
@@ -184,6 +297,53 @@ func (k Keeper) GetContractStoreIterator(ctx sdk.Context, contractAddress sdk.Ac
 	return prefixStore.Iterator(nil, nil)
 }
 
+// ListContractStore returns up to limit models of a contract store in the ascending key order
+// within [startKey, endKey) whose keys start with keyPrefix, and the key to continue from when
+// more models are left. Empty bounds and prefix are open.
+func (k Keeper) ListContractStore(ctx sdk.Context, contractAddress sdk.AccAddress, keyPrefix, startKey, endKey []byte, limit int) (models []types.Model, nextKey []byte) {
+	start, end := startKey, endKey
+	if len(keyPrefix) != 0 {
+		if bytes.Compare(keyPrefix, start) > 0 {
+			start = keyPrefix
+		}
+
+		if prefixEnd := sdk.PrefixEndBytes(keyPrefix); prefixEnd != nil && (len(end) == 0 || bytes.Compare(prefixEnd, end) < 0) {
+			end = prefixEnd
+		}
+	}
+
+	if len(start) == 0 {
+		start = nil
+	}
+
+	if len(end) == 0 {
+		end = nil
+	}
+
+	models = []types.Model{}
+	if start != nil && end != nil && bytes.Compare(start, end) >= 0 {
+		return models, nil
+	}
+
+	prefixStoreKey := types.GetContractStoreKey(contractAddress)
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), prefixStoreKey)
+	iter := prefixStore.Iterator(start, end)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if len(models) == limit {
+			return models, iter.Key()
+		}
+
+		models = append(models, types.Model{
+			Key:   iter.Key(),
+			Value: iter.Value(),
+		})
+	}
+
+	return models, nil
+}
+
 // SetContractStore records all the Models on the contract store
 func (k Keeper) SetContractStore(ctx sdk.Context, contractAddress sdk.AccAddress, models []types.Model) {
 	prefixStoreKey := types.GetContractStoreKey(contractAddress)
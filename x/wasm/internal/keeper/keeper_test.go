@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 	"github.com/terra-project/core/x/wasm/internal/types"
 )
@@ -20,7 +21,7 @@ func TestCodeInfo(t *testing.T) {
 
 	codeID := uint64(1)
 	creatorAddr := addrFromUint64(codeID)
-	expected := types.NewCodeInfo(codeID, []byte{1, 2, 3}, creatorAddr)
+	expected := types.NewCodeInfo(codeID, []byte{1, 2, 3}, creatorAddr, types.AllowEverybody)
 	keeper.SetCodeInfo(ctx, 1, expected)
 
 	as, err := keeper.GetCodeInfo(ctx, codeID)
@@ -47,7 +48,7 @@ func TestContractInfo(t *testing.T) {
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
 
-	expected := types.NewContractInfo(codeID, contractAddr, creatorAddr, initMsgBz, true)
+	expected := types.NewContractInfo(codeID, contractAddr, creatorAddr, initMsgBz, true, "")
 	keeper.SetContractInfo(ctx, contractAddr, expected)
 
 	as, err := keeper.GetContractInfo(ctx, contractAddr)
@@ -60,6 +61,93 @@ func TestContractInfo(t *testing.T) {
 	})
 }
 
+func TestContractIndexes(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.WasmKeeper
+
+	_, _, alice := keyPubAddr()
+	_, _, bob := keyPubAddr()
+
+	var contracts []sdk.AccAddress
+	for i := uint64(1); i <= 3; i++ {
+		contractAddr := keeper.generateContractAddress(ctx, 1, i)
+		keeper.SetContractInfo(ctx, contractAddr, types.NewContractInfo(1, contractAddr, alice, []byte("{}"), true, ""))
+		contracts = append(contracts, contractAddr)
+	}
+
+	all := keeper.GetContractsByCode(ctx, 1, 1, 10)
+	require.Len(t, all, 3)
+	require.ElementsMatch(t, contracts, all)
+	require.ElementsMatch(t, contracts, keeper.GetContractsByOwner(ctx, alice, 1, 10))
+	require.Empty(t, keeper.GetContractsByOwner(ctx, bob, 1, 10))
+
+	// pagination
+	require.Equal(t, all[:2], keeper.GetContractsByCode(ctx, 1, 1, 2))
+	require.Equal(t, all[2:], keeper.GetContractsByCode(ctx, 1, 2, 2))
+	require.Empty(t, keeper.GetContractsByCode(ctx, 1, 3, 2))
+	require.Empty(t, keeper.GetContractsByCode(ctx, 1, 0, 2))
+
+	// updating the contract info moves the index entries
+	info, err := keeper.GetContractInfo(ctx, all[0])
+	require.NoError(t, err)
+	info.Owner = bob
+	info.CodeID = 2
+	keeper.SetContractInfo(ctx, all[0], info)
+
+	require.Equal(t, all[1:], keeper.GetContractsByCode(ctx, 1, 1, 10))
+	require.Equal(t, all[:1], keeper.GetContractsByCode(ctx, 2, 1, 10))
+	require.Equal(t, all[1:], keeper.GetContractsByOwner(ctx, alice, 1, 10))
+	require.Equal(t, all[:1], keeper.GetContractsByOwner(ctx, bob, 1, 10))
+
+	// cleared owner is not indexed
+	info.Owner = nil
+	keeper.SetContractInfo(ctx, all[0], info)
+	require.Empty(t, keeper.GetContractsByOwner(ctx, bob, 1, 10))
+}
+
+func TestContractHistory(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.WasmKeeper
+
+	contractAddr := keeper.generateContractAddress(ctx, 1, 1)
+	require.Empty(t, keeper.GetContractHistory(ctx, contractAddr))
+
+	entries := []types.ContractHistoryEntry{
+		types.NewContractHistoryEntry(types.ContractHistoryOperationInit, 1, 10, []byte("{}")),
+		types.NewContractHistoryEntry(types.ContractHistoryOperationMigrate, 2, 20, []byte(`{"foo":"bar"}`)),
+	}
+	keeper.AppendContractHistory(ctx, contractAddr, entries[0])
+	keeper.AppendContractHistory(ctx, contractAddr, entries[1])
+
+	entry := types.NewContractHistoryEntry(types.ContractHistoryOperationMigrate, 3, 30, []byte("{}"))
+	keeper.AppendContractHistory(ctx, contractAddr, entry)
+	entries = append(entries, entry)
+
+	require.Equal(t, entries, keeper.GetContractHistory(ctx, contractAddr))
+
+	// other contracts are not affected
+	require.Empty(t, keeper.GetContractHistory(ctx, keeper.generateContractAddress(ctx, 1, 2)))
+}
+
+func TestLoggingWhitelist(t *testing.T) {
+	input := CreateTestInput(t)
+	keeper := input.WasmKeeper
+
+	_, _, alice := keyPubAddr()
+	_, _, bob := keyPubAddr()
+
+	keeper.wasmConfig.ContractLoggingWhitelist = "3, " + alice.String()
+	keeper.loggingWhitelist = keeper.wasmConfig.WhitelistToMap()
+
+	require.True(t, keeper.isLoggingWhitelisted(3, bob))
+	require.True(t, keeper.isLoggingWhitelisted(1, bob, alice))
+	require.False(t, keeper.isLoggingWhitelisted(1, bob))
+
+	keeper.wasmConfig.ContractLoggingWhitelist = "*"
+	keeper.loggingWhitelist = keeper.wasmConfig.WhitelistToMap()
+	require.True(t, keeper.isLoggingWhitelisted(1, bob))
+}
+
 func TestContractStore(t *testing.T) {
 	models := []types.Model{
 		{
@@ -92,3 +180,43 @@ func TestContractStore(t *testing.T) {
 		i++
 	}
 }
+
+func TestListContractStore(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.WasmKeeper
+
+	var models []types.Model
+	for _, key := range []string{"a1", "a2", "a3", "b1", "b2", "c"} {
+		models = append(models, types.Model{Key: []byte(key), Value: []byte(key + key)})
+	}
+
+	_, _, contractAddr := keyPubAddr()
+	keeper.SetContractStore(ctx, contractAddr, models)
+
+	// other contract stores are not listed
+	_, _, otherAddr := keyPubAddr()
+	keeper.SetContractStore(ctx, otherAddr, []types.Model{{Key: []byte("a0"), Value: []byte("x")}})
+
+	for name, tc := range map[string]struct {
+		prefix, start, end []byte
+		limit              int
+		expModels          []types.Model
+		expNextKey         []byte
+	}{
+		"all":               {nil, nil, nil, 10, models, nil},
+		"limited":           {nil, nil, nil, 2, models[:2], []byte("a3")},
+		"exact limit":       {nil, nil, nil, 6, models, nil},
+		"start key":         {nil, []byte("a3"), nil, 10, models[2:], nil},
+		"end key":           {nil, nil, []byte("b1"), 10, models[:3], nil},
+		"prefix":            {[]byte("b"), nil, nil, 10, models[3:5], nil},
+		"prefix with start": {[]byte("a"), []byte("a2"), nil, 1, models[1:2], []byte("a3")},
+		"prefix with end":   {[]byte("a"), nil, []byte("a3"), 10, models[:2], nil},
+		"prefix out of end": {[]byte("c"), nil, []byte("b"), 10, []types.Model{}, nil},
+		"start after end":   {nil, []byte("b"), []byte("a"), 10, []types.Model{}, nil},
+		"no match":          {[]byte("d"), nil, nil, 10, []types.Model{}, nil},
+	} {
+		res, nextKey := keeper.ListContractStore(ctx, contractAddr, tc.prefix, tc.start, tc.end, tc.limit)
+		require.Equal(t, tc.expModels, res, name)
+		require.Equal(t, tc.expNextKey, nextKey, name)
+	}
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/terra-project/core/x/wasm/internal/types"
+)
+
+// Migrator migrates the wasm store in place between consensus versions
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the params added in version 2 to their defaults, sets the instantiate
+// permission of the codes stored before the permissions existed to everybody, builds the
+// code and owner indexes of the contracts instantiated before the indexes existed, and
+// starts the histories of these contracts with their current code
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if !m.keeper.paramSpace.Has(ctx, types.ParamStoreKeyUploadAccess) {
+		m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyUploadAccess, types.DefaultUploadAccess)
+	}
+
+	if !m.keeper.paramSpace.Has(ctx, types.ParamStoreKeyMaxQueryDepth) {
+		m.keeper.paramSpace.Set(ctx, types.ParamStoreKeyMaxQueryDepth, types.DefaultMaxQueryDepth)
+	}
+
+	lastCodeID, err := m.keeper.GetLastCodeID(ctx)
+	if err != nil {
+		return err
+	}
+
+	for codeID := uint64(1); codeID <= lastCodeID; codeID++ {
+		codeInfo, err := m.keeper.GetCodeInfo(ctx, codeID)
+		if err != nil {
+			return err
+		}
+
+		if codeInfo.InstantiatePermission.Permission == types.AccessTypeUnspecified {
+			codeInfo.InstantiatePermission = types.AllowEverybody
+			m.keeper.SetCodeInfo(ctx, codeID, codeInfo)
+		}
+	}
+
+	// the contract infos are collected first, not to write the store while iterating it
+	var contracts []types.ContractInfo
+	m.keeper.IterateContractInfo(ctx, func(contractInfo types.ContractInfo) bool {
+		contracts = append(contracts, contractInfo)
+		return false
+	})
+
+	for _, contractInfo := range contracts {
+		m.keeper.SetContractInfo(ctx, contractInfo.Address, contractInfo)
+
+		if len(m.keeper.GetContractHistory(ctx, contractInfo.Address)) == 0 {
+			m.keeper.AppendContractHistory(ctx, contractInfo.Address, types.NewContractHistoryEntry(
+				types.ContractHistoryOperationGenesis, contractInfo.CodeID, ctx.BlockHeight(), nil))
+		}
+	}
+
+	return nil
+}
@@ -23,6 +23,18 @@ func (k Keeper) MaxContractMsgSize(ctx sdk.Context) (res uint64) {
 	return
 }
 
+// UploadAccess defines who is allowed to upload contract codes
+func (k Keeper) UploadAccess(ctx sdk.Context) (res types.AccessConfig) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyUploadAccess, &res)
+	return
+}
+
+// MaxQueryDepth defines allowed maximum depth of nested contract queries
+func (k Keeper) MaxQueryDepth(ctx sdk.Context) (res uint64) {
+	k.paramSpace.Get(ctx, types.ParamStoreKeyMaxQueryDepth, &res)
+	return
+}
+
 // GetParams returns the total set of oracle parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -29,6 +29,16 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return queryContractStore(ctx, req, keeper)
 		case types.QueryParameters:
 			return queryParameters(ctx, keeper)
+		case types.QueryContractHistory:
+			return queryContractHistory(ctx, req, keeper)
+		case types.QueryContractsByCode:
+			return queryContractsByCode(ctx, req, keeper)
+		case types.QueryContractsByOwner:
+			return queryContractsByOwner(ctx, req, keeper)
+		case types.QueryContractStoreList:
+			return queryContractStoreList(ctx, req, keeper)
+		case types.QueryPredictAddress:
+			return queryPredictAddress(ctx, req, keeper)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unknown %s query endpoint: %s", types.ModuleName, path[0])
 		}
@@ -154,3 +164,124 @@ func queryParameters(ctx sdk.Context, keeper Keeper) ([]byte, error) {
 	}
 	return bz, nil
 }
+
+func queryContractHistory(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryContractAddressParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if _, err := keeper.GetContractInfo(ctx, params.ContractAddress); err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, keeper.GetContractHistory(ctx, params.ContractAddress))
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryContractsByCode(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryContractsByCodeParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	page, limit := normalizePagination(params.Page, params.Limit)
+	contracts := keeper.GetContractsByCode(ctx, params.CodeID, page, limit)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, contracts)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryContractsByOwner(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryContractsByOwnerParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if params.Owner.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty owner")
+	}
+
+	page, limit := normalizePagination(params.Page, params.Limit)
+	contracts := keeper.GetContractsByOwner(ctx, params.Owner, page, limit)
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, contracts)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryContractStoreList(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryContractStoreListParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if _, err := keeper.GetContractInfo(ctx, params.ContractAddress); err != nil {
+		return nil, err
+	}
+
+	limit := params.Limit
+	if limit < 1 {
+		limit = types.DefaultContractStoreListLimit
+	} else if limit > types.MaxContractStoreListLimit {
+		limit = types.MaxContractStoreListLimit
+	}
+
+	models, nextKey := keeper.ListContractStore(ctx, params.ContractAddress, params.Prefix, params.StartKey, params.EndKey, limit)
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, types.ContractStoreListResponse{
+		Models:  models,
+		NextKey: nextKey,
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+func queryPredictAddress(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, error) {
+	var params types.QueryPredictAddressParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if params.Creator.Empty() {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty creator")
+	}
+
+	contractAddress, err := keeper.PredictContractAddress(ctx, params.CodeID, params.Creator, params.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	bz, err := codec.MarshalJSONIndent(keeper.cdc, contractAddress)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+	}
+	return bz, nil
+}
+
+// normalizePagination applies the defaults to the page and limit of the contract list queries
+func normalizePagination(page, limit int) (int, int) {
+	if page < 1 {
+		page = 1
+	}
+
+	if limit < 1 || limit > types.DefaultContractsQueryLimit {
+		limit = types.DefaultContractsQueryLimit
+	}
+
+	return page, limit
+}
@@ -49,7 +49,7 @@ func TestQueryContractState(t *testing.T) {
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
 
-	addr, err := keeper.InstantiateContract(ctx, contractID, creator, initMsgBz, deposit, true)
+	addr, err := keeper.InstantiateContract(ctx, contractID, creator, initMsgBz, deposit, true, "")
 	require.NoError(t, err)
 
 	contractModel := []types.Model{
@@ -91,6 +91,120 @@ func TestQueryContractState(t *testing.T) {
 
 	_, err = querier(ctx, []string{types.QueryContractStore}, abci.RequestQuery{Data: []byte(bz)})
 	require.Error(t, err)
+
+	// list the store with a cursor
+	bz, err = cdc.MarshalJSON(types.NewQueryContractStoreListParams(addr, nil, nil, nil, 1))
+	require.NoError(t, err)
+
+	res, err = querier(ctx, []string{types.QueryContractStoreList}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var listRes types.ContractStoreListResponse
+	require.NoError(t, cdc.UnmarshalJSON(res, &listRes))
+	require.Equal(t, []types.Model{contractModel[1]}, listRes.Models)
+	require.NotEmpty(t, listRes.NextKey)
+
+	bz, err = cdc.MarshalJSON(types.NewQueryContractStoreListParams(addr, nil, listRes.NextKey, nil, 0))
+	require.NoError(t, err)
+
+	res, err = querier(ctx, []string{types.QueryContractStoreList}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	listRes = types.ContractStoreListResponse{}
+	require.NoError(t, cdc.UnmarshalJSON(res, &listRes))
+	require.NotEmpty(t, listRes.Models)
+	require.Empty(t, listRes.NextKey)
+
+	// filter with a prefix
+	bz, err = cdc.MarshalJSON(types.NewQueryContractStoreListParams(addr, []byte("fo"), nil, nil, 0))
+	require.NoError(t, err)
+
+	res, err = querier(ctx, []string{types.QueryContractStoreList}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	listRes = types.ContractStoreListResponse{}
+	require.NoError(t, cdc.UnmarshalJSON(res, &listRes))
+	require.Equal(t, []types.Model{contractModel[0]}, listRes.Models)
+	require.Empty(t, listRes.NextKey)
+
+	// unknown contract
+	bz, err = cdc.MarshalJSON(types.NewQueryContractStoreListParams(bob, nil, nil, nil, 0))
+	require.NoError(t, err)
+
+	_, err = querier(ctx, []string{types.QueryContractStoreList}, abci.RequestQuery{Data: bz})
+	require.Error(t, err)
+}
+
+func TestQueryContractListing(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set(flags.FlagHome, tempDir)
+
+	cdc := codec.New()
+	input := CreateTestInput(t)
+	ctx, accKeeper, keeper := input.Ctx, input.AccKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin("denom", 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	initMsgBz, err := json.Marshal(InitMsg{Verifier: creator, Beneficiary: bob})
+	require.NoError(t, err)
+
+	addr, err := keeper.InstantiateContract(ctx, codeID, creator, initMsgBz, nil, true, "my contract")
+	require.NoError(t, err)
+
+	contractInfo, err := keeper.GetContractInfo(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, "my contract", contractInfo.Label)
+
+	querier := NewQuerier(keeper)
+
+	// query history
+	bz, err := cdc.MarshalJSON(types.NewQueryContractAddressParams(addr))
+	require.NoError(t, err)
+
+	res, err := querier(ctx, []string{types.QueryContractHistory}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var history []types.ContractHistoryEntry
+	require.NoError(t, cdc.UnmarshalJSON(res, &history))
+	require.Equal(t, []types.ContractHistoryEntry{
+		types.NewContractHistoryEntry(types.ContractHistoryOperationInit, codeID, ctx.BlockHeight(), initMsgBz),
+	}, history)
+
+	// query contracts by code
+	bz, err = cdc.MarshalJSON(types.NewQueryContractsByCodeParams(codeID, 0, 0))
+	require.NoError(t, err)
+
+	res, err = querier(ctx, []string{types.QueryContractsByCode}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var contracts []sdk.AccAddress
+	require.NoError(t, cdc.UnmarshalJSON(res, &contracts))
+	require.Equal(t, []sdk.AccAddress{addr}, contracts)
+
+	// query contracts by owner
+	bz, err = cdc.MarshalJSON(types.NewQueryContractsByOwnerParams(creator, 1, 10))
+	require.NoError(t, err)
+
+	res, err = querier(ctx, []string{types.QueryContractsByOwner}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	contracts = nil
+	require.NoError(t, cdc.UnmarshalJSON(res, &contracts))
+	require.Equal(t, []sdk.AccAddress{addr}, contracts)
+
+	// invalid label is rejected
+	_, err = keeper.InstantiateContract(ctx, codeID, creator, initMsgBz, nil, true, " spaced ")
+	require.Error(t, err)
 }
 
 func TestQueryParams(t *testing.T) {
@@ -106,3 +220,40 @@ func TestQueryParams(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, input.WasmKeeper.GetParams(input.Ctx), params)
 }
+
+func TestQueryPredictAddress(t *testing.T) {
+	input := CreateTestInput(t)
+	ctx, keeper := input.Ctx, input.WasmKeeper
+
+	_, _, creator := keyPubAddr()
+	wasmCode, err := ioutil.ReadFile("./testdata/contract.wasm")
+	require.NoError(t, err)
+	codeID, err := keeper.StoreCode(ctx, creator, wasmCode)
+	require.NoError(t, err)
+
+	querier := NewQuerier(keeper)
+	bz, err := types.ModuleCdc.MarshalJSON(types.NewQueryPredictAddressParams(creator, codeID, []byte("salt")))
+	require.NoError(t, err)
+
+	res, err := querier(ctx, []string{types.QueryPredictAddress}, abci.RequestQuery{Data: bz})
+	require.NoError(t, err)
+
+	var addr sdk.AccAddress
+	require.NoError(t, input.Cdc.UnmarshalJSON(res, &addr))
+
+	expected, err := keeper.PredictContractAddress(ctx, codeID, creator, []byte("salt"))
+	require.NoError(t, err)
+	require.Equal(t, expected, addr)
+
+	// unknown code
+	bz, err = types.ModuleCdc.MarshalJSON(types.NewQueryPredictAddressParams(creator, codeID+1, []byte("salt")))
+	require.NoError(t, err)
+	_, err = querier(ctx, []string{types.QueryPredictAddress}, abci.RequestQuery{Data: bz})
+	require.Error(t, err)
+
+	// empty salt
+	bz, err = types.ModuleCdc.MarshalJSON(types.NewQueryPredictAddressParams(creator, codeID, nil))
+	require.NoError(t, err)
+	_, err = querier(ctx, []string{types.QueryPredictAddress}, abci.RequestQuery{Data: bz})
+	require.Error(t, err)
+}
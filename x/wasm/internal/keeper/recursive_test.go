@@ -17,6 +17,7 @@ import (
 	sdkerror "github.com/cosmos/cosmos-sdk/types/errors"
 	abci "github.com/tendermint/tendermint/abci/types"
 
+	core "github.com/terra-project/core/types"
 	"github.com/terra-project/core/x/wasm/internal/types"
 )
 
@@ -96,14 +97,14 @@ func initRecurseContract(t *testing.T) (contract sdk.AccAddress, creator sdk.Acc
 	}
 	initMsgBz, err := json.Marshal(initMsg)
 	require.NoError(t, err)
-	contractAddr, err := keeper.InstantiateContract(ctx, codeID, creator, initMsgBz, deposit, false)
+	contractAddr, err := keeper.InstantiateContract(ctx, codeID, creator, initMsgBz, deposit, false, "")
 	require.NoError(t, err)
 
 	return contractAddr, creator, ctx, keeper, cleanup
 }
 
 func TestGasCostOnQuery(t *testing.T) {
-	GasNoWork := types.InstanceCost + 2_693 /* Contract Loading Cost */ + 1_432 /* No Op Cost*/
+	GasNoWork := types.InstanceCost + 2_732 /* Contract Loading Cost */ + 1_432 /* No Op Cost*/
 	// Note: about 100 SDK gas (10k wasmer gas) for each round of sha256
 	GasWork50 := GasNoWork + 5_708 // this is a little shy of 50k gas - to keep an eye on the limit
 
@@ -191,7 +192,7 @@ func TestGasCostOnQuery(t *testing.T) {
 }
 
 func TestGasOnExternalQuery(t *testing.T) {
-	GasNoWork := types.InstanceCost + 2_693 /* Contract Loading Cost */ + 1_432 /* No Op Cost*/
+	GasNoWork := types.InstanceCost + 2_732 /* Contract Loading Cost */ + 1_432 /* No Op Cost*/
 	// Note: about 100 SDK gas (10k wasmer gas) for each round of sha256
 	GasWork50 := GasNoWork + 5_708 // this is a little shy of 50k gas - to keep an eye on the limit
 
@@ -272,7 +273,7 @@ func TestLimitRecursiveQueryGas(t *testing.T) {
 	// This attack would allow us to use far more than the provided gas before
 	// eventually hitting an OutOfGas panic.
 
-	GasNoWork := types.InstanceCost + 2_693 /* Contract Loading Cost */ + 1_432 /* No Op Cost*/
+	GasNoWork := types.InstanceCost + 2_732 /* Contract Loading Cost */ + 1_432 /* No Op Cost*/
 	// Note: about 100 SDK gas (10k wasmer gas) for each round of sha256
 
 	GasWork2k := GasNoWork + 230_623
@@ -356,3 +357,133 @@ func TestLimitRecursiveQueryGas(t *testing.T) {
 		})
 	}
 }
+
+func TestLimitQueryDepth(t *testing.T) {
+	contractAddr, _, ctx, keeper, cleanup := initRecurseContract(t)
+	defer cleanup()
+
+	params := keeper.GetParams(ctx)
+	params.MaxQueryDepth = 3
+	keeper.SetParams(ctx, params)
+
+	cases := map[string]struct {
+		depth     uint32
+		expectErr bool
+	}{
+		"within the max depth": {
+			depth: 2,
+		},
+		"exceeding the max depth": {
+			depth:     3,
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			ctx = ctx.WithGasMeter(sdk.NewGasMeter(4_000_000))
+
+			msg := buildQuery(t, Recurse{Depth: tc.depth, Contract: contractAddr})
+			_, err := keeper.queryToContract(ctx, contractAddr, msg)
+			if tc.expectErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), types.ErrQueryDepthExceeded.Error())
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSubQueryGasCap(t *testing.T) {
+	contractAddr, _, ctx, keeper, cleanup := initRecurseContract(t)
+	defer cleanup()
+
+	// the counting querier of the recurse setup does not take custom queries
+	querier := types.NewModuleQuerier()
+	querier.Queriers[types.WasmQueryRouteWasm] = NewWasmQuerier(keeper)
+
+	msg := buildQuery(t, Recurse{Work: 50, Contract: contractAddr})
+	smartQuery, err := json.Marshal(wasmTypes.WasmQuery{
+		Smart: &wasmTypes.SmartQuery{ContractAddr: contractAddr.String(), Msg: msg},
+	})
+	require.NoError(t, err)
+
+	customQuery := func(gasLimit uint64) wasmTypes.QueryRequest {
+		bz, err := json.Marshal(types.WasmCustomQuery{
+			Route:     types.WasmQueryRouteWasm,
+			QueryData: smartQuery,
+			GasLimit:  gasLimit,
+		})
+		require.NoError(t, err)
+		return wasmTypes.QueryRequest{Custom: bz}
+	}
+
+	// enough gas under the cap
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(4_000_000))
+	_, err = querier.WithCtx(ctx).Query(customQuery(1_000_000), 4_000_000*types.GasMultiplier)
+	require.NoError(t, err)
+
+	// running out of the capped gas returns an error and charges exactly the cap
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(4_000_000))
+	_, err = querier.WithCtx(ctx).Query(customQuery(10_000), 4_000_000*types.GasMultiplier)
+	require.Error(t, err)
+	require.True(t, types.ErrQueryGasCapExceeded.Is(err))
+	require.Equal(t, uint64(10_000), ctx.GasMeter().GasConsumed())
+
+	// running out of the gas of the caller still panics
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(20_000))
+	require.Panics(t, func() {
+		_, _ = querier.WithCtx(ctx).Query(customQuery(1_000_000), 20_000*types.GasMultiplier)
+	})
+}
+
+func TestQueryLimitsFeature(t *testing.T) {
+	contractAddr, _, ctx, keeper, cleanup := initRecurseContract(t)
+	defer cleanup()
+
+	// the query limits are enabled at the height 10
+	const chainID = "wasm-query-limits-test"
+	require.NoError(t, core.DefaultFeatureRegistry.SetActivationHeight(chainID, core.FeatureWasmQueryLimits, 10))
+	ctx = ctx.WithChainID(chainID).WithBlockHeight(9)
+
+	params := keeper.GetParams(ctx)
+	params.MaxQueryDepth = 3
+	keeper.SetParams(ctx, params)
+
+	querier := types.NewModuleQuerier()
+	querier.Queriers[types.WasmQueryRouteWasm] = NewWasmQuerier(keeper)
+
+	smartQuery, err := json.Marshal(wasmTypes.WasmQuery{
+		Smart: &wasmTypes.SmartQuery{ContractAddr: contractAddr.String(), Msg: buildQuery(t, Recurse{Work: 50, Contract: contractAddr})},
+	})
+	require.NoError(t, err)
+	customQuery, err := json.Marshal(types.WasmCustomQuery{
+		Route:     types.WasmQueryRouteWasm,
+		QueryData: smartQuery,
+		GasLimit:  10_000,
+	})
+	require.NoError(t, err)
+
+	deepQuery := buildQuery(t, Recurse{Depth: 3, Contract: contractAddr})
+
+	// the custom wasm queries return nothing and the depth is not limited before the activation
+	ctx = ctx.WithGasMeter(sdk.NewGasMeter(4_000_000))
+	res, err := querier.WithCtx(ctx).Query(wasmTypes.QueryRequest{Custom: customQuery}, 4_000_000*types.GasMultiplier)
+	require.NoError(t, err)
+	require.Nil(t, res)
+	require.Equal(t, uint64(0), ctx.GasMeter().GasConsumed())
+
+	_, err = keeper.queryToContract(ctx.WithGasMeter(sdk.NewGasMeter(4_000_000)), contractAddr, deepQuery)
+	require.NoError(t, err)
+
+	// and are enabled after it
+	ctx = ctx.WithBlockHeight(10).WithGasMeter(sdk.NewGasMeter(4_000_000))
+	_, err = querier.WithCtx(ctx).Query(wasmTypes.QueryRequest{Custom: customQuery}, 4_000_000*types.GasMultiplier)
+	require.True(t, types.ErrQueryGasCapExceeded.Is(err))
+
+	_, err = keeper.queryToContract(ctx.WithGasMeter(sdk.NewGasMeter(4_000_000)), contractAddr, deepQuery)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), types.ErrQueryDepthExceeded.Error())
+}
@@ -125,7 +125,7 @@ func TestInitializeStaking(t *testing.T) {
 	initBz, err := json.Marshal(&initMsg)
 	require.NoError(t, err)
 
-	stakingAddr, err := keeper.InstantiateContract(ctx, stakingID, creatorAddr, initBz, nil, true)
+	stakingAddr, err := keeper.InstantiateContract(ctx, stakingID, creatorAddr, initBz, nil, true, "")
 	require.NoError(t, err)
 	require.NotEmpty(t, stakingAddr)
 
@@ -145,7 +145,7 @@ func TestInitializeStaking(t *testing.T) {
 	badBz, err := json.Marshal(&badInitMsg)
 	require.NoError(t, err)
 
-	_, err = keeper.InstantiateContract(ctx, stakingID, creatorAddr, badBz, nil, true)
+	_, err = keeper.InstantiateContract(ctx, stakingID, creatorAddr, badBz, nil, true, "")
 	require.Error(t, err)
 
 	// no changes to bonding shares
@@ -193,7 +193,7 @@ func initializeStaking(t *testing.T, input TestInput) InitInfo {
 	initBz, err := json.Marshal(&initMsg)
 	require.NoError(t, err)
 
-	stakingAddr, err := keeper.InstantiateContract(ctx, stakingID, creatorAddr, initBz, nil, true)
+	stakingAddr, err := keeper.InstantiateContract(ctx, stakingID, creatorAddr, initBz, nil, true, "")
 	require.NoError(t, err)
 	require.NotEmpty(t, stakingAddr)
 
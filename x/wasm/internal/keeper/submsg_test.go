@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
+
+	wasmTypes "github.com/CosmWasm/go-cosmwasm/types"
+
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/wasm/internal/types"
+)
+
+func toSubMsg(t *testing.T, id uint64, msg wasmTypes.CosmosMsg, replyOn types.ReplyOn) wasmTypes.CosmosMsg {
+	subMsgBz, err := json.Marshal(types.SubMsg{ID: id, Msg: msg, ReplyOn: replyOn})
+	require.NoError(t, err)
+
+	customMsg, err := json.Marshal(types.WasmCustomMsg{
+		Route:   types.WasmCustomMsgRouteSubMsg,
+		MsgData: subMsgBz,
+	})
+	require.NoError(t, err)
+
+	return wasmTypes.CosmosMsg{Custom: customMsg}
+}
+
+func bankSendMsg(from, to sdk.AccAddress, amount string) wasmTypes.CosmosMsg {
+	return wasmTypes.CosmosMsg{
+		Bank: &wasmTypes.BankMsg{
+			Send: &wasmTypes.SendMsg{
+				FromAddress: from.String(),
+				ToAddress:   to.String(),
+				Amount: []wasmTypes.Coin{{
+					Denom:  core.MicroLunaDenom,
+					Amount: amount,
+				}},
+			},
+		},
+	}
+}
+
+func TestSubMsgReply(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "wasmtest")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	viper.Set(flags.FlagHome, tempDir)
+
+	input := CreateTestInput(t)
+	ctx, accKeeper, keeper := input.Ctx, input.AccKeeper, input.WasmKeeper
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	creator := createFakeFundedAccount(ctx, accKeeper, deposit)
+	_, _, fred := keyPubAddr()
+
+	maskCode, err := ioutil.ReadFile("./testdata/mask.wasm")
+	require.NoError(t, err)
+	codeID, err := keeper.StoreCode(ctx, creator, maskCode)
+	require.NoError(t, err)
+
+	contractStart := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 40000))
+	contractAddr, err := keeper.InstantiateContract(ctx, codeID, creator, []byte("{}"), contractStart, true, "")
+	require.NoError(t, err)
+
+	// the mask contract cannot reflect submessages as its custom msg type is fixed,
+	// so dispatch the msgs as if they were returned by the contract
+	reflect := func(ctx sdk.Context, msgs ...wasmTypes.CosmosMsg) error {
+		return keeper.dispatchMessages(ctx, contractAddr, msgs)
+	}
+
+	// successful submessage without reply is committed along with the plain msg
+	cacheCtx, _ := ctx.CacheContext()
+	err = reflect(cacheCtx,
+		bankSendMsg(contractAddr, fred, "1000"),
+		toSubMsg(t, 1, bankSendMsg(contractAddr, fred, "2000"), types.ReplyError),
+	)
+	require.NoError(t, err)
+	checkAccount(t, cacheCtx, accKeeper, fred, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 3000)))
+	for _, event := range cacheCtx.EventManager().Events() {
+		require.NotEqual(t, types.EventTypeReply, event.Type)
+	}
+
+	// failed submessage without reply aborts the execution
+	cacheCtx, _ = ctx.CacheContext()
+	err = reflect(cacheCtx, toSubMsg(t, 2, bankSendMsg(contractAddr, fred, "50000"), types.ReplySuccess))
+	require.Error(t, err)
+	require.NotContains(t, err.Error(), "reply to sub msg")
+
+	// failed submessage is replied to the contract; the mask contract
+	// does not implement reply, so the reply itself fails
+	gasBefore := ctx.GasMeter().GasConsumed()
+	cacheCtx, _ = ctx.CacheContext()
+	err = reflect(cacheCtx, toSubMsg(t, 3, bankSendMsg(contractAddr, fred, "50000"), types.ReplyError))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "reply to sub msg 3")
+	require.True(t, ctx.GasMeter().GasConsumed() > gasBefore+types.InstanceCost)
+
+	// successful submessage is replied to the contract with always policy
+	cacheCtx, _ = ctx.CacheContext()
+	err = reflect(cacheCtx, toSubMsg(t, 4, bankSendMsg(contractAddr, fred, "1000"), types.ReplyAlways))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "reply to sub msg 4")
+
+	// invalid reply policy
+	cacheCtx, _ = ctx.CacheContext()
+	err = reflect(cacheCtx, toSubMsg(t, 5, bankSendMsg(contractAddr, fred, "1000"), "never"))
+	require.Error(t, err)
+	require.True(t, types.ErrInvalidMsg.Is(err))
+}
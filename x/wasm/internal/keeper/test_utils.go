@@ -26,6 +26,7 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/auth/vesting"
 	bankwasm "github.com/terra-project/core/x/bank/wasm"
 	"github.com/terra-project/core/x/market"
 	marketwasm "github.com/terra-project/core/x/market/wasm"
@@ -51,6 +52,7 @@ func makeTestCodec() *codec.Codec {
 	distr.RegisterCodec(cdc)
 	oracle.RegisterCodec(cdc)
 	market.RegisterCodec(cdc)
+	vesting.RegisterCodec(cdc)
 
 	return cdc
 }
@@ -168,7 +170,7 @@ func CreateTestInput(t *testing.T) TestInput {
 		cdc,
 		keyTreasury, paramsKeeper.Subspace(treasury.DefaultParamspace),
 		supplyKeeper, marketKeeper, stakingKeeper, distrKeeper,
-		oracle.ModuleName, distr.ModuleName,
+		oracleKeeper, oracle.ModuleName, distr.ModuleName,
 	)
 
 	treasuryKeeper.SetParams(ctx, treasury.DefaultParams())
@@ -296,6 +298,9 @@ func TestHandler(k Keeper) sdk.Handler {
 		case *types.MsgInstantiateContract:
 			return handleInstantiate(ctx, k, *msg)
 
+		case types.MsgInstantiateContract2:
+			return handleInstantiate2(ctx, k, msg)
+
 		case types.MsgExecuteContract:
 			return handleExecute(ctx, k, msg)
 		case *types.MsgExecuteContract:
@@ -309,7 +314,19 @@ func TestHandler(k Keeper) sdk.Handler {
 }
 
 func handleInstantiate(ctx sdk.Context, k Keeper, msg types.MsgInstantiateContract) (*sdk.Result, error) {
-	contractAddr, err := k.InstantiateContract(ctx, msg.CodeID, msg.Owner, msg.InitMsg, msg.InitCoins, msg.Migratable)
+	contractAddr, err := k.InstantiateContract(ctx, msg.CodeID, msg.Owner, msg.InitMsg, msg.InitCoins, msg.Migratable, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{
+		Data:   contractAddr,
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleInstantiate2(ctx sdk.Context, k Keeper, msg types.MsgInstantiateContract2) (*sdk.Result, error) {
+	contractAddr, err := k.InstantiateContract2(ctx, msg.CodeID, msg.Owner, msg.InitMsg, msg.InitCoins, msg.Migratable, msg.Label, msg.Salt)
 	if err != nil {
 		return nil, err
 	}
@@ -8,6 +8,7 @@ import (
 
 	wasmTypes "github.com/CosmWasm/go-cosmwasm/types"
 
+	core "github.com/terra-project/core/types"
 	"github.com/terra-project/core/x/wasm/internal/types"
 )
 
@@ -65,7 +66,30 @@ func (WasmMsgParser) Parse(contractAddr sdk.AccAddress, wasmMsg wasmTypes.Cosmos
 
 // ParseCustom implements custom parser
 func (parser WasmMsgParser) ParseCustom(contractAddr sdk.AccAddress, data json.RawMessage) ([]sdk.Msg, error) {
-	return nil, nil
+	var msg types.WasmCustomWasmMsg
+	if err := json.Unmarshal(data, &msg); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	if msg.Instantiate != nil {
+		coins, err := types.ParseToCoins(msg.Instantiate.Send)
+		if err != nil {
+			return nil, err
+		}
+
+		sdkMsg := types.MsgInstantiateContract2{
+			Owner:      contractAddr,
+			CodeID:     msg.Instantiate.CodeID,
+			InitMsg:    msg.Instantiate.Msg,
+			InitCoins:  coins,
+			Migratable: msg.Instantiate.Migratable,
+			Label:      msg.Instantiate.Label,
+			Salt:       msg.Instantiate.Salt,
+		}
+		return []sdk.Msg{sdkMsg}, nil
+	}
+
+	return nil, sdkerrors.Wrap(types.ErrInvalidMsg, "Unknown variant of Wasm custom msg")
 }
 
 // WasmQuerier - wasm query interface for wasm contract
@@ -101,7 +125,18 @@ func (querier WasmQuerier) Query(ctx sdk.Context, request wasmTypes.QueryRequest
 	return nil, wasmTypes.UnsupportedRequest{Kind: "unknown WasmQuery variant"}
 }
 
-// QueryCustom implements custom query interface
-func (WasmQuerier) QueryCustom(ctx sdk.Context, data json.RawMessage) ([]byte, error) {
-	return nil, nil
+// QueryCustom implements custom query interface; it takes the wasm queries,
+// so a contract can cap the gas of a contract query with WasmCustomQuery.GasLimit.
+// The custom wasm queries return nothing until the wasm-query-limits feature is active
+func (querier WasmQuerier) QueryCustom(ctx sdk.Context, data json.RawMessage) ([]byte, error) {
+	if !core.IsFeatureActive(ctx, core.FeatureWasmQueryLimits) {
+		return nil, nil
+	}
+
+	var query wasmTypes.WasmQuery
+	if err := json.Unmarshal(data, &query); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	return querier.Query(ctx, wasmTypes.QueryRequest{Wasm: &query})
 }
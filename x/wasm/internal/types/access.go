@@ -0,0 +1,112 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// AccessType defines who is allowed to perform a permissioned wasm action
+type AccessType string
+
+// Access types
+const (
+	// AccessTypeUnspecified is only found on codes stored before instantiate
+	// permissions existed and is treated the same as AccessTypeEverybody
+	AccessTypeUnspecified   AccessType = ""
+	AccessTypeNobody        AccessType = "nobody"
+	AccessTypeOnlyAddresses AccessType = "only_addresses"
+	AccessTypeEverybody     AccessType = "everybody"
+)
+
+// AccessTypeFromString parses an AccessType from its string representation
+func AccessTypeFromString(str string) (AccessType, error) {
+	switch t := AccessType(strings.ToLower(str)); t {
+	case AccessTypeNobody, AccessTypeOnlyAddresses, AccessTypeEverybody:
+		return t, nil
+	}
+
+	return AccessTypeUnspecified, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid access type: %s", str)
+}
+
+// AccessConfig defines an access type with the addresses it applies to
+type AccessConfig struct {
+	Permission AccessType       `json:"permission" yaml:"permission"`
+	Addresses  []sdk.AccAddress `json:"addresses,omitempty" yaml:"addresses,omitempty"`
+}
+
+// Pre-defined access configs
+var (
+	AllowEverybody = AccessConfig{Permission: AccessTypeEverybody}
+	AllowNobody    = AccessConfig{Permission: AccessTypeNobody}
+)
+
+// NewAccessConfig creates a new AccessConfig instance
+func NewAccessConfig(permission AccessType, addresses ...sdk.AccAddress) AccessConfig {
+	return AccessConfig{
+		Permission: permission,
+		Addresses:  addresses,
+	}
+}
+
+// OnlyAddresses returns an AccessConfig allowing only the given addresses
+func OnlyAddresses(addresses ...sdk.AccAddress) AccessConfig {
+	return NewAccessConfig(AccessTypeOnlyAddresses, addresses...)
+}
+
+// Allowed returns true when the given address passes the access config
+func (ac AccessConfig) Allowed(addr sdk.AccAddress) bool {
+	switch ac.Permission {
+	case AccessTypeUnspecified, AccessTypeEverybody:
+		return true
+	case AccessTypeOnlyAddresses:
+		for _, allowed := range ac.Addresses {
+			if allowed.Equals(addr) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// ValidateBasic performs stateless validation of the access config
+func (ac AccessConfig) ValidateBasic() error {
+	switch ac.Permission {
+	case AccessTypeNobody, AccessTypeEverybody:
+		if len(ac.Addresses) != 0 {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "addresses must be empty for %s access", ac.Permission)
+		}
+	case AccessTypeOnlyAddresses:
+		if len(ac.Addresses) == 0 {
+			return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "addresses must not be empty for only_addresses access")
+		}
+
+		seen := make(map[string]bool, len(ac.Addresses))
+		for _, addr := range ac.Addresses {
+			if addr.Empty() {
+				return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty address")
+			}
+
+			if seen[addr.String()] {
+				return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "duplicate address: %s", addr)
+			}
+
+			seen[addr.String()] = true
+		}
+	default:
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid access type: %s", ac.Permission)
+	}
+
+	return nil
+}
+
+// String implements fmt.Stringer interface
+func (ac AccessConfig) String() string {
+	return fmt.Sprintf(`AccessConfig
+	Permission: %s,
+	Addresses:  %v`,
+		ac.Permission, ac.Addresses)
+}
@@ -0,0 +1,79 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mock"
+)
+
+func TestAccessConfigAllowed(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(2, sdk.Coins{})
+
+	require.True(t, AccessConfig{}.Allowed(addrs[0]))
+	require.True(t, AllowEverybody.Allowed(addrs[0]))
+	require.False(t, AllowNobody.Allowed(addrs[0]))
+	require.True(t, OnlyAddresses(addrs[0]).Allowed(addrs[0]))
+	require.False(t, OnlyAddresses(addrs[0]).Allowed(addrs[1]))
+}
+
+func TestAccessConfigValidateBasic(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(2, sdk.Coins{})
+
+	tests := []struct {
+		config     AccessConfig
+		expectPass bool
+	}{
+		{AccessConfig{}, false},
+		{NewAccessConfig("somebody"), false},
+		{NewAccessConfig(AccessTypeEverybody, addrs[0]), false},
+		{NewAccessConfig(AccessTypeNobody, addrs[0]), false},
+		{OnlyAddresses(), false},
+		{OnlyAddresses(addrs[0], addrs[0]), false},
+		{OnlyAddresses(sdk.AccAddress{}), false},
+		{AllowEverybody, true},
+		{AllowNobody, true},
+		{OnlyAddresses(addrs[0], addrs[1]), true},
+	}
+
+	for i, tc := range tests {
+		if tc.expectPass {
+			require.NoError(t, tc.config.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, tc.config.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestAccessTypeFromString(t *testing.T) {
+	accessType, err := AccessTypeFromString("Only_Addresses")
+	require.NoError(t, err)
+	require.Equal(t, AccessTypeOnlyAddresses, accessType)
+
+	_, err = AccessTypeFromString("")
+	require.Error(t, err)
+
+	_, err = AccessTypeFromString("somebody")
+	require.Error(t, err)
+}
+
+func TestCodeInfoLegacyPermission(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+
+	// code info stored before instantiate permissions existed
+	type legacyCodeInfo struct {
+		CodeID   uint64
+		CodeHash []byte
+		Creator  sdk.AccAddress
+	}
+
+	cdc := ModuleCdc
+	bz := cdc.MustMarshalBinaryLengthPrefixed(legacyCodeInfo{1, []byte{1, 2, 3}, addrs[0]})
+
+	var codeInfo CodeInfo
+	require.NoError(t, cdc.UnmarshalBinaryLengthPrefixed(bz, &codeInfo))
+	require.Equal(t, AccessTypeUnspecified, codeInfo.InstantiatePermission.Permission)
+	require.True(t, codeInfo.InstantiatePermission.Allowed(addrs[0]))
+}
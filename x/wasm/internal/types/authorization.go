@@ -0,0 +1,87 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	msgauthexported "github.com/terra-project/core/x/msgauth/exported"
+)
+
+var _ msgauthexported.Authorization = ExecuteContractAuthorization{}
+
+// ContractAuthorization specifies the coins and the number of calls
+// allowed for a single contract
+type ContractAuthorization struct {
+	Contract sdk.AccAddress `json:"contract" yaml:"contract"`
+
+	// SpendLimit specifies the maximum amount of coins that can be sent to the contract
+	// and will be updated as coins are sent. If it is empty, no coins can be sent.
+	SpendLimit sdk.Coins `json:"spend_limit" yaml:"spend_limit"`
+
+	// CallsLimit specifies the remaining number of executions. If it is zero,
+	// there is no limit on the number of executions.
+	CallsLimit uint64 `json:"calls_limit" yaml:"calls_limit"`
+}
+
+// NewContractAuthorization returns new ContractAuthorization instance
+func NewContractAuthorization(contract sdk.AccAddress, spendLimit sdk.Coins, callsLimit uint64) ContractAuthorization {
+	return ContractAuthorization{
+		Contract:   contract,
+		SpendLimit: spendLimit,
+		CallsLimit: callsLimit,
+	}
+}
+
+// ExecuteContractAuthorization grants the permission to execute
+// the allowed contracts on behalf of the granter
+type ExecuteContractAuthorization struct {
+	Contracts []ContractAuthorization `json:"contracts" yaml:"contracts"`
+}
+
+// NewExecuteContractAuthorization returns new ExecuteContractAuthorization instance
+func NewExecuteContractAuthorization(contracts []ContractAuthorization) ExecuteContractAuthorization {
+	return ExecuteContractAuthorization{Contracts: contracts}
+}
+
+// MsgType implement msgauth Authorization
+func (authorization ExecuteContractAuthorization) MsgType() string {
+	return MsgExecuteContract{}.Type()
+}
+
+// Accept implement msgauth Authorization
+func (authorization ExecuteContractAuthorization) Accept(msg sdk.Msg, block abci.Header) (allow bool, updated msgauthexported.Authorization, delete bool) {
+	switch msg := msg.(type) {
+	case MsgExecuteContract:
+		for i, contractAuth := range authorization.Contracts {
+			if !contractAuth.Contract.Equals(msg.Contract) {
+				continue
+			}
+
+			limitLeft, isNegative := contractAuth.SpendLimit.SafeSub(msg.Coins)
+			if isNegative {
+				return false, nil, false
+			}
+
+			// copy contracts not to modify the stored authorization
+			contracts := make([]ContractAuthorization, 0, len(authorization.Contracts))
+			contracts = append(contracts, authorization.Contracts[:i]...)
+
+			switch contractAuth.CallsLimit {
+			case 0:
+				contracts = append(contracts, NewContractAuthorization(contractAuth.Contract, limitLeft, 0))
+			case 1:
+				// the last call is used; remove the contract from the authorization
+			default:
+				contracts = append(contracts, NewContractAuthorization(contractAuth.Contract, limitLeft, contractAuth.CallsLimit-1))
+			}
+
+			contracts = append(contracts, authorization.Contracts[i+1:]...)
+			if len(contracts) == 0 {
+				return true, nil, true
+			}
+
+			return true, NewExecuteContractAuthorization(contracts), false
+		}
+	}
+	return false, nil, false
+}
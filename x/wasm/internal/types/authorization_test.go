@@ -0,0 +1,69 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	core "github.com/terra-project/core/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mock"
+)
+
+func TestExecuteContractAuthorization(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(3, sdk.Coins{})
+	sender, contract, otherContract := addrs[0], addrs[1], addrs[2]
+
+	authorization := NewExecuteContractAuthorization([]ContractAuthorization{
+		NewContractAuthorization(contract, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100)), 2),
+		NewContractAuthorization(otherContract, nil, 0),
+	})
+	require.Equal(t, "execute_contract", authorization.MsgType())
+
+	// exceeds the spend limit
+	allow, _, _ := authorization.Accept(NewMsgExecuteContract(sender, contract, []byte("{}"), sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 101))), abci.Header{})
+	require.False(t, allow)
+
+	// no coins can be sent to the contract without spend limit
+	allow, _, _ = authorization.Accept(NewMsgExecuteContract(sender, otherContract, []byte("{}"), sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 1))), abci.Header{})
+	require.False(t, allow)
+
+	// not allowed contract
+	allow, _, _ = authorization.Accept(NewMsgExecuteContract(sender, sender, []byte("{}"), nil), abci.Header{})
+	require.False(t, allow)
+
+	// spend limit and calls limit are updated
+	allow, updated, del := authorization.Accept(NewMsgExecuteContract(sender, contract, []byte("{}"), sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 40))), abci.Header{})
+	require.True(t, allow)
+	require.False(t, del)
+	require.Equal(t, NewExecuteContractAuthorization([]ContractAuthorization{
+		NewContractAuthorization(contract, sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 60)), 1),
+		NewContractAuthorization(otherContract, nil, 0),
+	}), updated)
+
+	// the stored authorization is not modified
+	require.Equal(t, uint64(2), authorization.Contracts[0].CallsLimit)
+
+	// the contract is removed after the last call
+	allow, updated, del = updated.Accept(NewMsgExecuteContract(sender, contract, []byte("{}"), nil), abci.Header{})
+	require.True(t, allow)
+	require.False(t, del)
+	require.Equal(t, NewExecuteContractAuthorization([]ContractAuthorization{
+		NewContractAuthorization(otherContract, nil, 0),
+	}), updated)
+
+	// unlimited calls
+	allow, updated2, del := updated.Accept(NewMsgExecuteContract(sender, otherContract, []byte("{}"), nil), abci.Header{})
+	require.True(t, allow)
+	require.False(t, del)
+	require.Equal(t, updated, updated2)
+
+	// the authorization is deleted when no contract is left
+	authorization = NewExecuteContractAuthorization([]ContractAuthorization{NewContractAuthorization(contract, nil, 1)})
+	allow, _, del = authorization.Accept(NewMsgExecuteContract(sender, contract, []byte("{}"), nil), abci.Header{})
+	require.True(t, allow)
+	require.True(t, del)
+}
@@ -2,15 +2,25 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/terra-project/core/x/gov"
+	msgauthexported "github.com/terra-project/core/x/msgauth/exported"
 )
 
 // RegisterCodec registers the wasm types and interface
 func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgStoreCode{}, "wasm/MsgStoreCode", nil)
 	cdc.RegisterConcrete(MsgInstantiateContract{}, "wasm/MsgInstantiateContract", nil)
+	cdc.RegisterConcrete(MsgInstantiateContract2{}, "wasm/MsgInstantiateContract2", nil)
 	cdc.RegisterConcrete(MsgExecuteContract{}, "wasm/MsgExecuteContract", nil)
 	cdc.RegisterConcrete(MsgMigrateContract{}, "wasm/MsgMigrateContract", nil)
 	cdc.RegisterConcrete(MsgUpdateContractOwner{}, "wasm/MsgUpdateContractOwner", nil)
+	cdc.RegisterConcrete(MsgClearContractAdmin{}, "wasm/MsgClearContractAdmin", nil)
+	cdc.RegisterConcrete(ExecuteContractAuthorization{}, "wasm/ExecuteContractAuthorization", nil)
+	cdc.RegisterConcrete(StoreCodeProposal{}, "wasm/StoreCodeProposal", nil)
+	cdc.RegisterConcrete(InstantiateContractProposal{}, "wasm/InstantiateContractProposal", nil)
+	cdc.RegisterConcrete(MigrateContractProposal{}, "wasm/MigrateContractProposal", nil)
+	cdc.RegisterConcrete(UpdateContractAdminProposal{}, "wasm/UpdateContractAdminProposal", nil)
 }
 
 // ModuleCdc generic sealed codec to be used throughout module
@@ -21,4 +31,12 @@ func init() {
 	RegisterCodec(cdc)
 	codec.RegisterCrypto(cdc)
 	ModuleCdc = cdc.Seal()
+
+	msgauthexported.RegisterMsgAuthTypeCodec(MsgExecuteContract{}, "wasm/MsgExecuteContract")
+	msgauthexported.RegisterMsgAuthTypeCodec(ExecuteContractAuthorization{}, "wasm/ExecuteContractAuthorization")
+
+	gov.RegisterProposalTypeCodec(StoreCodeProposal{}, "wasm/StoreCodeProposal")
+	gov.RegisterProposalTypeCodec(InstantiateContractProposal{}, "wasm/InstantiateContractProposal")
+	gov.RegisterProposalTypeCodec(MigrateContractProposal{}, "wasm/MigrateContractProposal")
+	gov.RegisterProposalTypeCodec(UpdateContractAdminProposal{}, "wasm/UpdateContractAdminProposal")
 }
@@ -2,6 +2,7 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -12,23 +13,112 @@ import (
 // DefaultFeatures - Cosmwasm feature
 const DefaultFeatures = "staking,terra"
 
-// ParseEvents converts wasm LogAttributes into an sdk.Events (with 0 or 1 elements)
-func ParseEvents(logs []wasmTypes.LogAttribute, contractAddr sdk.AccAddress) sdk.Events {
+// Custom event limits
+const (
+	MinCustomEventTypeLength         = 2
+	MaxCustomEventTypeLength         = 64
+	MaxCustomEventAttributeKeyLength = 128
+)
+
+// ParseEvents converts wasm LogAttributes into the from_contract event and the typed
+// custom events of the contract. A log with the LogKeyEventType key starts a new custom
+// event of the type "wasm-<value>", and the following logs become its attributes.
+// The logs before the first custom event make the from_contract event as before.
+// Without typedEvents, all the logs make the from_contract event, including LogKeyEventType.
+func ParseEvents(logs []wasmTypes.LogAttribute, contractAddr sdk.AccAddress, typedEvents bool) (events sdk.Events, customEvents sdk.Events, err error) {
 	if len(logs) == 0 {
-		return nil
+		return nil, nil, nil
 	}
 
 	// we always tag with the contract address issuing this event
-	attrs := []sdk.Attribute{sdk.NewAttribute(AttributeKeyContractAddress, contractAddr.String())}
+	contractAttr := sdk.NewAttribute(AttributeKeyContractAddress, contractAddr.String())
+
+	attrs := []sdk.Attribute{contractAttr}
+	hasLogs := false
+	var customEvent *sdk.Event
 	for _, l := range logs {
-		// and reserve the contract_address key for our use (not contract)
-		if l.Key != AttributeKeyContractAddress {
-			attr := sdk.NewAttribute(l.Key, l.Value)
-			attrs = append(attrs, attr)
+		if typedEvents && l.Key == LogKeyEventType {
+			if customEvent != nil {
+				customEvents = append(customEvents, *customEvent)
+			}
+
+			eventType := strings.TrimSpace(l.Value)
+			if err := validateCustomEventType(eventType); err != nil {
+				return nil, nil, err
+			}
+
+			event := sdk.NewEvent(CustomEventTypePrefix+eventType, contractAttr)
+			customEvent = &event
+			continue
+		}
+
+		if customEvent == nil {
+			hasLogs = true
+
+			// and reserve the contract_address key for our use (not contract)
+			if l.Key != AttributeKeyContractAddress {
+				attrs = append(attrs, sdk.NewAttribute(l.Key, l.Value))
+			}
+
+			continue
 		}
+
+		key := strings.TrimSpace(l.Key)
+		if err := validateCustomEventAttributeKey(key); err != nil {
+			return nil, nil, err
+		}
+
+		customEvent.Attributes = append(customEvent.Attributes, sdk.NewAttribute(key, l.Value).ToKVPair())
+	}
+
+	if customEvent != nil {
+		customEvents = append(customEvents, *customEvent)
+	}
+
+	if hasLogs {
+		events = sdk.Events{sdk.NewEvent(EventTypeFromContract, attrs...)}
+	}
+
+	return events, customEvents, nil
+}
+
+// validateCustomEventType checks the type of a custom event given by a contract
+func validateCustomEventType(eventType string) error {
+	if len(eventType) < MinCustomEventTypeLength || len(eventType) > MaxCustomEventTypeLength {
+		return sdkerrors.Wrapf(ErrInvalidEvent, "event type length must be between %d and %d: %s",
+			MinCustomEventTypeLength, MaxCustomEventTypeLength, eventType)
+	}
+
+	for _, c := range eventType {
+		if !isCustomEventTypeChar(c) {
+			return sdkerrors.Wrapf(ErrInvalidEvent, "invalid character %q in event type: %s", c, eventType)
+		}
+	}
+
+	return nil
+}
+
+func isCustomEventTypeChar(c rune) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') ||
+		c == '-' || c == '_' || c == '.'
+}
+
+// validateCustomEventAttributeKey checks an attribute key of a custom event given by a contract
+func validateCustomEventAttributeKey(key string) error {
+	if len(key) == 0 {
+		return sdkerrors.Wrap(ErrInvalidEvent, "empty attribute key")
+	}
+
+	if len(key) > MaxCustomEventAttributeKeyLength {
+		return sdkerrors.Wrapf(ErrInvalidEvent, "attribute key length must not exceed %d: %s", MaxCustomEventAttributeKeyLength, key)
+	}
+
+	// keys starting with an underscore and the contract address are reserved
+	if strings.HasPrefix(key, "_") || key == AttributeKeyContractAddress {
+		return sdkerrors.Wrapf(ErrInvalidEvent, "reserved attribute key: %s", key)
 	}
 
-	return sdk.Events{sdk.NewEvent(EventTypeFromContract, attrs...)}
+	return nil
 }
 
 // ParseToCoin converts wasm coin to sdk.Coin
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	wasmTypes "github.com/CosmWasm/go-cosmwasm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestParseEvents(t *testing.T) {
+	contractAddr := sdk.AccAddress([]byte("contract_address____"))
+	contractAttr := sdk.NewAttribute(AttributeKeyContractAddress, contractAddr.String())
+
+	cases := map[string]struct {
+		logs         []wasmTypes.LogAttribute
+		events       sdk.Events
+		customEvents sdk.Events
+		expectErr    bool
+	}{
+		"empty logs": {},
+		"plain logs": {
+			logs: []wasmTypes.LogAttribute{{Key: "action", Value: "transfer"}, {Key: AttributeKeyContractAddress, Value: "fake"}},
+			events: sdk.Events{sdk.NewEvent(EventTypeFromContract, contractAttr,
+				sdk.NewAttribute("action", "transfer"))},
+		},
+		"custom events": {
+			logs: []wasmTypes.LogAttribute{
+				{Key: "action", Value: "swap"},
+				{Key: LogKeyEventType, Value: "swap"},
+				{Key: "offer", Value: "100uluna"},
+				{Key: LogKeyEventType, Value: " pool.update "},
+				{Key: " reserve ", Value: "1000uluna"},
+			},
+			events: sdk.Events{sdk.NewEvent(EventTypeFromContract, contractAttr,
+				sdk.NewAttribute("action", "swap"))},
+			customEvents: sdk.Events{
+				sdk.NewEvent("wasm-swap", contractAttr, sdk.NewAttribute("offer", "100uluna")),
+				sdk.NewEvent("wasm-pool.update", contractAttr, sdk.NewAttribute("reserve", "1000uluna")),
+			},
+		},
+		"custom events only": {
+			logs:         []wasmTypes.LogAttribute{{Key: LogKeyEventType, Value: "ping"}},
+			customEvents: sdk.Events{sdk.NewEvent("wasm-ping", contractAttr)},
+		},
+		"too short event type": {
+			logs:      []wasmTypes.LogAttribute{{Key: LogKeyEventType, Value: " a "}},
+			expectErr: true,
+		},
+		"too long event type": {
+			logs:      []wasmTypes.LogAttribute{{Key: LogKeyEventType, Value: strings.Repeat("a", MaxCustomEventTypeLength+1)}},
+			expectErr: true,
+		},
+		"invalid event type character": {
+			logs:      []wasmTypes.LogAttribute{{Key: LogKeyEventType, Value: "swap done"}},
+			expectErr: true,
+		},
+		"empty attribute key": {
+			logs:      []wasmTypes.LogAttribute{{Key: LogKeyEventType, Value: "swap"}, {Key: " ", Value: "1"}},
+			expectErr: true,
+		},
+		"reserved attribute key": {
+			logs:      []wasmTypes.LogAttribute{{Key: LogKeyEventType, Value: "swap"}, {Key: "_sender", Value: "1"}},
+			expectErr: true,
+		},
+		"contract address attribute key": {
+			logs:      []wasmTypes.LogAttribute{{Key: LogKeyEventType, Value: "swap"}, {Key: AttributeKeyContractAddress, Value: "1"}},
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			events, customEvents, err := ParseEvents(tc.logs, contractAddr, true)
+			if tc.expectErr {
+				require.Error(t, err)
+				require.True(t, ErrInvalidEvent.Is(err))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tc.events, events)
+			require.Equal(t, tc.customEvents, customEvents)
+		})
+	}
+}
+
+func TestParseEventsWithoutTypedEvents(t *testing.T) {
+	contractAddr := sdk.AccAddress([]byte("contract_address____"))
+	contractAttr := sdk.NewAttribute(AttributeKeyContractAddress, contractAddr.String())
+
+	// all the logs make the from_contract event, and the invalid custom events are not rejected
+	logs := []wasmTypes.LogAttribute{
+		{Key: "action", Value: "swap"},
+		{Key: LogKeyEventType, Value: "swap done"},
+		{Key: "_sender", Value: "1"},
+		{Key: AttributeKeyContractAddress, Value: "fake"},
+	}
+
+	events, customEvents, err := ParseEvents(logs, contractAddr, false)
+	require.NoError(t, err)
+	require.Empty(t, customEvents)
+	require.Equal(t, sdk.Events{sdk.NewEvent(EventTypeFromContract, contractAttr,
+		sdk.NewAttribute("action", "swap"),
+		sdk.NewAttribute(LogKeyEventType, "swap done"),
+		sdk.NewAttribute("_sender", "1"),
+	)}, events)
+}
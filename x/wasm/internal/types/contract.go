@@ -2,10 +2,13 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	wasmTypes "github.com/CosmWasm/go-cosmwasm/types"
+	"github.com/tendermint/tendermint/crypto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	core "github.com/terra-project/core/types"
 )
 
@@ -25,26 +28,29 @@ func (m Model) String() string {
 
 // CodeInfo is data for the uploaded contract WASM code
 type CodeInfo struct {
-	CodeID   uint64           `json:"code_id"`
-	CodeHash core.Base64Bytes `json:"code_hash"`
-	Creator  sdk.AccAddress   `json:"creator"`
+	CodeID                uint64           `json:"code_id"`
+	CodeHash              core.Base64Bytes `json:"code_hash"`
+	Creator               sdk.AccAddress   `json:"creator"`
+	InstantiatePermission AccessConfig     `json:"instantiate_permission"`
 }
 
 // String implements fmt.Stringer interface
 func (ci CodeInfo) String() string {
 	return fmt.Sprintf(`CodeInfo
-	CodeID:      %d,
-	CodeHash:    %s, 
-	Creator:     %s`,
-		ci.CodeID, ci.CodeHash, ci.Creator)
+	CodeID:                %d,
+	CodeHash:              %s, 
+	Creator:               %s,
+	InstantiatePermission: %s`,
+		ci.CodeID, ci.CodeHash, ci.Creator, ci.InstantiatePermission)
 }
 
 // NewCodeInfo fills a new Contract struct
-func NewCodeInfo(codeID uint64, codeHash []byte, creator sdk.AccAddress) CodeInfo {
+func NewCodeInfo(codeID uint64, codeHash []byte, creator sdk.AccAddress, instantiatePermission AccessConfig) CodeInfo {
 	return CodeInfo{
-		CodeID:   codeID,
-		CodeHash: codeHash,
-		Creator:  creator,
+		CodeID:                codeID,
+		CodeHash:              codeHash,
+		Creator:               creator,
+		InstantiatePermission: instantiatePermission,
 	}
 }
 
@@ -55,16 +61,18 @@ type ContractInfo struct {
 	CodeID     uint64           `json:"code_id"`
 	InitMsg    core.Base64Bytes `json:"init_msg"`
 	Migratable bool             `json:"migratable"`
+	Label      string           `json:"label,omitempty"`
 }
 
 // NewContractInfo creates a new instance of a given WASM contract info
-func NewContractInfo(codeID uint64, address, owner sdk.AccAddress, initMsg []byte, migratable bool) ContractInfo {
+func NewContractInfo(codeID uint64, address, owner sdk.AccAddress, initMsg []byte, migratable bool, label string) ContractInfo {
 	return ContractInfo{
 		Address:    address,
 		CodeID:     codeID,
 		Owner:      owner,
 		InitMsg:    initMsg,
 		Migratable: migratable,
+		Label:      label,
 	}
 }
 
@@ -76,8 +84,98 @@ func (ci ContractInfo) String() string {
 	Owner:      %s,
 	InitMsg:    %s,
 	Migratable  %v,
+	Label:      %s,
 	`,
-		ci.Address, ci.CodeID, ci.Owner, ci.InitMsg, ci.Migratable)
+		ci.Address, ci.CodeID, ci.Owner, ci.InitMsg, ci.Migratable, ci.Label)
+}
+
+// MaxLabelSize is the maximum length of a contract label
+const MaxLabelSize = 128
+
+// ValidateLabel checks the optional contract label
+func ValidateLabel(label string) error {
+	if len(label) > MaxLabelSize {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "label must not be longer than %d", MaxLabelSize)
+	}
+
+	if strings.TrimSpace(label) != label {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "label must not have leading or trailing spaces")
+	}
+
+	return nil
+}
+
+// MaxSaltSize is the maximum length of the salt of a predictable contract address
+const MaxSaltSize = 64
+
+// ValidateSalt checks the salt of a predictable contract address
+func ValidateSalt(salt []byte) error {
+	if len(salt) == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "empty salt")
+	}
+
+	if len(salt) > MaxSaltSize {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "salt must not be longer than %d", MaxSaltSize)
+	}
+
+	return nil
+}
+
+// PredictableContractAddress derives a contract address from the creator, the code hash
+// and the salt. Every part is length prefixed, so different inputs never collide.
+func PredictableContractAddress(creator sdk.AccAddress, codeHash []byte, salt []byte) sdk.AccAddress {
+	var key []byte
+	key = append(key, predictableAddressPrefix...)
+	for _, part := range [][]byte{creator, codeHash, salt} {
+		key = append(key, byte(len(part)))
+		key = append(key, part...)
+	}
+
+	return sdk.AccAddress(crypto.AddressHash(key))
+}
+
+// predictableAddressPrefix separates the predictable addresses from the counter based ones
+var predictableAddressPrefix = []byte("wasm/predictable")
+
+// ContractHistoryOperation defines the operation recorded in the contract history
+type ContractHistoryOperation string
+
+// Contract history operations
+const (
+	ContractHistoryOperationInit    ContractHistoryOperation = "init"
+	ContractHistoryOperationMigrate ContractHistoryOperation = "migrate"
+
+	// ContractHistoryOperationGenesis records the code of a contract instantiated
+	// before the contract histories were recorded
+	ContractHistoryOperationGenesis ContractHistoryOperation = "genesis"
+)
+
+// ContractHistoryEntry records a code change of a contract
+type ContractHistoryEntry struct {
+	Operation ContractHistoryOperation `json:"operation"`
+	CodeID    uint64                   `json:"code_id"`
+	Height    int64                    `json:"height"`
+	Msg       core.Base64Bytes         `json:"msg"`
+}
+
+// NewContractHistoryEntry creates a new instance of ContractHistoryEntry
+func NewContractHistoryEntry(operation ContractHistoryOperation, codeID uint64, height int64, msg []byte) ContractHistoryEntry {
+	return ContractHistoryEntry{
+		Operation: operation,
+		CodeID:    codeID,
+		Height:    height,
+		Msg:       msg,
+	}
+}
+
+// String implements fmt.Stringer interface
+func (e ContractHistoryEntry) String() string {
+	return fmt.Sprintf(`ContractHistoryEntry
+	Operation: %s,
+	CodeID:    %d,
+	Height:    %d,
+	Msg:       %s`,
+		e.Operation, e.CodeID, e.Height, e.Msg)
 }
 
 // NewWasmAPIParams initializes params for a contract instance
@@ -20,4 +20,7 @@ var (
 	ErrNotMigratable       = sdkerrors.Register(ModuleName, 12, "the contract is not migratable ")
 	ErrStoreCodeFailed     = sdkerrors.Register(ModuleName, 13, "store wasm contract failed")
 	ErrContractQueryFailed = sdkerrors.Register(ModuleName, 14, "contract query failed")
+	ErrQueryDepthExceeded  = sdkerrors.Register(ModuleName, 15, "contract query depth exceeded")
+	ErrQueryGasCapExceeded = sdkerrors.Register(ModuleName, 16, "contract sub-query gas cap exceeded")
+	ErrInvalidEvent        = sdkerrors.Register(ModuleName, 17, "invalid event from the contract")
 )
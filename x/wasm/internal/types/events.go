@@ -8,13 +8,29 @@ const (
 	EventTypeExecuteContract     = "execute_contract"
 	EventTypeMigrateContract     = "migrate_contract"
 	EventTypeUpdateContractOwner = "update_contract_owner"
+	EventTypeClearContractAdmin  = "clear_contract_admin"
 	EventTypeFromContract        = "from_contract"
+	EventTypeReply               = "reply"
+	EventTypeGasTrace            = "wasm_gas_trace"
 
 	AttributeKeySender          = "sender"
 	AttributeKeyCodeID          = "code_id"
 	AttributeKeyContractAddress = "contract_address"
 	AttributeKeyContractID      = "contract_id"
 	AttributeKeyOwner           = "owner"
+	AttributeKeySubMsgID        = "sub_msg_id"
+	AttributeKeyReplyOn         = "reply_on"
+	AttributeKeySuccess         = "success"
+	AttributeKeyGasTrace        = "trace"
 
 	AttributeValueCategory = ModuleName
 )
+
+// Custom events given by the contracts
+const (
+	// CustomEventTypePrefix is prepended to the type of every custom event
+	CustomEventTypePrefix = "wasm-"
+
+	// LogKeyEventType is the reserved log key starting a custom event
+	LogKeyEventType = "_type"
+)
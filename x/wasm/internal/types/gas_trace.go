@@ -0,0 +1,242 @@
+package types
+
+import (
+	"strings"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// GasTraceCategory classifies the gas recorded in a gas trace
+type GasTraceCategory string
+
+// Gas trace categories
+const (
+	GasTraceCategoryVM           GasTraceCategory = "vm"
+	GasTraceCategoryStorageRead  GasTraceCategory = "storage_read"
+	GasTraceCategoryStorageWrite GasTraceCategory = "storage_write"
+	GasTraceCategoryHumanize     GasTraceCategory = "humanize"
+	GasTraceCategoryCanonicalize GasTraceCategory = "canonicalize"
+	GasTraceCategoryQuery        GasTraceCategory = "query"
+	GasTraceCategoryDispatch     GasTraceCategory = "dispatch"
+	GasTraceCategoryOther        GasTraceCategory = "other"
+)
+
+// gasTraceCategories is the order of the categories in the trace totals
+var gasTraceCategories = []GasTraceCategory{
+	GasTraceCategoryVM,
+	GasTraceCategoryStorageRead,
+	GasTraceCategoryStorageWrite,
+	GasTraceCategoryHumanize,
+	GasTraceCategoryCanonicalize,
+	GasTraceCategoryQuery,
+	GasTraceCategoryDispatch,
+	GasTraceCategoryOther,
+}
+
+// GasDescSubQuery is the gas descriptor of the gas charged for a contract sub-query
+const GasDescSubQuery = "contract sub-query"
+
+// GasTraceEntry records gas consumed during a wasm msg execution.
+// Depth counts the dispatched messages the gas was consumed in.
+type GasTraceEntry struct {
+	Category   GasTraceCategory `json:"category"`
+	Descriptor string           `json:"descriptor"`
+	Gas        uint64           `json:"gas"`
+	Depth      int              `json:"depth"`
+}
+
+// GasTraceTotal is the sum of the gas recorded for a category
+type GasTraceTotal struct {
+	Category GasTraceCategory `json:"category"`
+	Gas      uint64           `json:"gas"`
+}
+
+// GasTrace is a breakdown of the gas consumed by a wasm msg. It is only
+// recorded on simulation and never affects the gas consumption itself.
+//
+// Gas of the humanize and canonicalize calls is charged by the VM, so it is
+// recorded as separate entries and deducted from the following VM entry.
+// Everything consumed by a dispatched message is recorded as dispatch gas.
+type GasTrace struct {
+	Entries []GasTraceEntry `json:"entries"`
+	Totals  []GasTraceTotal `json:"totals"`
+	GasUsed uint64          `json:"gas_used"`
+
+	scopes     []GasTraceCategory
+	pendingAPI map[int]uint64
+}
+
+// NewGasTrace returns an empty GasTrace
+func NewGasTrace() *GasTrace {
+	return &GasTrace{
+		Entries:    []GasTraceEntry{},
+		Totals:     []GasTraceTotal{},
+		pendingAPI: make(map[int]uint64),
+	}
+}
+
+// BeginScope starts recording the gas as consumed by a sub-query or a dispatched message
+func (t *GasTrace) BeginScope(category GasTraceCategory) {
+	t.scopes = append(t.scopes, category)
+}
+
+// EndScope stops recording the gas for the last scope
+func (t *GasTrace) EndScope() {
+	delete(t.pendingAPI, len(t.scopes))
+	t.scopes = t.scopes[:len(t.scopes)-1]
+}
+
+// inQuery returns whether the gas is consumed in a sub-query, which
+// uses its own gas meter and is charged as a whole afterwards
+func (t *GasTrace) inQuery() bool {
+	for _, scope := range t.scopes {
+		if scope == GasTraceCategoryQuery {
+			return true
+		}
+	}
+
+	return false
+}
+
+// category returns the category of the scope, or the given one outside of any scope
+func (t *GasTrace) category(category GasTraceCategory) GasTraceCategory {
+	if len(t.scopes) != 0 {
+		return t.scopes[len(t.scopes)-1]
+	}
+
+	return category
+}
+
+// RecordAPICall records the gas of a humanize or canonicalize call charged by the VM
+func (t *GasTrace) RecordAPICall(category GasTraceCategory, gas uint64) {
+	if t.inQuery() {
+		return
+	}
+
+	depth := len(t.scopes)
+	t.pendingAPI[depth] += gas
+	t.Entries = append(t.Entries, GasTraceEntry{
+		Category:   t.category(category),
+		Descriptor: string(category),
+		Gas:        gas,
+		Depth:      depth,
+	})
+}
+
+// RecordGas records the gas consumed from the gas meter with the descriptor
+func (t *GasTrace) RecordGas(descriptor string, gas uint64) {
+	if t.inQuery() {
+		return
+	}
+
+	depth := len(t.scopes)
+	category := gasTraceCategoryOf(descriptor)
+	if category == GasTraceCategoryVM {
+		// the api calls are already recorded
+		deduct := t.pendingAPI[depth]
+		if deduct > gas {
+			deduct = gas
+		}
+
+		gas -= deduct
+		t.pendingAPI[depth] -= deduct
+	}
+
+	t.Entries = append(t.Entries, GasTraceEntry{
+		Category:   t.category(category),
+		Descriptor: descriptor,
+		Gas:        gas,
+		Depth:      depth,
+	})
+}
+
+// Summarize fills the totals of the trace
+func (t *GasTrace) Summarize() {
+	sums := make(map[GasTraceCategory]uint64)
+	t.GasUsed = 0
+	for _, entry := range t.Entries {
+		sums[entry.Category] += entry.Gas
+		t.GasUsed += entry.Gas
+	}
+
+	t.Totals = []GasTraceTotal{}
+	for _, category := range gasTraceCategories {
+		if sum, ok := sums[category]; ok {
+			t.Totals = append(t.Totals, GasTraceTotal{Category: category, Gas: sum})
+		}
+	}
+}
+
+// gasTraceCategoryOf classifies the gas meter descriptors
+func gasTraceCategoryOf(descriptor string) GasTraceCategory {
+	switch descriptor {
+	case storetypes.GasReadCostFlatDesc, storetypes.GasReadPerByteDesc, storetypes.GasHasDesc,
+		storetypes.GasIterNextCostFlatDesc, storetypes.GasValuePerByteDesc:
+		return GasTraceCategoryStorageRead
+	case storetypes.GasWriteCostFlatDesc, storetypes.GasWritePerByteDesc, storetypes.GasDeleteDesc:
+		return GasTraceCategoryStorageWrite
+	case GasDescSubQuery:
+		return GasTraceCategoryQuery
+	}
+
+	if strings.HasPrefix(descriptor, "Contract ") || strings.HasPrefix(descriptor, "Loading CosmWasm module") {
+		return GasTraceCategoryVM
+	}
+
+	return GasTraceCategoryOther
+}
+
+// gasTraceMeter records all the consumed gas to the trace before consuming it
+type gasTraceMeter struct {
+	sdk.GasMeter
+	trace *GasTrace
+}
+
+func (m gasTraceMeter) ConsumeGas(amount sdk.Gas, descriptor string) {
+	m.trace.RecordGas(descriptor, amount)
+	m.GasMeter.ConsumeGas(amount, descriptor)
+}
+
+type gasTraceKey struct{}
+
+// WithGasTrace starts recording a gas trace with the gas meter of the context
+func WithGasTrace(ctx sdk.Context) (sdk.Context, *GasTrace) {
+	trace := NewGasTrace()
+	ctx = ctx.WithValue(gasTraceKey{}, trace).
+		WithGasMeter(gasTraceMeter{GasMeter: ctx.GasMeter(), trace: trace})
+
+	return ctx, trace
+}
+
+// GetGasTrace returns the gas trace being recorded, nil if there is none
+func GetGasTrace(ctx sdk.Context) *GasTrace {
+	trace, _ := ctx.Value(gasTraceKey{}).(*GasTrace)
+	return trace
+}
+
+// ParseGasTraces returns the gas traces from the events of a simulation result
+func ParseGasTraces(events sdk.Events) ([]GasTrace, error) {
+	traces := []GasTrace{}
+	for _, event := range events {
+		if event.Type != EventTypeGasTrace {
+			continue
+		}
+
+		for _, attr := range event.Attributes {
+			if string(attr.Key) != AttributeKeyGasTrace {
+				continue
+			}
+
+			var trace GasTrace
+			if err := ModuleCdc.UnmarshalJSON(attr.Value, &trace); err != nil {
+				return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+			}
+
+			traces = append(traces, trace)
+		}
+	}
+
+	return traces, nil
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestGasTrace(t *testing.T) {
+	trace := NewGasTrace()
+
+	// api calls are carved out of the following vm gas
+	trace.RecordAPICall(GasTraceCategoryHumanize, 5)
+	trace.RecordGas("Contract Execution", 100)
+	trace.RecordGas(storetypes.GasReadCostFlatDesc, 10)
+	trace.RecordGas(storetypes.GasWritePerByteDesc, 20)
+
+	// sub-query internals are charged as a whole afterwards
+	trace.BeginScope(GasTraceCategoryQuery)
+	trace.RecordGas(storetypes.GasReadCostFlatDesc, 1000)
+	trace.EndScope()
+	trace.RecordGas(GasDescSubQuery, 30)
+
+	// everything consumed by a dispatched msg is dispatch gas
+	trace.BeginScope(GasTraceCategoryDispatch)
+	trace.RecordAPICall(GasTraceCategoryCanonicalize, 3)
+	trace.RecordGas(storetypes.GasWriteCostFlatDesc, 40)
+	trace.EndScope()
+
+	trace.RecordGas("unknown", 7)
+	trace.Summarize()
+
+	require.Equal(t, []GasTraceTotal{
+		{GasTraceCategoryVM, 95},
+		{GasTraceCategoryStorageRead, 10},
+		{GasTraceCategoryStorageWrite, 20},
+		{GasTraceCategoryHumanize, 5},
+		{GasTraceCategoryQuery, 30},
+		{GasTraceCategoryDispatch, 43},
+		{GasTraceCategoryOther, 7},
+	}, trace.Totals)
+	require.Equal(t, uint64(210), trace.GasUsed)
+	require.Equal(t, 1, trace.Entries[len(trace.Entries)-2].Depth)
+}
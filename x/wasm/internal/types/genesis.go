@@ -15,16 +15,20 @@ type GenesisState struct {
 	Contracts      []Contract `json:"contracts" yaml:"contracts"`
 }
 
-// Code struct encompasses CodeInfo and CodeBytes
+// Code struct encompasses CodeInfo and CodeBytes; the code bytes are
+// read from CodeFile instead when the code is exported to a file
 type Code struct {
 	CodeInfo   CodeInfo `json:"code_info"`
 	CodesBytes []byte   `json:"code_bytes"`
+	CodeFile   string   `json:"code_file,omitempty"`
 }
 
 // Contract struct encompasses ContractAddress, ContractInfo, and ContractState
 type Contract struct {
 	ContractInfo  ContractInfo `json:"contract_info"`
 	ContractStore []Model      `json:"contract_store"`
+	// History is empty for contracts exported before the history existed
+	History []ContractHistoryEntry `json:"history,omitempty"`
 }
 
 // NewGenesisState creates a new GenesisState object
@@ -57,8 +61,9 @@ func ValidateGenesis(data GenesisState) error {
 		return sdkerrors.Wrap(ErrInvalidGenesis, "the number of codes is not met with LastCodeID")
 	}
 
-	if uint64(len(data.Contracts)) != data.LastInstanceID {
-		return sdkerrors.Wrap(ErrInvalidGenesis, "the number of contracts is not met with LastInstanceID")
+	// the contracts instantiated with a salt take no instance ID
+	if uint64(len(data.Contracts)) < data.LastInstanceID {
+		return sdkerrors.Wrap(ErrInvalidGenesis, "the number of contracts is less than LastInstanceID")
 	}
 
 	return data.Params.Validate()
@@ -34,7 +34,11 @@ func TestGenesisValidation(t *testing.T) {
 	genState.LastInstanceID = 2
 	require.NoError(t, ValidateGenesis(genState))
 
+	// the contracts instantiated with a salt take no instance ID
 	genState.LastInstanceID = 1
+	require.NoError(t, ValidateGenesis(genState))
+
+	genState.LastInstanceID = 3
 	require.Error(t, ValidateGenesis(genState))
 }
 
@@ -33,12 +33,21 @@ const (
 // - 0x04<accAddress_Bytes>: ContractInfo
 //
 // - 0x05<accAddress_Bytes>: KVStore for contract
+//
+// - 0x06<accAddress_Bytes><uint64>: ContractHistoryEntry
+//
+// - 0x07<uint64><accAddress_Bytes>: []byte{} (contract index by code id)
+//
+// - 0x08<accAddress_Bytes><accAddress_Bytes>: []byte{} (contract index by owner)
 var (
-	LastCodeIDKey     = []byte{0x01}
-	LastInstanceIDKey = []byte{0x02}
-	CodeKey           = []byte{0x03}
-	ContractInfoKey   = []byte{0x04}
-	ContractStoreKey  = []byte{0x05}
+	LastCodeIDKey           = []byte{0x01}
+	LastInstanceIDKey       = []byte{0x02}
+	CodeKey                 = []byte{0x03}
+	ContractInfoKey         = []byte{0x04}
+	ContractStoreKey        = []byte{0x05}
+	ContractHistoryKey      = []byte{0x06}
+	ContractByCodeIndexKey  = []byte{0x07}
+	ContractByOwnerIndexKey = []byte{0x08}
 )
 
 // GetCodeInfoKey constructs the key of the WASM code info for the ID
@@ -56,3 +65,33 @@ func GetContractInfoKey(addr sdk.AccAddress) []byte {
 func GetContractStoreKey(addr sdk.AccAddress) []byte {
 	return append(ContractStoreKey, addr...)
 }
+
+// GetContractHistoryPrefix returns the store prefix for the history entries of a contract
+func GetContractHistoryPrefix(addr sdk.AccAddress) []byte {
+	return append(ContractHistoryKey, addr...)
+}
+
+// GetContractHistoryKey returns the key of a contract history entry for the position
+func GetContractHistoryKey(addr sdk.AccAddress, pos uint64) []byte {
+	return append(GetContractHistoryPrefix(addr), sdk.Uint64ToBigEndian(pos)...)
+}
+
+// GetContractByCodeIndexPrefix returns the store prefix for the contracts of a code
+func GetContractByCodeIndexPrefix(codeID uint64) []byte {
+	return append(ContractByCodeIndexKey, sdk.Uint64ToBigEndian(codeID)...)
+}
+
+// GetContractByCodeIndexKey returns the key of a contract in the code index
+func GetContractByCodeIndexKey(codeID uint64, addr sdk.AccAddress) []byte {
+	return append(GetContractByCodeIndexPrefix(codeID), addr...)
+}
+
+// GetContractByOwnerIndexPrefix returns the store prefix for the contracts of an owner
+func GetContractByOwnerIndexPrefix(owner sdk.AccAddress) []byte {
+	return append(ContractByOwnerIndexKey, owner...)
+}
+
+// GetContractByOwnerIndexKey returns the key of a contract in the owner index
+func GetContractByOwnerIndexKey(owner, addr sdk.AccAddress) []byte {
+	return append(GetContractByOwnerIndexPrefix(owner), addr...)
+}
@@ -12,6 +12,8 @@ type MsgStoreCode struct {
 	Sender sdk.AccAddress `json:"sender" yaml:"sender"`
 	// WASMByteCode can be raw or gzip compressed
 	WASMByteCode core.Base64Bytes `json:"wasm_byte_code" yaml:"wasm_byte_code"`
+	// InstantiatePermission restricts who can instantiate the code; everybody when empty
+	InstantiatePermission *AccessConfig `json:"instantiate_permission,omitempty" yaml:"instantiate_permission,omitempty"`
 }
 
 // NewMsgStoreCode creates a MsgStoreCode instance
@@ -56,6 +58,12 @@ func (msg MsgStoreCode) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "wasm code too large")
 	}
 
+	if msg.InstantiatePermission != nil {
+		if err := msg.InstantiatePermission.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "instantiate permission")
+		}
+	}
+
 	return nil
 }
 
@@ -66,6 +74,8 @@ type MsgInstantiateContract struct {
 	InitMsg    core.Base64Bytes `json:"init_msg" yaml:"init_msg"`
 	InitCoins  sdk.Coins        `json:"init_coins" yaml:"init_coins"`
 	Migratable bool             `json:"migratable" yaml:"migratable"`
+	// Label is an optional human readable name of the contract
+	Label string `json:"label,omitempty" yaml:"label,omitempty"`
 }
 
 // NewMsgInstantiateContract creates a MsgInstantiateContract instance
@@ -103,6 +113,10 @@ func (msg MsgInstantiateContract) ValidateBasic() error {
 		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "wasm msg byte size is too huge")
 	}
 
+	if err := ValidateLabel(msg.Label); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -116,6 +130,62 @@ func (msg MsgInstantiateContract) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Owner}
 }
 
+// MsgInstantiateContract2 - struct for instantiate contract from uploaded code
+// at the address derived from the owner, the code hash and the salt
+type MsgInstantiateContract2 struct {
+	Owner      sdk.AccAddress   `json:"owner" yaml:"owner"`
+	CodeID     uint64           `json:"code_id" yaml:"code_id"`
+	InitMsg    core.Base64Bytes `json:"init_msg" yaml:"init_msg"`
+	InitCoins  sdk.Coins        `json:"init_coins" yaml:"init_coins"`
+	Migratable bool             `json:"migratable" yaml:"migratable"`
+	// Label is an optional human readable name of the contract
+	Label string           `json:"label,omitempty" yaml:"label,omitempty"`
+	Salt  core.Base64Bytes `json:"salt" yaml:"salt"`
+}
+
+// NewMsgInstantiateContract2 creates a MsgInstantiateContract2 instance
+func NewMsgInstantiateContract2(owner sdk.AccAddress, codeID uint64, initMsg []byte, initCoins sdk.Coins, migratable bool, salt []byte) MsgInstantiateContract2 {
+	return MsgInstantiateContract2{
+		Owner:      owner,
+		CodeID:     codeID,
+		InitMsg:    initMsg,
+		InitCoins:  initCoins,
+		Migratable: migratable,
+		Salt:       salt,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgInstantiateContract2) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgInstantiateContract2) Type() string {
+	return "instantiate_contract2"
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgInstantiateContract2) ValidateBasic() error {
+	if err := ValidateSalt(msg.Salt); err != nil {
+		return err
+	}
+
+	instantiateMsg := NewMsgInstantiateContract(msg.Owner, msg.CodeID, msg.InitMsg, msg.InitCoins, msg.Migratable)
+	instantiateMsg.Label = msg.Label
+	return instantiateMsg.ValidateBasic()
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgInstantiateContract2) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgInstantiateContract2) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
 // MsgExecuteContract - struct for execute instantiated contract with givn inner msg bytes
 type MsgExecuteContract struct {
 	Sender     sdk.AccAddress   `json:"sender" yaml:"sender"`
@@ -290,3 +360,51 @@ func (msg MsgUpdateContractOwner) GetSignBytes() []byte {
 func (msg MsgUpdateContractOwner) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Owner}
 }
+
+// MsgClearContractAdmin - struct for clearing the owner of a contract,
+// which makes the contract immutable for good
+type MsgClearContractAdmin struct {
+	Owner    sdk.AccAddress `json:"owner" yaml:"owner"`
+	Contract sdk.AccAddress `json:"contract" yaml:"contract"`
+}
+
+// NewMsgClearContractAdmin creates a MsgClearContractAdmin instance
+func NewMsgClearContractAdmin(owner, contract sdk.AccAddress) MsgClearContractAdmin {
+	return MsgClearContractAdmin{
+		Owner:    owner,
+		Contract: contract,
+	}
+}
+
+// Route implements sdk.Msg
+func (msg MsgClearContractAdmin) Route() string {
+	return RouterKey
+}
+
+// Type implements sdk.Msg
+func (msg MsgClearContractAdmin) Type() string {
+	return "clear_contract_admin"
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgClearContractAdmin) ValidateBasic() error {
+	if msg.Owner.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty owner")
+	}
+
+	if msg.Contract.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty contract address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg
+func (msg MsgClearContractAdmin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgClearContractAdmin) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
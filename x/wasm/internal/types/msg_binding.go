@@ -11,10 +11,12 @@ import (
 
 // Routes of pre-determined wasm querier
 const (
-	WasmMsgParserRouteBank    = "bank"
-	WasmMsgParserRouteStaking = "staking"
-	WasmMsgParserRouteMarket  = "market"
-	WasmMsgParserRouteWasm    = "wasm"
+	WasmMsgParserRouteBank         = "bank"
+	WasmMsgParserRouteStaking      = "staking"
+	WasmMsgParserRouteMarket       = "market"
+	WasmMsgParserRouteWasm         = "wasm"
+	WasmMsgParserRouteDistribution = "distribution"
+	WasmMsgParserRouteGov          = "gov"
 )
 
 // WasmMsgParserInterface - msg parsers of each module
@@ -73,3 +75,37 @@ func (p MsgParser) Parse(contractAddr sdk.AccAddress, msg wasmTypes.CosmosMsg) (
 	}
 	return nil, sdkerrors.Wrap(ErrInvalidMsg, "failed to parse empty msg")
 }
+
+// CustomMsgRoute returns the route of the custom msg, or false if the msg is not a valid custom msg
+func CustomMsgRoute(msg wasmTypes.CosmosMsg) (string, bool) {
+	if msg.Custom == nil {
+		return "", false
+	}
+
+	var customMsg WasmCustomMsg
+	if err := json.Unmarshal(msg.Custom, &customMsg); err != nil {
+		return "", false
+	}
+
+	return customMsg.Route, true
+}
+
+// WasmCustomWasmMsg is the custom msg of the wasm route, which a contract dispatches as
+//
+//	{"custom": {"route": "wasm", "msg_data": {"instantiate": {"code_id": 1, "msg": "...", "send": [], "migratable": true, "salt": "..."}}}}
+//
+// The custom msgs of the wasm route are ignored until the wasm-custom-msgs feature is active
+type WasmCustomWasmMsg struct {
+	Instantiate *WasmCustomInstantiateMsg `json:"instantiate,omitempty"`
+}
+
+// WasmCustomInstantiateMsg instantiates a contract at the address derived from
+// the contract, the code hash and the salt, so the contract knows it in advance
+type WasmCustomInstantiateMsg struct {
+	CodeID     uint64          `json:"code_id"`
+	Msg        []byte          `json:"msg"`
+	Send       wasmTypes.Coins `json:"send"`
+	Migratable bool            `json:"migratable,omitempty"`
+	Label      string          `json:"label,omitempty"`
+	Salt       []byte          `json:"salt"`
+}
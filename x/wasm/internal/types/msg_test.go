@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	core "github.com/terra-project/core/types"
@@ -13,19 +14,28 @@ import (
 func TestMsgStoreCode(t *testing.T) {
 	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
 
+	onlySender := OnlyAddresses(addrs[0])
+	emptyOnly := OnlyAddresses()
+	invalidType := NewAccessConfig("somebody")
+
 	tests := []struct {
 		sender       sdk.AccAddress
 		wasmByteCode core.Base64Bytes
+		permission   *AccessConfig
 		expectPass   bool
 	}{
-		{addrs[0], []byte{}, false},
-		{sdk.AccAddress{}, []byte{1, 2, 3}, false},
-		{addrs[0], make([]byte, EnforcedMaxContractSize+1), false},
-		{addrs[0], []byte{1, 2, 3}, true},
+		{addrs[0], []byte{}, nil, false},
+		{sdk.AccAddress{}, []byte{1, 2, 3}, nil, false},
+		{addrs[0], make([]byte, EnforcedMaxContractSize+1), nil, false},
+		{addrs[0], []byte{1, 2, 3}, &emptyOnly, false},
+		{addrs[0], []byte{1, 2, 3}, &invalidType, false},
+		{addrs[0], []byte{1, 2, 3}, nil, true},
+		{addrs[0], []byte{1, 2, 3}, &onlySender, true},
 	}
 
 	for i, tc := range tests {
 		msg := NewMsgStoreCode(tc.sender, tc.wasmByteCode)
+		msg.InstantiatePermission = tc.permission
 		if tc.expectPass {
 			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
 		} else {
@@ -58,6 +68,43 @@ func TestMsgInstantiateCode(t *testing.T) {
 			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
 		}
 	}
+
+	msg := NewMsgInstantiateContract(addrs[0], 1, []byte{}, sdk.Coins{}, true)
+	msg.Label = "my contract"
+	require.NoError(t, msg.ValidateBasic())
+	msg.Label = strings.Repeat("a", MaxLabelSize+1)
+	require.Error(t, msg.ValidateBasic())
+	msg.Label = " my contract"
+	require.Error(t, msg.ValidateBasic())
+}
+
+func TestMsgInstantiateCode2(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+
+	tests := []struct {
+		creator    sdk.AccAddress
+		salt       core.Base64Bytes
+		expectPass bool
+	}{
+		{sdk.AccAddress{}, []byte("salt"), false},
+		{addrs[0], nil, false},
+		{addrs[0], make([]byte, MaxSaltSize+1), false},
+		{addrs[0], make([]byte, MaxSaltSize), true},
+		{addrs[0], []byte("salt"), true},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgInstantiateContract2(tc.creator, 1, []byte{}, sdk.Coins{}, true, tc.salt)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+
+	msg := NewMsgInstantiateContract2(addrs[0], 1, []byte{}, sdk.Coins{}, true, []byte("salt"))
+	msg.Label = strings.Repeat("a", MaxLabelSize+1)
+	require.Error(t, msg.ValidateBasic())
 }
 
 func TestMsgExecuteContract(t *testing.T) {
@@ -138,3 +185,26 @@ func TestMsgUpdateContractOwner(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgClearContractAdmin(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(2, sdk.Coins{})
+
+	tests := []struct {
+		owner      sdk.AccAddress
+		contract   sdk.AccAddress
+		expectPass bool
+	}{
+		{sdk.AccAddress{}, addrs[1], false},
+		{addrs[0], sdk.AccAddress{}, false},
+		{addrs[0], addrs[1], true},
+	}
+
+	for i, tc := range tests {
+		msg := NewMsgClearContractAdmin(tc.owner, tc.contract)
+		if tc.expectPass {
+			require.Nil(t, msg.ValidateBasic(), "test: %v", i)
+		} else {
+			require.NotNil(t, msg.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
@@ -16,6 +16,8 @@ const (
 	EnforcedMaxContractSize    = uint64(500 * 1024)  // 500KB
 	EnforcedMaxContractGas     = uint64(100_000_000) // 100,000,000
 	EnforcedMaxContractMsgSize = uint64(10 * 1024)   // 10KB
+
+	EnforcedMaxContractQueryDepth = uint64(20)
 )
 
 // Parameter keys
@@ -23,6 +25,8 @@ var (
 	ParamStoreKeyMaxContractSize    = []byte("maxcontractsize")
 	ParamStoreKeyMaxContractGas     = []byte("maxcontractgas")
 	ParamStoreKeyMaxContractMsgSize = []byte("maxcontractmsgsize")
+	ParamStoreKeyUploadAccess       = []byte("uploadaccess")
+	ParamStoreKeyMaxQueryDepth      = []byte("maxquerydepth")
 )
 
 // Default parameter values
@@ -30,6 +34,12 @@ const (
 	DefaultMaxContractSize    = EnforcedMaxContractSize // 500 KB
 	DefaultMaxContractGas     = EnforcedMaxContractGas  // 100,000,000
 	DefaultMaxContractMsgSize = uint64(1 * 1024)        // 1KB
+	DefaultMaxQueryDepth      = uint64(10)
+)
+
+// Default parameter values
+var (
+	DefaultUploadAccess = AllowEverybody
 )
 
 const (
@@ -44,9 +54,11 @@ var _ params.ParamSet = &Params{}
 
 // Params wasm parameters
 type Params struct {
-	MaxContractSize    uint64 `json:"max_contract_size" yaml:"max_contract_size"`         // allowed max contract bytes size
-	MaxContractGas     uint64 `json:"max_contract_gas" yaml:"max_contract_gas"`           // allowed max gas usages per each contract execution
-	MaxContractMsgSize uint64 `json:"max_contract_msg_size" yaml:"max_contract_msg_size"` // allowed max contract exe msg bytes size
+	MaxContractSize    uint64       `json:"max_contract_size" yaml:"max_contract_size"`         // allowed max contract bytes size
+	MaxContractGas     uint64       `json:"max_contract_gas" yaml:"max_contract_gas"`           // allowed max gas usages per each contract execution
+	MaxContractMsgSize uint64       `json:"max_contract_msg_size" yaml:"max_contract_msg_size"` // allowed max contract exe msg bytes size
+	UploadAccess       AccessConfig `json:"upload_access" yaml:"upload_access"`                 // who is allowed to upload contract codes
+	MaxQueryDepth      uint64       `json:"max_query_depth" yaml:"max_query_depth"`             // allowed max depth of nested contract queries
 }
 
 // DefaultParams creates default treasury module parameters
@@ -55,6 +67,8 @@ func DefaultParams() Params {
 		MaxContractSize:    DefaultMaxContractSize,
 		MaxContractGas:     DefaultMaxContractGas,
 		MaxContractMsgSize: DefaultMaxContractMsgSize,
+		UploadAccess:       DefaultUploadAccess,
+		MaxQueryDepth:      DefaultMaxQueryDepth,
 	}
 }
 
@@ -66,6 +80,8 @@ func (p *Params) ParamSetPairs() params.ParamSetPairs {
 		params.NewParamSetPair(ParamStoreKeyMaxContractSize, &p.MaxContractSize, validateMaxContractSize),
 		params.NewParamSetPair(ParamStoreKeyMaxContractGas, &p.MaxContractGas, validateMaxContractGas),
 		params.NewParamSetPair(ParamStoreKeyMaxContractMsgSize, &p.MaxContractMsgSize, validateMaxContractMsgSize),
+		params.NewParamSetPair(ParamStoreKeyUploadAccess, &p.UploadAccess, validateUploadAccess),
+		params.NewParamSetPair(ParamStoreKeyMaxQueryDepth, &p.MaxQueryDepth, validateMaxQueryDepth),
 	}
 }
 
@@ -94,6 +110,14 @@ func (p Params) Validate() error {
 		return fmt.Errorf("max contract msg byte size %d must be equal or smaller than %d", p.MaxContractMsgSize, EnforcedMaxContractMsgSize)
 	}
 
+	if err := p.UploadAccess.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid upload access: %s", err)
+	}
+
+	if err := validateMaxQueryDepth(p.MaxQueryDepth); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -135,3 +159,29 @@ func validateMaxContractMsgSize(i interface{}) error {
 
 	return nil
 }
+
+func validateUploadAccess(i interface{}) error {
+	v, ok := i.(AccessConfig)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return v.ValidateBasic()
+}
+
+func validateMaxQueryDepth(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max query depth must be positive")
+	}
+
+	if v > EnforcedMaxContractQueryDepth {
+		return fmt.Errorf("max query depth %d must be equal or smaller than %d", v, EnforcedMaxContractQueryDepth)
+	}
+
+	return nil
+}
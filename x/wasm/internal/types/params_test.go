@@ -21,4 +21,20 @@ func TestParams(t *testing.T) {
 	params = DefaultParams()
 	params.MaxContractSize = EnforcedMaxContractSize + 1
 	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.UploadAccess = OnlyAddresses()
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.UploadAccess = AllowNobody
+	require.NoError(t, params.Validate())
+
+	params = DefaultParams()
+	params.MaxQueryDepth = 0
+	require.Error(t, params.Validate())
+
+	params = DefaultParams()
+	params.MaxQueryDepth = EnforcedMaxContractQueryDepth + 1
+	require.Error(t, params.Validate())
 }
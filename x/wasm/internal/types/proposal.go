@@ -0,0 +1,271 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	core "github.com/terra-project/core/types"
+	"github.com/terra-project/core/x/gov"
+)
+
+const (
+	// ProposalTypeStoreCode defines the type for a StoreCodeProposal
+	ProposalTypeStoreCode = "StoreCode"
+
+	// ProposalTypeInstantiateContract defines the type for a InstantiateContractProposal
+	ProposalTypeInstantiateContract = "InstantiateContract"
+
+	// ProposalTypeMigrateContract defines the type for a MigrateContractProposal
+	ProposalTypeMigrateContract = "MigrateContract"
+
+	// ProposalTypeUpdateContractAdmin defines the type for a UpdateContractAdminProposal
+	ProposalTypeUpdateContractAdmin = "UpdateContractAdmin"
+)
+
+// Assert proposals implement govtypes.Content at compile-time
+var _ gov.Content = StoreCodeProposal{}
+var _ gov.Content = InstantiateContractProposal{}
+var _ gov.Content = MigrateContractProposal{}
+var _ gov.Content = UpdateContractAdminProposal{}
+
+func init() {
+	gov.RegisterProposalType(ProposalTypeStoreCode)
+	gov.RegisterProposalType(ProposalTypeInstantiateContract)
+	gov.RegisterProposalType(ProposalTypeMigrateContract)
+	gov.RegisterProposalType(ProposalTypeUpdateContractAdmin)
+}
+
+// StoreCodeProposal uploads a contract code on behalf of the creator,
+// regardless of the upload access param
+type StoreCodeProposal struct {
+	Title        string           `json:"title" yaml:"title"`             // Title of the Proposal
+	Description  string           `json:"description" yaml:"description"` // Description of the Proposal
+	Creator      sdk.AccAddress   `json:"creator" yaml:"creator"`         // Creator recorded in the code info
+	WASMByteCode core.Base64Bytes `json:"wasm_byte_code" yaml:"wasm_byte_code"`
+	// InstantiatePermission restricts who can instantiate the code; everybody when empty
+	InstantiatePermission *AccessConfig `json:"instantiate_permission,omitempty" yaml:"instantiate_permission,omitempty"`
+}
+
+// NewStoreCodeProposal creates a StoreCodeProposal.
+func NewStoreCodeProposal(title, description string, creator sdk.AccAddress, wasmByteCode []byte, instantiatePermission *AccessConfig) StoreCodeProposal {
+	return StoreCodeProposal{title, description, creator, wasmByteCode, instantiatePermission}
+}
+
+// GetTitle returns the title of a StoreCodeProposal.
+func (p StoreCodeProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a StoreCodeProposal.
+func (p StoreCodeProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a StoreCodeProposal.
+func (StoreCodeProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a StoreCodeProposal.
+func (p StoreCodeProposal) ProposalType() string { return ProposalTypeStoreCode }
+
+// ValidateBasic runs basic stateless validity checks
+func (p StoreCodeProposal) ValidateBasic() error {
+	err := gov.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	msg := NewMsgStoreCode(p.Creator, p.WASMByteCode)
+	msg.InstantiatePermission = p.InstantiatePermission
+	return msg.ValidateBasic()
+}
+
+// String implements the Stringer interface.
+func (p StoreCodeProposal) String() string {
+	permission := AllowEverybody
+	if p.InstantiatePermission != nil {
+		permission = *p.InstantiatePermission
+	}
+
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Store Code Proposal:
+  Title:                 %s
+  Description:           %s
+  Creator:               %s
+  WASMByteCode:          %d bytes
+  InstantiatePermission: %s %v
+`, p.Title, p.Description, p.Creator, len(p.WASMByteCode), permission.Permission, permission.Addresses))
+	return b.String()
+}
+
+// InstantiateContractProposal instantiates a contract on behalf of the owner,
+// regardless of the instantiate permission of the code
+type InstantiateContractProposal struct {
+	Title       string           `json:"title" yaml:"title"`             // Title of the Proposal
+	Description string           `json:"description" yaml:"description"` // Description of the Proposal
+	Owner       sdk.AccAddress   `json:"owner" yaml:"owner"`             // Owner of the contract, also pays the init coins
+	CodeID      uint64           `json:"code_id" yaml:"code_id"`
+	InitMsg     core.Base64Bytes `json:"init_msg" yaml:"init_msg"`
+	InitCoins   sdk.Coins        `json:"init_coins" yaml:"init_coins"`
+	Migratable  bool             `json:"migratable" yaml:"migratable"`
+	Label       string           `json:"label,omitempty" yaml:"label,omitempty"`
+}
+
+// NewInstantiateContractProposal creates an InstantiateContractProposal.
+func NewInstantiateContractProposal(title, description string, owner sdk.AccAddress, codeID uint64, initMsg []byte, initCoins sdk.Coins, migratable bool, label string) InstantiateContractProposal {
+	return InstantiateContractProposal{title, description, owner, codeID, initMsg, initCoins, migratable, label}
+}
+
+// GetTitle returns the title of an InstantiateContractProposal.
+func (p InstantiateContractProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an InstantiateContractProposal.
+func (p InstantiateContractProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an InstantiateContractProposal.
+func (InstantiateContractProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an InstantiateContractProposal.
+func (p InstantiateContractProposal) ProposalType() string { return ProposalTypeInstantiateContract }
+
+// ValidateBasic runs basic stateless validity checks
+func (p InstantiateContractProposal) ValidateBasic() error {
+	err := gov.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	msg := NewMsgInstantiateContract(p.Owner, p.CodeID, p.InitMsg, p.InitCoins, p.Migratable)
+	msg.Label = p.Label
+	return msg.ValidateBasic()
+}
+
+// String implements the Stringer interface.
+func (p InstantiateContractProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Instantiate Contract Proposal:
+  Title:       %s
+  Description: %s
+  Owner:       %s
+  CodeID:      %d
+  InitMsg:     %s
+  InitCoins:   %s
+  Migratable:  %v
+  Label:       %s
+`, p.Title, p.Description, p.Owner, p.CodeID, p.InitMsg, p.InitCoins, p.Migratable, p.Label))
+	return b.String()
+}
+
+// MigrateContractProposal migrates a contract to a new code, regardless of the contract owner
+type MigrateContractProposal struct {
+	Title       string           `json:"title" yaml:"title"`             // Title of the Proposal
+	Description string           `json:"description" yaml:"description"` // Description of the Proposal
+	Contract    sdk.AccAddress   `json:"contract" yaml:"contract"`
+	NewCodeID   uint64           `json:"new_code_id" yaml:"new_code_id"`
+	MigrateMsg  core.Base64Bytes `json:"migrate_msg" yaml:"migrate_msg"`
+}
+
+// NewMigrateContractProposal creates a MigrateContractProposal.
+func NewMigrateContractProposal(title, description string, contract sdk.AccAddress, newCodeID uint64, migrateMsg []byte) MigrateContractProposal {
+	return MigrateContractProposal{title, description, contract, newCodeID, migrateMsg}
+}
+
+// GetTitle returns the title of a MigrateContractProposal.
+func (p MigrateContractProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a MigrateContractProposal.
+func (p MigrateContractProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a MigrateContractProposal.
+func (MigrateContractProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a MigrateContractProposal.
+func (p MigrateContractProposal) ProposalType() string { return ProposalTypeMigrateContract }
+
+// ValidateBasic runs basic stateless validity checks
+func (p MigrateContractProposal) ValidateBasic() error {
+	err := gov.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.Contract.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty contract address")
+	}
+
+	if p.NewCodeID == 0 {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "missing new code id")
+	}
+
+	if uint64(len(p.MigrateMsg)) > EnforcedMaxContractMsgSize {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "wasm msg byte size is too huge")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p MigrateContractProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Migrate Contract Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  NewCodeID:   %d
+  MigrateMsg:  %s
+`, p.Title, p.Description, p.Contract, p.NewCodeID, p.MigrateMsg))
+	return b.String()
+}
+
+// UpdateContractAdminProposal sets a new owner of a contract, regardless of the current owner
+type UpdateContractAdminProposal struct {
+	Title       string         `json:"title" yaml:"title"`             // Title of the Proposal
+	Description string         `json:"description" yaml:"description"` // Description of the Proposal
+	Contract    sdk.AccAddress `json:"contract" yaml:"contract"`
+	NewAdmin    sdk.AccAddress `json:"new_admin" yaml:"new_admin"`
+}
+
+// NewUpdateContractAdminProposal creates an UpdateContractAdminProposal.
+func NewUpdateContractAdminProposal(title, description string, contract, newAdmin sdk.AccAddress) UpdateContractAdminProposal {
+	return UpdateContractAdminProposal{title, description, contract, newAdmin}
+}
+
+// GetTitle returns the title of an UpdateContractAdminProposal.
+func (p UpdateContractAdminProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of an UpdateContractAdminProposal.
+func (p UpdateContractAdminProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of an UpdateContractAdminProposal.
+func (UpdateContractAdminProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of an UpdateContractAdminProposal.
+func (p UpdateContractAdminProposal) ProposalType() string { return ProposalTypeUpdateContractAdmin }
+
+// ValidateBasic runs basic stateless validity checks
+func (p UpdateContractAdminProposal) ValidateBasic() error {
+	err := gov.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	if p.Contract.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty contract address")
+	}
+
+	if p.NewAdmin.Empty() {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "empty new admin")
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p UpdateContractAdminProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Contract Admin Proposal:
+  Title:       %s
+  Description: %s
+  Contract:    %s
+  NewAdmin:    %s
+`, p.Title, p.Description, p.Contract, p.NewAdmin))
+	return b.String()
+}
@@ -0,0 +1,132 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mock"
+)
+
+func TestStoreCodeProposal(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+
+	onlyCreator := OnlyAddresses(addrs[0])
+	emptyOnly := OnlyAddresses()
+
+	tests := []struct {
+		title        string
+		creator      sdk.AccAddress
+		wasmByteCode []byte
+		permission   *AccessConfig
+		expectPass   bool
+	}{
+		{"", addrs[0], []byte{1, 2, 3}, nil, false},
+		{"Test", sdk.AccAddress{}, []byte{1, 2, 3}, nil, false},
+		{"Test", addrs[0], []byte{}, nil, false},
+		{"Test", addrs[0], []byte{1, 2, 3}, &emptyOnly, false},
+		{"Test", addrs[0], []byte{1, 2, 3}, nil, true},
+		{"Test", addrs[0], []byte{1, 2, 3}, &onlyCreator, true},
+	}
+
+	for i, tc := range tests {
+		p := NewStoreCodeProposal(tc.title, "description", tc.creator, tc.wasmByteCode, tc.permission)
+		require.Equal(t, ProposalTypeStoreCode, p.ProposalType())
+		require.Equal(t, RouterKey, p.ProposalRoute())
+
+		if tc.expectPass {
+			require.NoError(t, p.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, p.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestInstantiateContractProposal(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+
+	tests := []struct {
+		title      string
+		owner      sdk.AccAddress
+		initMsg    []byte
+		initCoins  sdk.Coins
+		expectPass bool
+	}{
+		{"", addrs[0], []byte("{}"), sdk.Coins{}, false},
+		{"Test", sdk.AccAddress{}, []byte("{}"), sdk.Coins{}, false},
+		{"Test", addrs[0], make([]byte, EnforcedMaxContractMsgSize+1), sdk.Coins{}, false},
+		{"Test", addrs[0], []byte("{}"), sdk.Coins{{Amount: sdk.NewInt(1)}}, false},
+		{"Test", addrs[0], []byte("{}"), sdk.Coins{}, true},
+	}
+
+	for i, tc := range tests {
+		p := NewInstantiateContractProposal(tc.title, "description", tc.owner, 1, tc.initMsg, tc.initCoins, false, "")
+		require.Equal(t, ProposalTypeInstantiateContract, p.ProposalType())
+		require.Equal(t, RouterKey, p.ProposalRoute())
+
+		if tc.expectPass {
+			require.NoError(t, p.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, p.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestMigrateContractProposal(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(1, sdk.Coins{})
+
+	tests := []struct {
+		title      string
+		contract   sdk.AccAddress
+		newCodeID  uint64
+		migrateMsg []byte
+		expectPass bool
+	}{
+		{"", addrs[0], 1, []byte("{}"), false},
+		{"Test", sdk.AccAddress{}, 1, []byte("{}"), false},
+		{"Test", addrs[0], 0, []byte("{}"), false},
+		{"Test", addrs[0], 1, make([]byte, EnforcedMaxContractMsgSize+1), false},
+		{"Test", addrs[0], 1, []byte("{}"), true},
+	}
+
+	for i, tc := range tests {
+		p := NewMigrateContractProposal(tc.title, "description", tc.contract, tc.newCodeID, tc.migrateMsg)
+		require.Equal(t, ProposalTypeMigrateContract, p.ProposalType())
+		require.Equal(t, RouterKey, p.ProposalRoute())
+
+		if tc.expectPass {
+			require.NoError(t, p.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, p.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
+
+func TestUpdateContractAdminProposal(t *testing.T) {
+	_, addrs, _, _ := mock.CreateGenAccounts(2, sdk.Coins{})
+
+	tests := []struct {
+		title      string
+		contract   sdk.AccAddress
+		newAdmin   sdk.AccAddress
+		expectPass bool
+	}{
+		{"", addrs[0], addrs[1], false},
+		{"Test", sdk.AccAddress{}, addrs[1], false},
+		{"Test", addrs[0], sdk.AccAddress{}, false},
+		{"Test", addrs[0], addrs[1], true},
+	}
+
+	for i, tc := range tests {
+		p := NewUpdateContractAdminProposal(tc.title, "description", tc.contract, tc.newAdmin)
+		require.Equal(t, ProposalTypeUpdateContractAdmin, p.ProposalType())
+		require.Equal(t, RouterKey, p.ProposalRoute())
+
+		if tc.expectPass {
+			require.NoError(t, p.ValidateBasic(), "test: %v", i)
+		} else {
+			require.Error(t, p.ValidateBasic(), "test: %v", i)
+		}
+	}
+}
@@ -1,17 +1,35 @@
 package types
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	core "github.com/terra-project/core/types"
+)
 
 // query endpoints supported by the wasm Querier
 const (
-	QueryGetByteCode     = "bytecode"
-	QueryGetCodeInfo     = "codeInfo"
-	QueryGetContractInfo = "contractInfo"
-	QueryRawStore        = "rawStore"
-	QueryContractStore   = "contractStore"
-	QueryParameters      = "parameters"
+	QueryGetByteCode       = "bytecode"
+	QueryGetCodeInfo       = "codeInfo"
+	QueryGetContractInfo   = "contractInfo"
+	QueryRawStore          = "rawStore"
+	QueryContractStore     = "contractStore"
+	QueryParameters        = "parameters"
+	QueryContractHistory   = "contractHistory"
+	QueryContractsByCode   = "contractsByCode"
+	QueryContractsByOwner  = "contractsByOwner"
+	QueryContractStoreList = "contractStoreList"
+	QueryPredictAddress    = "predictAddress"
 )
 
+// DefaultContractsQueryLimit is the page size used when the contract list queries omit a limit
+const DefaultContractsQueryLimit = 100
+
+// DefaultContractStoreListLimit is the number of models returned when the store list query omits a limit
+const DefaultContractStoreListLimit = 100
+
+// MaxContractStoreListLimit is the maximum number of models returned by a single store list query
+const MaxContractStoreListLimit = 1000
+
 // QueryCodeIDParams defines the params for the following queries:
 // - 'custom/wasm/codeInfo
 // - 'custom/wasm/bytecode
@@ -26,6 +44,7 @@ func NewQueryCodeIDParams(codeID uint64) QueryCodeIDParams {
 
 // QueryContractAddressParams defines the params for the following queries:
 // - 'custom/wasm/contractInfo
+// - 'custom/wasm/contractHistory
 type QueryContractAddressParams struct {
 	ContractAddress sdk.AccAddress
 }
@@ -58,3 +77,67 @@ type QueryContractParams struct {
 func NewQueryContractParams(contractAddress sdk.AccAddress, msg []byte) QueryContractParams {
 	return QueryContractParams{contractAddress, msg}
 }
+
+// QueryContractsByCodeParams defines the params for the following queries:
+// - 'custom/wasm/contractsByCode'
+type QueryContractsByCodeParams struct {
+	CodeID uint64
+	Page   int
+	Limit  int
+}
+
+// NewQueryContractsByCodeParams returns QueryContractsByCodeParams instance
+func NewQueryContractsByCodeParams(codeID uint64, page, limit int) QueryContractsByCodeParams {
+	return QueryContractsByCodeParams{codeID, page, limit}
+}
+
+// QueryContractsByOwnerParams defines the params for the following queries:
+// - 'custom/wasm/contractsByOwner'
+type QueryContractsByOwnerParams struct {
+	Owner sdk.AccAddress
+	Page  int
+	Limit int
+}
+
+// NewQueryContractsByOwnerParams returns QueryContractsByOwnerParams instance
+func NewQueryContractsByOwnerParams(owner sdk.AccAddress, page, limit int) QueryContractsByOwnerParams {
+	return QueryContractsByOwnerParams{owner, page, limit}
+}
+
+// QueryContractStoreListParams defines the params for the following queries:
+// - 'custom/wasm/contractStoreList'
+//
+// The models are listed in the ascending key order within [StartKey, EndKey)
+// and only the keys starting with Prefix are returned. Empty bounds are open.
+type QueryContractStoreListParams struct {
+	ContractAddress sdk.AccAddress
+	Prefix          []byte
+	StartKey        []byte
+	EndKey          []byte
+	Limit           int
+}
+
+// NewQueryContractStoreListParams returns QueryContractStoreListParams instance
+func NewQueryContractStoreListParams(contractAddress sdk.AccAddress, prefix, startKey, endKey []byte, limit int) QueryContractStoreListParams {
+	return QueryContractStoreListParams{contractAddress, prefix, startKey, endKey, limit}
+}
+
+// ContractStoreListResponse is the response of the contract store list query
+type ContractStoreListResponse struct {
+	Models []Model `json:"models"`
+	// NextKey is the start key of the next page, empty when there are no more models
+	NextKey core.Base64Bytes `json:"next_key,omitempty"`
+}
+
+// QueryPredictAddressParams defines the params for the following queries:
+// - 'custom/wasm/predictAddress'
+type QueryPredictAddressParams struct {
+	Creator sdk.AccAddress
+	CodeID  uint64
+	Salt    []byte
+}
+
+// NewQueryPredictAddressParams returns QueryPredictAddressParams instance
+func NewQueryPredictAddressParams(creator sdk.AccAddress, codeID uint64, salt []byte) QueryPredictAddressParams {
+	return QueryPredictAddressParams{creator, codeID, salt}
+}
@@ -34,18 +34,24 @@ func NewModuleQuerier() Querier {
 type WasmCustomQuery struct {
 	Route     string          `json:"route"`
 	QueryData json.RawMessage `json:"query_data"`
+	// GasLimit optionally caps the sdk gas the sub-query can spend; running out of it
+	// returns ErrQueryGasCapExceeded to the contract instead of aborting the execution
+	GasLimit uint64 `json:"gas_limit,omitempty"`
 }
 
 var _ wasmTypes.Querier = Querier{}
 
 // Routes of pre-determined wasm querier
 const (
-	WasmQueryRouteBank     = "bank"
-	WasmQueryRouteStaking  = "staking"
-	WasmQueryRouteMarket   = "market"
-	WasmQueryRouteOracle   = "oracle"
-	WasmQueryRouteTreasury = "treasury"
-	WasmQueryRouteWasm     = "wasm"
+	WasmQueryRouteBank         = "bank"
+	WasmQueryRouteStaking      = "staking"
+	WasmQueryRouteMarket       = "market"
+	WasmQueryRouteOracle       = "oracle"
+	WasmQueryRouteTreasury     = "treasury"
+	WasmQueryRouteWasm         = "wasm"
+	WasmQueryRouteDistribution = "distribution"
+	WasmQueryRouteGov          = "gov"
+	WasmQueryRouteSlashing     = "slashing"
 )
 
 // WithCtx returns new querier with context
@@ -60,16 +66,58 @@ func (q Querier) GasConsumed() uint64 {
 }
 
 // Query - interface for wasmTypes.Querier
-func (q Querier) Query(request wasmTypes.QueryRequest, gasLimit uint64) ([]byte, error) {
-	// set a limit for a ctx
+func (q Querier) Query(request wasmTypes.QueryRequest, gasLimit uint64) (res []byte, err error) {
 	// gasLimit passed from the go-cosmwasm part, so need to divide it with gas multiplier
-	ctx := q.Ctx.WithGasMeter(sdk.NewGasMeter(gasLimit / GasMultiplier))
+	gasLimit /= GasMultiplier
+
+	var customQuery WasmCustomQuery
+	if request.Custom != nil {
+		if err := json.Unmarshal(request.Custom, &customQuery); err != nil {
+			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+	}
+
+	// apply the gas cap requested by the contract
+	gasCapped := customQuery.GasLimit != 0 && customQuery.GasLimit < gasLimit &&
+		core.IsFeatureActive(q.Ctx, core.FeatureWasmQueryLimits)
+	if gasCapped {
+		gasLimit = customQuery.GasLimit
+	}
 
-	// make sure we charge the higher level context even on panic
+	// set a limit for a ctx
+	ctx := q.Ctx.WithGasMeter(sdk.NewGasMeter(gasLimit))
+
+	// make sure we charge the higher level context even on panic; the capped
+	// sub-query is charged no more than its cap, even when it runs out of gas
 	defer func() {
-		q.Ctx.GasMeter().ConsumeGas(ctx.GasMeter().GasConsumed(), "contract sub-query")
+		gasUsed := ctx.GasMeter().GasConsumed()
+		if gasCapped {
+			gasUsed = ctx.GasMeter().GasConsumedToLimit()
+		}
+
+		q.Ctx.GasMeter().ConsumeGas(gasUsed, GasDescSubQuery)
 	}()
 
+	// running out of the capped gas is returned to the contract, which can handle it;
+	// recovered before charging the higher level context, which still panics when out of gas
+	if gasCapped {
+		defer func() {
+			if r := recover(); r != nil {
+				if _, ok := r.(sdk.ErrorOutOfGas); !ok {
+					panic(r)
+				}
+
+				res, err = nil, sdkerrors.Wrapf(ErrQueryGasCapExceeded, "gas limit %d", gasLimit)
+			}
+		}()
+	}
+
+	// the sub-query is recorded as a whole when the gas is charged above
+	if trace := GetGasTrace(q.Ctx); trace != nil {
+		trace.BeginScope(GasTraceCategoryQuery)
+		defer trace.EndScope()
+	}
+
 	// do the query
 
 	switch {
@@ -80,12 +128,6 @@ func (q Querier) Query(request wasmTypes.QueryRequest, gasLimit uint64) ([]byte,
 
 		return nil, sdkerrors.Wrap(ErrNoRegisteredQuerier, WasmQueryRouteBank)
 	case request.Custom != nil:
-		var customQuery WasmCustomQuery
-		err := json.Unmarshal(request.Custom, &customQuery)
-		if err != nil {
-			return nil, sdkerrors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
-		}
-
 		if querier, ok := q.Queriers[customQuery.Route]; ok {
 			return querier.QueryCustom(ctx, customQuery.QueryData)
 		}
@@ -112,3 +154,16 @@ func (q Querier) Query(request wasmTypes.QueryRequest, gasLimit uint64) ([]byte,
 
 	return nil, wasmTypes.Unknown{}
 }
+
+type queryDepthKey struct{}
+
+// WithQueryDepth returns a context carrying the depth of the nested contract queries
+func WithQueryDepth(ctx sdk.Context, depth uint64) sdk.Context {
+	return ctx.WithValue(queryDepthKey{}, depth)
+}
+
+// GetQueryDepth returns the depth of the nested contract queries, 0 outside of any contract query
+func GetQueryDepth(ctx sdk.Context) uint64 {
+	depth, _ := ctx.Value(queryDepthKey{}).(uint64)
+	return depth
+}
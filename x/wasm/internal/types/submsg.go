@@ -0,0 +1,146 @@
+package types
+
+import (
+	"encoding/json"
+
+	wasmTypes "github.com/CosmWasm/go-cosmwasm/types"
+
+	"github.com/tendermint/tendermint/crypto"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// WasmCustomMsgRouteSubMsg is the custom msg route which wraps
+// a CosmosMsg into a submessage
+const WasmCustomMsgRouteSubMsg = "sub_msg"
+
+// ReplyOn defines when the contract is called back with the submessage result
+type ReplyOn string
+
+// Reply policies of submessage
+const (
+	ReplyAlways  ReplyOn = "always"
+	ReplyError   ReplyOn = "error"
+	ReplySuccess ReplyOn = "success"
+)
+
+// SubMsg wraps a CosmosMsg with an ID and a reply policy. A contract dispatches
+// a submessage as a custom msg with the WasmCustomMsgRouteSubMsg route, e.g.
+//
+//	{"custom": {"route": "sub_msg", "msg_data": {"id": 1, "msg": {...}, "reply_on": "error"}}}
+//
+// The submessage is executed in a cached context, which is committed only when it succeeds.
+type SubMsg struct {
+	ID      uint64              `json:"id"`
+	Msg     wasmTypes.CosmosMsg `json:"msg"`
+	ReplyOn ReplyOn             `json:"reply_on"`
+}
+
+// ShouldReply returns whether the contract is called back for the execution result
+func (subMsg SubMsg) ShouldReply(success bool) bool {
+	switch subMsg.ReplyOn {
+	case ReplyAlways:
+		return true
+	case ReplyError:
+		return !success
+	case ReplySuccess:
+		return success
+	}
+
+	return false
+}
+
+// ParseSubMsg returns the submessage when the given msg is a custom msg with
+// the submessage route; otherwise it returns false
+func ParseSubMsg(msg wasmTypes.CosmosMsg) (subMsg SubMsg, ok bool, err error) {
+	if msg.Custom == nil {
+		return subMsg, false, nil
+	}
+
+	// leave the invalid custom msg for the msg parser
+	var customMsg WasmCustomMsg
+	if err := json.Unmarshal(msg.Custom, &customMsg); err != nil || customMsg.Route != WasmCustomMsgRouteSubMsg {
+		return subMsg, false, nil
+	}
+
+	if err := json.Unmarshal(customMsg.MsgData, &subMsg); err != nil {
+		return subMsg, false, sdkerrors.Wrap(ErrInvalidMsg, err.Error())
+	}
+
+	switch subMsg.ReplyOn {
+	case ReplyAlways, ReplyError, ReplySuccess:
+	default:
+		return subMsg, false, sdkerrors.Wrapf(ErrInvalidMsg, "invalid reply_on: %s", subMsg.ReplyOn)
+	}
+
+	return subMsg, true, nil
+}
+
+// ReplySender is the sender of the replies; as no account holds its key, the contract
+// tells a reply from a self-call or a call by another account by the sender
+var ReplySender = sdk.AccAddress(crypto.AddressHash([]byte("wasm/reply")))
+
+// ReplyMsg is the handle msg delivered to the contract with the submessage result,
+// sent by ReplySender without coins. The reply to a successful submessage holds the
+// events it emitted,
+//
+//	{"reply": {"id": 1, "result": {"ok": {"events": [{"type": "transfer", "attributes": [{"key": "amount", "value": "1000uluna"}]}]}}}}
+//
+// and the reply to a failed submessage holds the codespace and the code of its error,
+//
+//	{"reply": {"id": 1, "result": {"error": {"codespace": "sdk", "code": 5}}}}
+type ReplyMsg struct {
+	Reply Reply `json:"reply"`
+}
+
+// Reply holds the submessage ID and its execution result
+type Reply struct {
+	ID     uint64       `json:"id"`
+	Result SubMsgResult `json:"result"`
+}
+
+// SubMsgResult is either the events emitted by the submessage or its error
+type SubMsgResult struct {
+	Ok  *SubMsgExecutionResponse `json:"ok,omitempty"`
+	Err *SubMsgError             `json:"error,omitempty"`
+}
+
+// SubMsgError is the error of the failed submessage; only the codespace and the code are
+// delivered, as the error message is not deterministic. The errors not registered with
+// a code have the codespace "undefined" and the code 1
+type SubMsgError struct {
+	Codespace string `json:"codespace"`
+	Code      uint32 `json:"code"`
+}
+
+// SubMsgExecutionResponse holds the events emitted by the successful submessage
+type SubMsgExecutionResponse struct {
+	Events []ReplyEvent `json:"events"`
+}
+
+// ReplyEvent is an event delivered to the contract
+type ReplyEvent struct {
+	Type       string                   `json:"type"`
+	Attributes []wasmTypes.LogAttribute `json:"attributes"`
+}
+
+// NewReplyMsg returns the reply msg built from the submessage result
+func NewReplyMsg(id uint64, events sdk.Events, err error) ReplyMsg {
+	if err != nil {
+		codespace, code, _ := sdkerrors.ABCIInfo(err, false)
+		return ReplyMsg{Reply: Reply{ID: id, Result: SubMsgResult{Err: &SubMsgError{Codespace: codespace, Code: code}}}}
+	}
+
+	replyEvents := make([]ReplyEvent, len(events))
+	for i, event := range events {
+		attrs := make([]wasmTypes.LogAttribute, len(event.Attributes))
+		for j, attr := range event.Attributes {
+			attrs[j] = wasmTypes.LogAttribute{Key: string(attr.Key), Value: string(attr.Value)}
+		}
+
+		replyEvents[i] = ReplyEvent{Type: event.Type, Attributes: attrs}
+	}
+
+	return ReplyMsg{Reply: Reply{ID: id, Result: SubMsgResult{Ok: &SubMsgExecutionResponse{Events: replyEvents}}}}
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	wasmTypes "github.com/CosmWasm/go-cosmwasm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func TestParseSubMsg(t *testing.T) {
+	bankMsg := wasmTypes.CosmosMsg{Bank: &wasmTypes.BankMsg{Send: &wasmTypes.SendMsg{}}}
+
+	// not custom msg
+	_, ok, err := ParseSubMsg(bankMsg)
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// custom msg of other route
+	_, ok, err = ParseSubMsg(wasmTypes.CosmosMsg{Custom: []byte(`{"route":"market","msg_data":{}}`)})
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	subMsgBz, err := json.Marshal(SubMsg{ID: 1, Msg: bankMsg, ReplyOn: ReplyError})
+	require.NoError(t, err)
+	customMsg, err := json.Marshal(WasmCustomMsg{Route: WasmCustomMsgRouteSubMsg, MsgData: subMsgBz})
+	require.NoError(t, err)
+
+	subMsg, ok, err := ParseSubMsg(wasmTypes.CosmosMsg{Custom: customMsg})
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, SubMsg{ID: 1, Msg: bankMsg, ReplyOn: ReplyError}, subMsg)
+
+	// invalid reply policy
+	_, _, err = ParseSubMsg(wasmTypes.CosmosMsg{Custom: []byte(`{"route":"sub_msg","msg_data":{"id":1,"msg":{},"reply_on":"never"}}`)})
+	require.Error(t, err)
+}
+
+func TestSubMsgShouldReply(t *testing.T) {
+	require.True(t, SubMsg{ReplyOn: ReplyAlways}.ShouldReply(true))
+	require.True(t, SubMsg{ReplyOn: ReplyAlways}.ShouldReply(false))
+	require.False(t, SubMsg{ReplyOn: ReplyError}.ShouldReply(true))
+	require.True(t, SubMsg{ReplyOn: ReplyError}.ShouldReply(false))
+	require.True(t, SubMsg{ReplyOn: ReplySuccess}.ShouldReply(true))
+	require.False(t, SubMsg{ReplyOn: ReplySuccess}.ShouldReply(false))
+}
+
+func TestNewReplyMsg(t *testing.T) {
+	events := sdk.Events{sdk.NewEvent("transfer", sdk.NewAttribute("amount", "1000uluna"))}
+
+	bz, err := json.Marshal(NewReplyMsg(1, events, nil))
+	require.NoError(t, err)
+	require.Equal(t, `{"reply":{"id":1,"result":{"ok":{"events":[{"type":"transfer","attributes":[{"key":"amount","value":"1000uluna"}]}]}}}}`, string(bz))
+
+	// only the codespace and the code of the error are delivered
+	bz, err = json.Marshal(NewReplyMsg(2, nil, sdkerrors.Wrap(sdkerrors.ErrInsufficientFunds, "1000uluna < 2000uluna")))
+	require.NoError(t, err)
+	require.Equal(t, `{"reply":{"id":2,"result":{"error":{"codespace":"sdk","code":5}}}}`, string(bz))
+
+	bz, err = json.Marshal(NewReplyMsg(3, nil, errors.New("insufficient funds")))
+	require.NoError(t, err)
+	require.Equal(t, `{"reply":{"id":3,"result":{"error":{"codespace":"undefined","code":1}}}}`, string(bz))
+}
@@ -17,15 +17,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	sim "github.com/cosmos/cosmos-sdk/x/simulation"
 
+	"github.com/terra-project/core/x/upgrade"
 	"github.com/terra-project/core/x/wasm/client/cli"
 	"github.com/terra-project/core/x/wasm/client/rest"
 	"github.com/terra-project/core/x/wasm/simulation"
 )
 
 var (
-	_ module.AppModule           = AppModule{}
-	_ module.AppModuleBasic      = AppModuleBasic{}
-	_ module.AppModuleSimulation = AppModule{}
+	_ module.AppModule            = AppModule{}
+	_ module.AppModuleBasic       = AppModuleBasic{}
+	_ module.AppModuleSimulation  = AppModule{}
+	_ upgrade.HasConsensusVersion = AppModule{}
+	_ upgrade.HasMigrations       = AppModule{}
 )
 
 // AppModuleBasic defines the basic application module used by the wasm module.
@@ -98,7 +101,9 @@ func (AppModule) Name() string {
 }
 
 // RegisterInvariants registers the wasm module invariants.
-func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	RegisterInvariants(ir, am.keeper)
+}
 
 // Route returns the message routing key for the wasm module.
 func (AppModule) Route() string {
@@ -147,6 +152,15 @@ func (AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.Validato
 	return []abci.ValidatorUpdate{}
 }
 
+// ConsensusVersion returns the consensus version of the wasm module's store.
+// Version 2 adds the instantiate permissions and the contract indexes.
+func (AppModule) ConsensusVersion() uint64 { return 2 }
+
+// RegisterMigrations registers the in-place store migrations of the wasm module.
+func (am AppModule) RegisterMigrations(registry upgrade.MigrationRegistry) error {
+	return registry.RegisterMigration(ModuleName, 1, NewMigrator(am.keeper).Migrate1to2)
+}
+
 //____________________________________________________________________________
 
 // AppModuleSimulation functions
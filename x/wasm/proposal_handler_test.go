@@ -0,0 +1,172 @@
+package wasm
+
+import (
+	"encoding/json"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	core "github.com/terra-project/core/types"
+)
+
+func TestStoreCodeProposalHandler(t *testing.T) {
+	loadContracts()
+
+	data, cleanup := setupTest(t)
+	defer cleanup()
+
+	params := data.keeper.GetParams(data.ctx)
+	params.UploadAccess = AllowNobody
+	data.keeper.SetParams(data.ctx, params)
+
+	// direct upload is rejected
+	h := data.module.NewHandler()
+	_, err := h(data.ctx, NewMsgStoreCode(addr1, testContract))
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err), err)
+
+	permission := OnlyAddresses(addr1)
+	p := NewStoreCodeProposal("Test", "description", addr1, testContract, &permission)
+	require.NoError(t, p.ValidateBasic())
+
+	hdlr := NewWasmProposalHandler(data.keeper)
+	require.NoError(t, hdlr(data.ctx, p))
+
+	codeInfo, err := data.keeper.GetCodeInfo(data.ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, addr1, codeInfo.Creator)
+	require.Equal(t, permission, codeInfo.InstantiatePermission)
+}
+
+func TestInstantiateContractProposalHandler(t *testing.T) {
+	loadContracts()
+
+	data, cleanup := setupTest(t)
+	defer cleanup()
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	creator := createFakeFundedAccount(data.ctx, data.acctKeeper, deposit)
+	owner := createFakeFundedAccount(data.ctx, data.acctKeeper, deposit)
+
+	nobody := AllowNobody
+	h := data.module.NewHandler()
+	msg := NewMsgStoreCode(creator, testContract)
+	msg.InstantiatePermission = &nobody
+	_, err := h(data.ctx, msg)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+	initMsgBz, err := json.Marshal(initMsg{
+		Verifier:    fred.String(),
+		Beneficiary: bob.String(),
+	})
+	require.NoError(t, err)
+
+	// direct instantiate is rejected
+	_, err = h(data.ctx, NewMsgInstantiateContract(creator, 1, initMsgBz, nil, false))
+	require.True(t, sdkerrors.ErrUnauthorized.Is(err), err)
+
+	p := NewInstantiateContractProposal("Test", "description", owner, 1, initMsgBz, deposit, false, "token")
+	require.NoError(t, p.ValidateBasic())
+
+	hdlr := NewWasmProposalHandler(data.keeper)
+	require.NoError(t, hdlr(data.ctx, p))
+
+	var contracts []ContractInfo
+	data.keeper.IterateContractInfo(data.ctx, func(info ContractInfo) bool {
+		contracts = append(contracts, info)
+		return false
+	})
+	require.Len(t, contracts, 1)
+	require.Equal(t, owner, contracts[0].Owner)
+	require.Equal(t, "token", contracts[0].Label)
+	require.Equal(t, deposit, data.acctKeeper.GetAccount(data.ctx, contracts[0].Address).GetCoins())
+}
+
+func TestMigrateContractProposalHandler(t *testing.T) {
+	loadContracts()
+
+	data, cleanup := setupTest(t)
+	defer cleanup()
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	creator := createFakeFundedAccount(data.ctx, data.acctKeeper, deposit)
+
+	_, err := data.keeper.StoreCode(data.ctx, creator, testContract)
+	require.NoError(t, err)
+	newCodeID, err := data.keeper.StoreCode(data.ctx, creator, testContract)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+	initMsgBz, err := json.Marshal(initMsg{
+		Verifier:    fred.String(),
+		Beneficiary: bob.String(),
+	})
+	require.NoError(t, err)
+
+	contractAddr, err := data.keeper.InstantiateContract(data.ctx, 1, creator, initMsgBz, nil, true, "")
+	require.NoError(t, err)
+	notMigratableAddr, err := data.keeper.InstantiateContract(data.ctx, 1, creator, initMsgBz, nil, false, "")
+	require.NoError(t, err)
+
+	migMsgBz, err := json.Marshal(map[string]string{"verifier": bob.String()})
+	require.NoError(t, err)
+
+	hdlr := NewWasmProposalHandler(data.keeper)
+
+	// the owner key is not required
+	p := NewMigrateContractProposal("Test", "description", contractAddr, newCodeID, migMsgBz)
+	require.NoError(t, p.ValidateBasic())
+	require.NoError(t, hdlr(data.ctx, p))
+
+	cInfo, err := data.keeper.GetContractInfo(data.ctx, contractAddr)
+	require.NoError(t, err)
+	require.Equal(t, newCodeID, cInfo.CodeID)
+	require.Equal(t, creator, cInfo.Owner)
+
+	// not migratable contract cannot be migrated even by governance
+	p = NewMigrateContractProposal("Test", "description", notMigratableAddr, newCodeID, migMsgBz)
+	require.Error(t, hdlr(data.ctx, p))
+}
+
+func TestUpdateContractAdminProposalHandler(t *testing.T) {
+	loadContracts()
+
+	data, cleanup := setupTest(t)
+	defer cleanup()
+
+	deposit := sdk.NewCoins(sdk.NewInt64Coin(core.MicroLunaDenom, 100000))
+	creator := createFakeFundedAccount(data.ctx, data.acctKeeper, deposit)
+	_, _, newAdmin := keyPubAddr()
+
+	codeID, err := data.keeper.StoreCode(data.ctx, creator, testContract)
+	require.NoError(t, err)
+
+	_, _, bob := keyPubAddr()
+	_, _, fred := keyPubAddr()
+	initMsgBz, err := json.Marshal(initMsg{
+		Verifier:    fred.String(),
+		Beneficiary: bob.String(),
+	})
+	require.NoError(t, err)
+
+	contractAddr, err := data.keeper.InstantiateContract(data.ctx, codeID, creator, initMsgBz, nil, true, "")
+	require.NoError(t, err)
+
+	hdlr := NewWasmProposalHandler(data.keeper)
+
+	p := NewUpdateContractAdminProposal("Test", "description", contractAddr, newAdmin)
+	require.NoError(t, p.ValidateBasic())
+	require.NoError(t, hdlr(data.ctx, p))
+
+	cInfo, err := data.keeper.GetContractInfo(data.ctx, contractAddr)
+	require.NoError(t, err)
+	require.Equal(t, newAdmin, cInfo.Owner)
+
+	// cleared contract stays immutable
+	require.NoError(t, data.keeper.ClearContractAdmin(data.ctx, contractAddr, newAdmin))
+	require.Error(t, hdlr(data.ctx, p))
+}
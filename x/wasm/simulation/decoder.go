@@ -38,6 +38,14 @@ func DecodeStore(cdc *codec.Codec, kvA, kvB tmkv.Pair) string {
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &rawDataA)
 		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &rawDataB)
 		return fmt.Sprintf("%v\n%v", rawDataA, rawDataB)
+	case bytes.Equal(kvA.Key[:1], types.ContractHistoryKey):
+		var entryA, entryB types.ContractHistoryEntry
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvA.Value, &entryA)
+		cdc.MustUnmarshalBinaryLengthPrefixed(kvB.Value, &entryB)
+		return fmt.Sprintf("%v\n%v", entryA, entryB)
+	case bytes.Equal(kvA.Key[:1], types.ContractByCodeIndexKey),
+		bytes.Equal(kvA.Key[:1], types.ContractByOwnerIndexKey):
+		return fmt.Sprintf("%X\n%X", kvA.Key[1:], kvB.Key[1:])
 	default:
 		panic(fmt.Sprintf("invalid wasm key prefix %X", kvA.Key[:1]))
 	}
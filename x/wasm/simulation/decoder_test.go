@@ -39,9 +39,10 @@ func TestDecodeDistributionStore(t *testing.T) {
 	binary.LittleEndian.PutUint64(lastCodeIDbz, 123)
 	binary.LittleEndian.PutUint64(lastInstanceIDbz, 456)
 
-	codeInfo := types.NewCodeInfo(1, []byte{1, 2, 3}, creatorAddr)
-	contractInfo := types.NewContractInfo(1, contractAddr, creatorAddr, []byte{4, 5, 6}, true)
+	codeInfo := types.NewCodeInfo(1, []byte{1, 2, 3}, creatorAddr, types.AllowEverybody)
+	contractInfo := types.NewContractInfo(1, contractAddr, creatorAddr, []byte{4, 5, 6}, true, "")
 	contractStore := []byte{7, 8, 9}
+	historyEntry := types.NewContractHistoryEntry(types.ContractHistoryOperationInit, 1, 10, []byte{4, 5, 6})
 
 	kvPairs := tmkv.Pairs{
 		tmkv.Pair{Key: types.LastCodeIDKey, Value: lastCodeIDbz},
@@ -49,6 +50,8 @@ func TestDecodeDistributionStore(t *testing.T) {
 		tmkv.Pair{Key: types.CodeKey, Value: cdc.MustMarshalBinaryLengthPrefixed(codeInfo)},
 		tmkv.Pair{Key: types.ContractInfoKey, Value: cdc.MustMarshalBinaryLengthPrefixed(contractInfo)},
 		tmkv.Pair{Key: types.ContractStoreKey, Value: cdc.MustMarshalBinaryLengthPrefixed(contractStore)},
+		tmkv.Pair{Key: types.GetContractHistoryKey(contractAddr, 0), Value: cdc.MustMarshalBinaryLengthPrefixed(historyEntry)},
+		tmkv.Pair{Key: types.GetContractByCodeIndexKey(1, contractAddr), Value: []byte{1}},
 		tmkv.Pair{Key: []byte{0x99}, Value: []byte{0x99}},
 	}
 
@@ -61,6 +64,8 @@ func TestDecodeDistributionStore(t *testing.T) {
 		{"CodeInfo", fmt.Sprintf("%v\n%v", codeInfo, codeInfo)},
 		{"ContractInfo", fmt.Sprintf("%v\n%v", contractInfo, contractInfo)},
 		{"ContractStore", fmt.Sprintf("%v\n%v", contractStore, contractStore)},
+		{"ContractHistory", fmt.Sprintf("%v\n%v", historyEntry, historyEntry)},
+		{"ContractByCodeIndex", fmt.Sprintf("%X\n%X", types.GetContractByCodeIndexKey(1, contractAddr)[1:], types.GetContractByCodeIndexKey(1, contractAddr)[1:])},
 		{"other", ""},
 	}
 
@@ -17,6 +17,7 @@ const (
 	maxContractGasKey     = "max_contract_gas"
 	maxContractMsgSizeKey = "max_contract_msg_size"
 	gasMultiplierKey      = "gas_multiplier"
+	maxQueryDepthKey      = "max_query_depth"
 )
 
 // GenMaxContractSize randomized MaxContractSize
@@ -39,6 +40,11 @@ func GenGasMultiplier(r *rand.Rand) uint64 {
 	return uint64(1 + r.Intn(99))
 }
 
+// GenMaxQueryDepth randomized MaxQueryDepth
+func GenMaxQueryDepth(r *rand.Rand) uint64 {
+	return uint64(1 + r.Intn(int(types.EnforcedMaxContractQueryDepth)))
+}
+
 // RandomizedGenState generates a random GenesisState for wasm
 func RandomizedGenState(simState *module.SimulationState) {
 
@@ -66,11 +72,19 @@ func RandomizedGenState(simState *module.SimulationState) {
 		func(r *rand.Rand) { gasMultiplier = GenGasMultiplier(r) },
 	)
 
+	var maxQueryDepth uint64
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, maxQueryDepthKey, &maxQueryDepth, simState.Rand,
+		func(r *rand.Rand) { maxQueryDepth = GenMaxQueryDepth(r) },
+	)
+
 	wasmGenesis := types.NewGenesisState(
 		types.Params{
 			MaxContractSize:    maxContractSize,
 			MaxContractGas:     maxContractGas,
 			MaxContractMsgSize: maxContractMsgSize,
+			UploadAccess:       types.DefaultUploadAccess,
+			MaxQueryDepth:      maxQueryDepth,
 		},
 		0,
 		0,
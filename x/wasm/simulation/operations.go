@@ -4,7 +4,6 @@ package simulation
 
 import (
 	"encoding/json"
-	"fmt"
 	"io/ioutil"
 	"math/rand"
 	"strings"
@@ -44,31 +43,31 @@ func WeightedOperations(
 	var weightMsgUpdateContractOwner int
 	appParams.GetOrGenerate(cdc, OpWeightMsgStoreCode, &weightMsgStoreCode, nil,
 		func(_ *rand.Rand) {
-			weightMsgStoreCode = 1
+			weightMsgStoreCode = 50
 		},
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgInstantiateContract, &weightMsgInstantiateContract, nil,
 		func(_ *rand.Rand) {
-			weightMsgInstantiateContract = 1
+			weightMsgInstantiateContract = 100
 		},
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgExecuteContract, &weightMsgExecuteContract, nil,
 		func(_ *rand.Rand) {
-			weightMsgExecuteContract = 1
+			weightMsgExecuteContract = 100
 		},
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgMigrateContract, &weightMsgMigrateContract, nil,
 		func(_ *rand.Rand) {
-			weightMsgMigrateContract = 1
+			weightMsgMigrateContract = 50
 		},
 	)
 
 	appParams.GetOrGenerate(cdc, OpWeightMsgUpdateContractOwner, &weightMsgUpdateContractOwner, nil,
 		func(_ *rand.Rand) {
-			weightMsgUpdateContractOwner = 3
+			weightMsgUpdateContractOwner = 50
 		},
 	)
 
@@ -216,6 +215,51 @@ func SimulateMsgInstantiateContract(ak authkeeper.AccountKeeper, k keeper.Keeper
 	}
 }
 
+// contractConfig is the config of the test contract in its store
+type contractConfig struct {
+	Verifier    []byte `json:"verifier"`
+	Beneficiary []byte `json:"beneficiary"`
+}
+
+// randomContract returns a random contract accepted by the filter, of which the
+// owner is a simulation account
+func randomContract(
+	r *rand.Rand, ctx sdk.Context, k keeper.Keeper, accs []simulation.Account, filter func(types.ContractInfo) bool,
+) (info types.ContractInfo, owner simulation.Account, found bool) {
+	var infos []types.ContractInfo
+	k.IterateContractInfo(ctx, func(info types.ContractInfo) bool {
+		if _, found := simulation.FindAccount(accs, info.Owner); found && filter(info) {
+			infos = append(infos, info)
+		}
+
+		return false
+	})
+
+	if len(infos) == 0 {
+		return info, owner, false
+	}
+
+	info = infos[r.Intn(len(infos))]
+	owner, _ = simulation.FindAccount(accs, info.Owner)
+	return info, owner, true
+}
+
+// getContractVerifier returns the verifier of the test contract, which is the only account
+// allowed to release the funds of the contract
+func getContractVerifier(ctx sdk.Context, k keeper.Keeper, contractAddr sdk.AccAddress) (sdk.AccAddress, bool) {
+	models, _ := k.ListContractStore(ctx, contractAddr, []byte("config"), nil, nil, 1)
+	if len(models) == 0 {
+		return nil, false
+	}
+
+	var config contractConfig
+	if err := json.Unmarshal(models[0].Value, &config); err != nil {
+		return nil, false
+	}
+
+	return config.Verifier, true
+}
+
 // nolint: funlen
 func SimulateMsgExecuteContract(ak authkeeper.AccountKeeper, bk bank.Keeper, k keeper.Keeper) simulation.Operation {
 	return func(
@@ -225,14 +269,22 @@ func SimulateMsgExecuteContract(ak authkeeper.AccountKeeper, bk bank.Keeper, k k
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
 
-		contractAddr, _ := sdk.AccAddressFromBech32("cosmos18vd8fpwxzck93qlwghaj6arh4p7c5n89uzcee5")
-		info, err := k.GetContractInfo(ctx, contractAddr)
-		if err != nil {
+		// the verifier of the contract must execute the msg
+		info, _, found := randomContract(r, ctx, k, accs, func(info types.ContractInfo) bool {
+			verifier, found := getContractVerifier(ctx, k, info.Address)
+			if !found {
+				return false
+			}
+
+			_, found = simulation.FindAccount(accs, verifier)
+			return found
+		})
+		if !found {
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
 
-		// should owner execute the msg
-		simAccount, _ := simulation.FindAccount(accs, info.Owner)
+		verifier, _ := getContractVerifier(ctx, k, info.Address)
+		simAccount, _ := simulation.FindAccount(accs, verifier)
 		account := ak.GetAccount(ctx, simAccount.Address)
 		spendableCoins := account.SpendableCoins(ctx.BlockTime())
 		fees, err := simulation.RandomFees(r, ctx, spendableCoins)
@@ -243,7 +295,7 @@ func SimulateMsgExecuteContract(ak authkeeper.AccountKeeper, bk bank.Keeper, k k
 		spendableCoins = spendableCoins.Sub(fees)
 		spendableCoins = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, spendableCoins.AmountOf(sdk.DefaultBondDenom)))
 
-		msg := types.NewMsgExecuteContract(simAccount.Address, contractAddr, []byte(`{"release": {}}`), simulation.RandSubsetCoins(r, spendableCoins))
+		msg := types.NewMsgExecuteContract(simAccount.Address, info.Address, []byte(`{"release": {}}`), simulation.RandSubsetCoins(r, spendableCoins))
 		tx := helpers.GenTx(
 			[]sdk.Msg{msg},
 			fees,
@@ -272,20 +324,19 @@ func SimulateMsgMigrateContract(ak authkeeper.AccountKeeper, k keeper.Keeper) si
 	return func(
 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
 	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
-		contractAddr, _ := sdk.AccAddressFromBech32("cosmos18vd8fpwxzck93qlwghaj6arh4p7c5n89uzcee5")
-		info, err := k.GetContractInfo(ctx, contractAddr)
-		if err != nil {
+		lastCodeID, err := k.GetLastCodeID(ctx)
+		if err != nil || lastCodeID == 0 {
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
 
-		targetCodeID := 1
-		_, err = k.GetCodeInfo(ctx, 2)
-		if err == nil && info.CodeID == 1 {
-			targetCodeID = 2
+		// the owner of a migratable contract must execute the msg
+		info, simAccount, found := randomContract(r, ctx, k, accs, func(info types.ContractInfo) bool {
+			return info.Migratable
+		})
+		if !found {
+			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
 
-		// should owner execute the msg
-		simAccount, _ := simulation.FindAccount(accs, info.Owner)
 		account := ak.GetAccount(ctx, simAccount.Address)
 		spendableCoins := account.SpendableCoins(ctx.BlockTime())
 		fees, err := simulation.RandomFees(r, ctx, spendableCoins)
@@ -293,8 +344,7 @@ func SimulateMsgMigrateContract(ak authkeeper.AccountKeeper, k keeper.Keeper) si
 			return simulation.NoOpMsg(types.ModuleName), nil, err
 		}
 
-		spendableCoins = spendableCoins.Sub(fees)
-
+		// the owner becomes the verifier of the migrated contract
 		migData := map[string]interface{}{
 			"verifier": info.Owner.String(),
 		}
@@ -303,7 +353,8 @@ func SimulateMsgMigrateContract(ak authkeeper.AccountKeeper, k keeper.Keeper) si
 			return simulation.NoOpMsg(types.ModuleName), nil, err
 		}
 
-		msg := types.NewMsgMigrateContract(simAccount.Address, contractAddr, uint64(targetCodeID), migDataBz)
+		targetCodeID := uint64(simulation.RandIntBetween(r, 1, int(lastCodeID)+1))
+		msg := types.NewMsgMigrateContract(simAccount.Address, info.Address, targetCodeID, migDataBz)
 		tx := helpers.GenTx(
 			[]sdk.Msg{msg},
 			fees,
@@ -315,7 +366,11 @@ func SimulateMsgMigrateContract(ak authkeeper.AccountKeeper, k keeper.Keeper) si
 		)
 
 		_, _, err = app.Deliver(tx)
-		return simulation.NewOperationMsg(msg, true, ""), nil, err
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
 	}
 }
 
@@ -324,14 +379,14 @@ func SimulateMsgUpdateContractOwner(ak authkeeper.AccountKeeper, k keeper.Keeper
 	return func(
 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simulation.Account, chainID string,
 	) (simulation.OperationMsg, []simulation.FutureOperation, error) {
-		contractAddr, _ := sdk.AccAddressFromBech32("cosmos1hqrdl6wstt8qzshwc6mrumpjk9338k0lr4dqxd")
-		info, err := k.GetContractInfo(ctx, contractAddr)
-		if err != nil {
+		// the owner of the contract must execute the msg
+		info, simAccount, found := randomContract(r, ctx, k, accs, func(types.ContractInfo) bool {
+			return true
+		})
+		if !found {
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
 
-		// should owner execute the msg
-		simAccount, _ := simulation.FindAccount(accs, info.Owner)
 		account := ak.GetAccount(ctx, simAccount.Address)
 		spendableCoins := account.SpendableCoins(ctx.BlockTime())
 		fees, err := simulation.RandomFees(r, ctx, spendableCoins)
@@ -344,7 +399,7 @@ func SimulateMsgUpdateContractOwner(ak authkeeper.AccountKeeper, k keeper.Keeper
 			return simulation.NoOpMsg(types.ModuleName), nil, nil
 		}
 
-		msg := types.NewMsgUpdateContractOwner(simAccount.Address, newOwnerAccount.Address, contractAddr)
+		msg := types.NewMsgUpdateContractOwner(simAccount.Address, newOwnerAccount.Address, info.Address)
 		tx := helpers.GenTx(
 			[]sdk.Msg{msg},
 			fees,
@@ -357,9 +412,9 @@ func SimulateMsgUpdateContractOwner(ak authkeeper.AccountKeeper, k keeper.Keeper
 
 		_, _, err = app.Deliver(tx)
 		if err != nil {
-			fmt.Println(err.Error())
+			return simulation.NoOpMsg(types.ModuleName), nil, err
 		}
 
-		return simulation.NewOperationMsg(msg, true, ""), nil, err
+		return simulation.NewOperationMsg(msg, true, ""), nil, nil
 	}
 }
@@ -30,5 +30,10 @@ func ParamChanges(r *rand.Rand) []simulation.ParamChange {
 				return fmt.Sprintf("\"%d\"", GenMaxContractMsgSize(r))
 			},
 		),
+		simulation.NewSimParamChange(types.ModuleName, string(types.ParamStoreKeyMaxQueryDepth),
+			func(r *rand.Rand) string {
+				return fmt.Sprintf("\"%d\"", GenMaxQueryDepth(r))
+			},
+		),
 	}
 }